@@ -1,16 +1,22 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"log"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/BurntSushi/toml"
+	"github.com/buildpacks/lifecycle"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/pkg/errors"
 
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/blob"
 	"github.com/pivotal/kpack/pkg/buildchange"
 	"github.com/pivotal/kpack/pkg/cnb"
@@ -18,6 +24,7 @@ import (
 	"github.com/pivotal/kpack/pkg/flaghelpers"
 	"github.com/pivotal/kpack/pkg/git"
 	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/tracing"
 )
 
 var (
@@ -30,8 +37,14 @@ var (
 	blobURL       = flag.String("blob-url", os.Getenv("BLOB_URL"), "The url of the source code blob.")
 	registryImage = flag.String("registry-image", os.Getenv("REGISTRY_IMAGE"), "The registry location of the source code image.")
 
+	artifactURL      = flag.String("artifact-url", os.Getenv("ARTIFACT_URL"), "The url of the source artifact.")
+	artifactRevision = flag.String("artifact-revision", os.Getenv("ARTIFACT_REVISION"), "The revision of the source artifact.")
+	artifactChecksum = flag.String("artifact-checksum", os.Getenv("ARTIFACT_CHECKSUM"), "The checksum the source artifact is verified against.")
+
 	buildChanges = flag.String("build-changes", os.Getenv("BUILD_CHANGES"), "JSON string of build changes and their reason")
 
+	label = flag.Bool("label", false, "Add the OCI source labels to the build metadata once the buildpacks have run.")
+
 	basicGitCredentials     flaghelpers.CredentialsFlags
 	sshGitCredentials       flaghelpers.CredentialsFlags
 	dockerCredentials       flaghelpers.CredentialsFlags
@@ -55,6 +68,9 @@ const (
 	imagePullSecretsDir   = "/imagePullSecrets"
 	builderPullSecretsDir = "/builderPullSecrets"
 	projectMetadataDir    = "/projectMetadata"
+	layersDir             = "/layers"
+	labelsFile            = "oci-labels.toml"
+	commitMetadataPath    = "/dev/termination-log"
 )
 
 func main() {
@@ -62,14 +78,38 @@ func main() {
 
 	logger := log.New(os.Stdout, "", 0)
 
+	if *label {
+		if err := cnb.AddLabels(layersDir, path.Join(layersDir, labelsFile)); err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+
 	if err := buildchange.Log(logger, *buildChanges); err != nil {
 		logger.Println(err)
 	}
 
+	ctx, shutdownTracing, err := tracing.FromEnv("kpack-build-init")
+	if err != nil {
+		logger.Printf("Error setting up tracing: %s", err)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "build-init")
+	err = initBuild(ctx, logger)
+	tracing.EndSpan(span, err)
+	if err := shutdownTracing(context.Background()); err != nil {
+		logger.Printf("Error flushing traces: %s", err)
+	}
+	if err != nil {
+		logger.Fatal(err)
+	}
+}
+
+func initBuild(ctx context.Context, logger *log.Logger) error {
 	logLoadingSecrets(logger, dockerCredentials)
 	creds, err := dockercreds.ParseMountedAnnotatedSecrets(buildSecretsDir, dockerCredentials)
 	if err != nil {
-		logger.Fatal(err)
+		return err
 	}
 
 	for _, c := range append(dockerCfgCredentials, dockerConfigCredentials...) {
@@ -77,7 +117,7 @@ func main() {
 
 		dockerCfgCreds, err := dockercreds.ParseDockerPullSecrets(credPath)
 		if err != nil {
-			logger.Fatal(err)
+			return err
 		}
 
 		for domain := range dockerCfgCreds {
@@ -86,44 +126,62 @@ func main() {
 
 		creds, err = creds.Append(dockerCfgCreds)
 		if err != nil {
-			logger.Fatal(err)
+			return err
 		}
 	}
 
-	err = dockercreds.VerifyWriteAccess(creds, *imageTag)
+	_, span := tracing.StartSpan(ctx, "build-init.verifyAccess")
+	err = verifyAccess(creds)
+	tracing.EndSpan(span, err)
 	if err != nil {
-		logger.Fatal(errors.Wrapf(err, "Error verifying write access to %q", *imageTag))
+		return err
 	}
 
-	err = dockercreds.VerifyReadAccess(creds, *runImage)
+	_, span = tracing.StartSpan(ctx, "build-init.fetchSource")
+	err = fetchSource(logger, creds)
+	tracing.EndSpan(span, err)
 	if err != nil {
-		logger.Fatal(errors.Wrapf(err, "Error verifying read access to run image %q", *runImage))
+		return err
 	}
 
-	err = fetchSource(logger, creds)
+	err = writeLabels()
 	if err != nil {
-		logger.Fatal(err)
+		return errors.Wrap(err, "error writing source labels")
 	}
 
 	err = cnb.SetupPlatformEnvVars(platformDir, *platformEnvVars)
 	if err != nil {
-		logger.Fatalf("error setting up platform env vars %s", err)
+		return errors.Wrap(err, "error setting up platform env vars")
 	}
 
 	builderCreds, err := dockercreds.ParseDockerPullSecrets(builderPullSecretsDir)
 	if err != nil {
-		logger.Fatal(err)
+		return err
 	}
 
 	dockerCreds, err := creds.Append(builderCreds)
 	if err != nil {
-		logger.Fatalf("error appending builder creds %s", err)
+		return errors.Wrap(err, "error appending builder creds")
 	}
 
 	err = dockerCreds.Save(path.Join(secretsHome, ".docker", "config.json"))
 	if err != nil {
-		logger.Fatalf("error writing docker creds %s", err)
+		return errors.Wrap(err, "error writing docker creds")
+	}
+	return nil
+}
+
+func verifyAccess(creds dockercreds.DockerCreds) error {
+	err := dockercreds.VerifyWriteAccess(creds, *imageTag)
+	if err != nil {
+		return errors.Wrapf(err, "Error verifying write access to %q", *imageTag)
 	}
+
+	err = dockercreds.VerifyReadAccess(creds, *runImage)
+	if err != nil {
+		return errors.Wrapf(err, "Error verifying read access to run image %q", *runImage)
+	}
+	return nil
 }
 
 func fetchSource(logger *log.Logger, serviceAccountCreds dockercreds.DockerCreds) error {
@@ -137,15 +195,19 @@ func fetchSource(logger *log.Logger, serviceAccountCreds dockercreds.DockerCreds
 		}
 
 		fetcher := git.Fetcher{
-			Logger:   logger,
-			Keychain: gitKeychain,
+			Logger:             logger,
+			Keychain:           gitKeychain,
+			CommitMetadataPath: commitMetadataPath,
 		}
 		return fetcher.Fetch(appDir, *gitURL, *gitRevision, projectMetadataDir)
 	case *blobURL != "":
 		fetcher := blob.Fetcher{
 			Logger: logger,
 		}
-		return fetcher.Fetch(appDir, *blobURL)
+		if err := fetcher.Fetch(appDir, *blobURL); err != nil {
+			return err
+		}
+		return writeProjectMetadata("blob", map[string]interface{}{"url": *blobURL}, nil)
 	case *registryImage != "":
 		imagePullSecrets, err := dockercreds.ParseDockerPullSecrets(imagePullSecretsDir)
 		if err != nil {
@@ -157,12 +219,88 @@ func fetchSource(logger *log.Logger, serviceAccountCreds dockercreds.DockerCreds
 			Client:   &registry.Client{},
 			Keychain: authn.NewMultiKeychain(imagePullSecrets, serviceAccountCreds),
 		}
-		return fetcher.Fetch(appDir, *registryImage)
+		if err := fetcher.Fetch(appDir, *registryImage); err != nil {
+			return err
+		}
+		return writeProjectMetadata("image", map[string]interface{}{"image": *registryImage}, nil)
+	case *artifactURL != "":
+		if *artifactRevision != "" {
+			logger.Printf("Fetching artifact revision %s", *artifactRevision)
+		}
+
+		fetcher := blob.Fetcher{
+			Logger: logger,
+		}
+		if err := fetcher.FetchWithChecksum(appDir, *artifactURL, *artifactChecksum); err != nil {
+			return err
+		}
+		return writeProjectMetadata("artifact", map[string]interface{}{"url": *artifactURL}, map[string]interface{}{"revision": *artifactRevision})
 	default:
-		return errors.New("no git url, blob url, or registry image provided")
+		return errors.New("no git url, blob url, registry image, or artifact url provided")
 	}
 }
 
+// writeProjectMetadata describes the source for the lifecycle to export in the io.buildpacks.project.metadata label, the git fetcher writes its own
+func writeProjectMetadata(sourceType string, metadata, version map[string]interface{}) error {
+	file, err := os.Create(path.Join(projectMetadataDir, "project-metadata.toml"))
+	if err != nil {
+		return errors.Wrap(err, "unable to write project metadata")
+	}
+	defer file.Close()
+
+	return toml.NewEncoder(file).Encode(lifecycle.ProjectMetadata{
+		Source: &lifecycle.ProjectSource{
+			Type:     sourceType,
+			Metadata: metadata,
+			Version:  version,
+		},
+	})
+}
+
+// writeLabels records the OCI source labels for the label step to add before export. Git sources are labeled with the
+// time of their commit so rebuilding the same commit labels the image identically.
+func writeLabels() error {
+	labels := map[string]string{
+		cnb.CreatedLabel: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	switch {
+	case *gitURL != "":
+		labels[cnb.SourceLabel] = *gitURL
+		labels[cnb.RevisionLabel] = *gitRevision
+
+		commit, err := readCommitMetadata()
+		if err != nil {
+			return err
+		}
+		if commit.Timestamp != nil {
+			labels[cnb.CreatedLabel] = commit.Timestamp.UTC().Format(time.RFC3339)
+		}
+	case *blobURL != "":
+		labels[cnb.SourceLabel] = *blobURL
+	case *registryImage != "":
+		labels[cnb.SourceLabel] = *registryImage
+	case *artifactURL != "":
+		labels[cnb.SourceLabel] = *artifactURL
+		if *artifactRevision != "" {
+			labels[cnb.RevisionLabel] = *artifactRevision
+		}
+	}
+
+	return cnb.WriteLabels(path.Join(projectMetadataDir, labelsFile), labels)
+}
+
+func readCommitMetadata() (v1alpha1.GitCommit, error) {
+	var commit v1alpha1.GitCommit
+	file, err := os.Open(commitMetadataPath)
+	if err != nil {
+		return commit, errors.Wrap(err, "unable to read commit metadata")
+	}
+	defer file.Close()
+
+	return commit, json.NewDecoder(file).Decode(&commit)
+}
+
 func logLoadingSecrets(logger *log.Logger, secretsSlices ...[]string) {
 	for _, secretsSlice := range secretsSlices {
 		for _, secret := range secretsSlice {
@@ -1,25 +1,52 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/theupdateframework/notary/tuf/data"
+
+	"github.com/pivotal/kpack/pkg/cosign"
 	"github.com/pivotal/kpack/pkg/dockercreds"
 	"github.com/pivotal/kpack/pkg/flaghelpers"
 	"github.com/pivotal/kpack/pkg/notary"
+	"github.com/pivotal/kpack/pkg/provenance"
 	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/sbom"
+	"github.com/pivotal/kpack/pkg/tracing"
 )
 
 const (
 	registrySecretsDir = "/var/build-secrets"
 	reportFilePath     = "/var/report/report.toml"
 	notarySecretDir    = "/var/notary/v1"
+	cosignSecretDir    = "/var/cosign"
+	signedTargetsPath  = "/dev/termination-log"
 )
 
 var (
 	notaryV1URL             string
+	notaryV1Role            string
+	notaryV1Tags            string
+	notaryV1RootKeyIDs      string
+	cosignSign              bool
+	publishSBOM             bool
+	buildName               string
+	builderImage            string
+	lifecycleVersion        string
+	buildStarted            string
+	sourceURL               string
+	sourceDigest            string
+	envNames                string
+	bindingNames            string
 	dockerCredentials       flaghelpers.CredentialsFlags
 	dockerCfgCredentials    flaghelpers.CredentialsFlags
 	dockerConfigCredentials flaghelpers.CredentialsFlags
@@ -28,6 +55,19 @@ var (
 
 func init() {
 	flag.StringVar(&notaryV1URL, "notary-v1-url", "", "Notary V1 server url")
+	flag.StringVar(&notaryV1Role, "notary-v1-role", "", "Notary V1 role that signs the tags")
+	flag.StringVar(&notaryV1Tags, "notary-v1-tags", "", "Comma separated patterns of the tags to sign with Notary V1")
+	flag.StringVar(&notaryV1RootKeyIDs, "notary-v1-root-key-ids", "", "Comma separated ids of the pinned Notary V1 root keys")
+	flag.BoolVar(&cosignSign, "cosign", false, "Sign the image with the cosign key mounted at "+cosignSecretDir)
+	flag.BoolVar(&publishSBOM, "publish-sbom", false, "Publish the bill of materials of the image in the report at "+reportFilePath)
+	flag.StringVar(&buildName, "build", "", "The namespaced name of the build recorded in the provenance")
+	flag.StringVar(&builderImage, "builder-image", "", "The builder image recorded in the provenance")
+	flag.StringVar(&lifecycleVersion, "lifecycle-version", "", "The lifecycle version recorded in the provenance")
+	flag.StringVar(&buildStarted, "build-started", "", "The RFC3339 time the build started recorded in the provenance")
+	flag.StringVar(&sourceURL, "source-url", "", "The location of the source recorded in the provenance")
+	flag.StringVar(&sourceDigest, "source-digest", "", "The digest of the resolved source recorded in the provenance")
+	flag.StringVar(&envNames, "env-names", "", "Comma separated names of the build env recorded in the provenance")
+	flag.StringVar(&bindingNames, "binding-names", "", "Comma separated names of the build bindings recorded in the provenance")
 	flag.Var(&dockerCredentials, "basic-docker", "Basic authentication for docker of the form 'secretname=git.domain.com'")
 	flag.Var(&dockerCfgCredentials, "dockercfg", "Docker Cfg credentials in the form of the path to the credential")
 	flag.Var(&dockerConfigCredentials, "dockerconfig", "Docker Config JSON credentials in the form of the path to the credential")
@@ -38,39 +78,153 @@ func init() {
 func main() {
 	flag.Parse()
 
-	if notaryV1URL != "" {
-		creds, err := dockercreds.ParseMountedAnnotatedSecrets(registrySecretsDir, dockerCredentials)
+	ctx, shutdownTracing, err := tracing.FromEnv("kpack-completion")
+	if err != nil {
+		logger.Printf("Error setting up tracing: %s", err)
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "completion")
+	err = complete(ctx)
+	tracing.EndSpan(span, err)
+	if err := shutdownTracing(context.Background()); err != nil {
+		logger.Printf("Error flushing traces: %s", err)
+	}
+	if err != nil {
+		logger.Fatal(err)
+	}
+
+	logger.Println("Build successful")
+}
+
+func complete(ctx context.Context) error {
+	creds, err := dockercreds.ParseMountedAnnotatedSecrets(registrySecretsDir, dockerCredentials)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range append(dockerCfgCredentials, dockerConfigCredentials...) {
+		credPath := filepath.Join(registrySecretsDir, c)
+
+		dockerCfgCreds, err := dockercreds.ParseDockerPullSecrets(credPath)
 		if err != nil {
-			logger.Fatal(err)
+			return err
 		}
 
-		for _, c := range append(dockerCfgCredentials, dockerConfigCredentials...) {
-			credPath := filepath.Join(registrySecretsDir, c)
+		for domain := range dockerCfgCreds {
+			logger.Printf("Loading secret for %q from secret %q at location %q", domain, c, credPath)
+		}
 
-			dockerCfgCreds, err := dockercreds.ParseDockerPullSecrets(credPath)
-			if err != nil {
-				logger.Fatal(err)
+		creds, err = creds.Append(dockerCfgCreds)
+		if err != nil {
+			return err
+		}
+	}
+
+	if publishSBOM {
+		err := traced(ctx, "completion.publishSBOM", func() error {
+			publisher := sbom.Publisher{
+				Logger: logger,
 			}
+			return publisher.Publish(reportFilePath, creds)
+		})
+		if err != nil {
+			return err
+		}
+	}
 
-			for domain := range dockerCfgCreds {
-				logger.Printf("Loading secret for %q from secret %q at location %q", domain, c, credPath)
+	if cosignSign {
+		err := traced(ctx, "completion.cosign", func() error {
+			signer := cosign.ImageSigner{
+				Logger: logger,
+			}
+			if err := signer.Sign(reportFilePath, cosignSecretDir, creds); err != nil {
+				return err
 			}
 
-			creds, err = creds.Append(dockerCfgCreds)
+			config, err := provenanceConfig()
 			if err != nil {
-				logger.Fatal(err)
+				return err
+			}
+
+			attester := provenance.Attester{
+				Logger: logger,
 			}
+			return attester.Attest(reportFilePath, cosignSecretDir, config, creds)
+		})
+		if err != nil {
+			return err
 		}
+	}
+
+	if notaryV1URL != "" {
+		err := traced(ctx, "completion.notaryV1", func() error {
+			signer := notary.ImageSigner{
+				Logger:  logger,
+				Client:  &registry.Client{},
+				Factory: &notary.RemoteRepositoryFactory{},
+			}
+			signedTargets, err := signer.Sign(notaryV1URL, notarySecretDir, reportFilePath, notary.SigningOptions{
+				Role:       data.RoleName(notaryV1Role),
+				Tags:       splitNames(notaryV1Tags),
+				RootKeyIDs: splitNames(notaryV1RootKeyIDs),
+			}, creds)
+			if err != nil {
+				return err
+			}
 
-		signer := notary.ImageSigner{
-			Logger:  logger,
-			Client:  &registry.Client{},
-			Factory: &notary.RemoteRepositoryFactory{},
+			return writeSignedTargets(signedTargets)
+		})
+		if err != nil {
+			return err
 		}
-		if err := signer.Sign(notaryV1URL, notarySecretDir, reportFilePath, creds); err != nil {
-			logger.Fatal(err)
+	}
+
+	return nil
+}
+
+// traced runs fn in a span that is a child of the completion span
+func traced(ctx context.Context, name string, fn func() error) error {
+	_, span := tracing.StartSpan(ctx, name)
+	err := fn()
+	tracing.EndSpan(span, err)
+	return err
+}
+
+// writeSignedTargets passes the signed targets to the controller through the termination message
+func writeSignedTargets(signedTargets []notary.SignedTarget) error {
+	content, err := json.Marshal(signedTargets)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(signedTargetsPath, content, 0644)
+}
+
+func provenanceConfig() (provenance.Config, error) {
+	config := provenance.Config{
+		Build:            buildName,
+		BuilderImage:     builderImage,
+		LifecycleVersion: lifecycleVersion,
+		SourceURL:        sourceURL,
+		SourceDigest:     sourceDigest,
+		EnvNames:         splitNames(envNames),
+		BindingNames:     splitNames(bindingNames),
+		FinishedOn:       time.Now(),
+	}
+
+	if buildStarted != "" {
+		startedOn, err := time.Parse(time.RFC3339, buildStarted)
+		if err != nil {
+			return provenance.Config{}, errors.Wrap(err, "invalid build-started")
 		}
+		config.StartedOn = startedOn
 	}
 
-	logger.Println("Build successful")
+	return config, nil
+}
+
+func splitNames(names string) []string {
+	if names == "" {
+		return nil
+	}
+	return strings.Split(names, ",")
 }
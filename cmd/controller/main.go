@@ -8,13 +8,17 @@ import (
 	"os"
 	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
 	"knative.dev/pkg/configmap"
@@ -28,23 +32,31 @@ import (
 
 	"github.com/pivotal/kpack/cmd"
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/artifact"
 	"github.com/pivotal/kpack/pkg/blob"
 	"github.com/pivotal/kpack/pkg/buildpod"
+	"github.com/pivotal/kpack/pkg/buildqueue"
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
 	"github.com/pivotal/kpack/pkg/client/informers/externalversions"
 	"github.com/pivotal/kpack/pkg/cnb"
 	"github.com/pivotal/kpack/pkg/dockercreds/k8sdockercreds"
 	"github.com/pivotal/kpack/pkg/duckbuilder"
 	"github.com/pivotal/kpack/pkg/git"
+	"github.com/pivotal/kpack/pkg/logs"
+	kpackmetrics "github.com/pivotal/kpack/pkg/metrics"
+	"github.com/pivotal/kpack/pkg/notification"
 	"github.com/pivotal/kpack/pkg/reconciler"
 	"github.com/pivotal/kpack/pkg/reconciler/build"
 	"github.com/pivotal/kpack/pkg/reconciler/builder"
+	"github.com/pivotal/kpack/pkg/reconciler/buildquota"
 	"github.com/pivotal/kpack/pkg/reconciler/clusterbuilder"
 	"github.com/pivotal/kpack/pkg/reconciler/clusterstack"
 	"github.com/pivotal/kpack/pkg/reconciler/clusterstore"
 	"github.com/pivotal/kpack/pkg/reconciler/image"
+	"github.com/pivotal/kpack/pkg/reconciler/rolloutpolicy"
 	"github.com/pivotal/kpack/pkg/reconciler/sourceresolver"
 	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/tracing"
 )
 
 const (
@@ -60,6 +72,26 @@ var (
 	rebaseImage     = flag.String("rebase-image", os.Getenv("REBASE_IMAGE"), "The image used to perform rebases")
 	completionImage = flag.String("completion-image", os.Getenv("COMPLETION_IMAGE"), "The image used to finish a build")
 	lifecycleImage  = flag.String("lifecycle-image", os.Getenv("LIFECYCLE_IMAGE"), "The image used to provide lifecycle binaries")
+
+	buildPodScheduling    = flag.String("build-pod-scheduling", os.Getenv("BUILD_POD_SCHEDULING"), "Default tolerations, nodeSelector, affinity, priorityClassName and runtimeClassName for build pods as yaml")
+	buildPodStepResources = flag.String("build-pod-step-resources", os.Getenv("BUILD_POD_STEP_RESOURCES"), "Default resource requirements of each build pod step as yaml")
+
+	logArchive         = flag.String("log-archive", os.Getenv("LOG_ARCHIVE"), "The sink the logs of finished builds are archived to: volume, registry or s3. Logs are not archived if empty")
+	logArchiveDir      = flag.String("log-archive-dir", os.Getenv("LOG_ARCHIVE_DIR"), "The directory the volume log archive writes to")
+	logArchiveEndpoint = flag.String("log-archive-s3-endpoint", os.Getenv("LOG_ARCHIVE_S3_ENDPOINT"), "The url of the s3 compatible store the s3 log archive writes to")
+	logArchiveBucket   = flag.String("log-archive-s3-bucket", os.Getenv("LOG_ARCHIVE_S3_BUCKET"), "The bucket the s3 log archive writes to")
+	logArchiveRegion   = flag.String("log-archive-s3-region", os.Getenv("LOG_ARCHIVE_S3_REGION"), "The region of the s3 log archive bucket")
+
+	logServerAddress     = flag.String("log-server-address", os.Getenv("LOG_SERVER_ADDRESS"), "The address the build log streaming server listens on. The server is not started if empty")
+	logServerTLSCertFile = flag.String("log-server-tls-cert-file", os.Getenv("LOG_SERVER_TLS_CERT_FILE"), "The certificate the build log streaming server serves https with")
+	logServerTLSKeyFile  = flag.String("log-server-tls-key-file", os.Getenv("LOG_SERVER_TLS_KEY_FILE"), "The key of the build log streaming server certificate")
+
+	buildNotifications = flag.String("build-notifications", os.Getenv("BUILD_NOTIFICATIONS"), "The http sinks build lifecycle cloudevents are delivered to as yaml. Notifications are not sent if empty")
+
+	buildConcurrencyLimits = flag.String("build-concurrency-limits", os.Getenv("BUILD_CONCURRENCY_LIMITS"), "The cluster-wide and per-namespace limits of builds with a pod as yaml. The number of builds is not limited if empty")
+	enforceBuildQuotas     = flag.Bool("enforce-build-quotas", os.Getenv("ENFORCE_BUILD_QUOTAS") == "true", "Hold builds that would exceed the BuildQuotas of their namespace")
+
+	otlpCollectorAddress = flag.String("otlp-collector-address", os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"), "The address of the OTLP collector build traces are exported to. Builds are not traced if empty")
 )
 
 func main() {
@@ -75,6 +107,12 @@ func main() {
 	defer logger.Sync()
 	defer metrics.FlushExporter()
 
+	shutdownTracing, err := tracing.Setup("kpack-controller", *otlpCollectorAddress)
+	if err != nil {
+		logger.Fatalw("Error setting up tracing", zap.Error(err))
+	}
+	defer shutdownTracing(context.Background())
+
 	client, err := versioned.NewForConfig(clusterConfig)
 	if err != nil {
 		log.Fatalf("could not get Build client: %s", err)
@@ -85,9 +123,15 @@ func main() {
 		log.Fatalf("could not get kubernetes client: %s", err)
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(clusterConfig)
+	if err != nil {
+		log.Fatalf("could not get dynamic client: %s", err)
+	}
+
 	options := reconciler.Options{
 		Logger:                  logger,
 		Client:                  client,
+		EventRecorder:           reconciler.NewEventRecorder(k8sClient, logger),
 		ResyncPeriod:            10 * time.Hour,
 		SourcePollingFrequency:  1 * time.Minute,
 		BuilderPollingFrequency: 1 * time.Minute,
@@ -101,6 +145,8 @@ func main() {
 	clusterBuilderInformer := informerFactory.Kpack().V1alpha1().ClusterBuilders()
 	clusterStoreInformer := informerFactory.Kpack().V1alpha1().ClusterStores()
 	clusterStackInformer := informerFactory.Kpack().V1alpha1().ClusterStacks()
+	buildQuotaInformer := informerFactory.Kpack().V1alpha1().BuildQuotas()
+	rolloutPolicyInformer := informerFactory.Kpack().V1alpha1().RolloutPolicies()
 
 	duckBuilderInformer := &duckbuilder.DuckBuilderInformer{
 		BuilderInformer:        builderInformer,
@@ -121,11 +167,26 @@ func main() {
 		ImageFetcher:    &registry.Client{},
 	}
 
+	var scheduling v1alpha1.BuildPodScheduling
+	if err := yaml.Unmarshal([]byte(*buildPodScheduling), &scheduling); err != nil {
+		log.Fatalf("could not parse build pod scheduling: %s", err)
+	}
+
+	var stepResources v1alpha1.StepResources
+	if err := yaml.Unmarshal([]byte(*buildPodStepResources), &stepResources); err != nil {
+		log.Fatalf("could not parse build pod step resources: %s", err)
+	}
+	if err := stepResources.Validate(ctx); err != nil {
+		log.Fatalf("invalid build pod step resources: %s", err)
+	}
+
 	buildpodGenerator := &buildpod.Generator{
 		BuildPodConfig: v1alpha1.BuildPodImages{
 			BuildInitImage:  *buildInitImage,
 			CompletionImage: *completionImage,
 			RebaseImage:     *rebaseImage,
+			Scheduling:      scheduling,
+			StepResources:   stepResources,
 		},
 		K8sClient:       k8sClient,
 		KeychainFactory: keychainFactory,
@@ -135,6 +196,12 @@ func main() {
 	gitResolver := git.NewResolver(k8sClient)
 	blobResolver := &blob.Resolver{}
 	registryResolver := &registry.Resolver{}
+	artifactResolver := artifact.NewResolver(
+		dynamicClient,
+		restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(k8sClient.Discovery())),
+		options.ResyncPeriod,
+		ctx.Done(),
+	)
 
 	kpackKeychain, err := keychainFactory.KeychainForSecretRef(registry.SecretRef{})
 	if err != nil {
@@ -158,13 +225,73 @@ func main() {
 		NewBuildpackRepository: newBuildpackRepository(kpackKeychain),
 	}
 
-	buildController := build.NewController(options, k8sClient, buildInformer, podInformer, metadataRetriever, buildpodGenerator)
-	imageController := image.NewController(options, k8sClient, imageInformer, buildInformer, duckBuilderInformer, sourceResolverInformer, pvcInformer)
-	sourceResolverController := sourceresolver.NewController(options, sourceResolverInformer, gitResolver, blobResolver, registryResolver)
+	archive, err := logs.NewArchive(logs.ArchiveConfig{
+		Kind:              *logArchive,
+		Dir:               *logArchiveDir,
+		S3Endpoint:        *logArchiveEndpoint,
+		S3Bucket:          *logArchiveBucket,
+		S3Region:          *logArchiveRegion,
+		S3AccessKeyID:     os.Getenv("LOG_ARCHIVE_S3_ACCESS_KEY_ID"),
+		S3SecretAccessKey: os.Getenv("LOG_ARCHIVE_S3_SECRET_ACCESS_KEY"),
+	}, keychainFactory, client)
+	if err != nil {
+		log.Fatalf("could not configure log archive: %s", err)
+	}
+
+	var (
+		logArchiver      *logs.Archiver
+		buildLogArchiver build.LogArchiver
+	)
+	if archive != nil {
+		logArchiver = logs.NewArchiver(k8sClient, client, archive, logger)
+		buildLogArchiver = logArchiver
+	}
+
+	var notificationConfig notification.Config
+	if err := yaml.Unmarshal([]byte(*buildNotifications), &notificationConfig); err != nil {
+		log.Fatalf("could not parse build notifications: %s", err)
+	}
+
+	var (
+		notifier      *notification.Notifier
+		buildNotifier build.Notifier
+	)
+	if len(notificationConfig.Sinks) > 0 {
+		notifier, err = notification.NewNotifier(notificationConfig, logger)
+		if err != nil {
+			log.Fatalf("could not configure build notifications: %s", err)
+		}
+		buildNotifier = notifier
+	}
+
+	var concurrencyLimits buildqueue.Limits
+	if err := yaml.Unmarshal([]byte(*buildConcurrencyLimits), &concurrencyLimits); err != nil {
+		log.Fatalf("could not parse build concurrency limits: %s", err)
+	}
+
+	var buildQueue build.BuildQueue
+	if concurrencyLimits.Cluster > 0 || concurrencyLimits.Namespace > 0 || *enforceBuildQuotas {
+		queue := &buildqueue.Queue{
+			BuildLister:   buildInformer.Lister(),
+			PodLister:     podInformer.Lister(),
+			Limits:        concurrencyLimits,
+			StepResources: stepResources,
+		}
+		if *enforceBuildQuotas {
+			queue.QuotaLister = buildQuotaInformer.Lister()
+		}
+		buildQueue = queue
+	}
+
+	buildController := build.NewController(options, k8sClient, buildInformer, podInformer, metadataRetriever, buildpodGenerator, &logs.CreatorPhaseReader{K8sClient: k8sClient}, buildLogArchiver, buildNotifier, buildQueue, buildQuotaInformer)
+	imageController := image.NewController(options, k8sClient, imageInformer, buildInformer, duckBuilderInformer, sourceResolverInformer, pvcInformer, rolloutPolicyInformer)
+	sourceResolverController := sourceresolver.NewController(options, sourceResolverInformer, gitResolver, blobResolver, registryResolver, artifactResolver)
 	builderController := builder.NewController(options, builderInformer, builderCreator, keychainFactory, clusterStoreInformer, clusterStackInformer)
 	clusterBuilderController := clusterBuilder.NewController(options, clusterBuilderInformer, builderCreator, keychainFactory, clusterStoreInformer, clusterStackInformer)
 	clusterStoreController := clusterstore.NewController(options, clusterStoreInformer, remoteStoreReader)
 	clusterStackController := clusterstack.NewController(options, clusterStackInformer, remoteStackReader)
+	buildQuotaController := buildquota.NewController(options, buildQuotaInformer, podInformer, imageInformer)
+	rolloutPolicyController := rolloutpolicy.NewController(options, rolloutPolicyInformer, imageInformer, buildInformer, duckBuilderInformer)
 
 	stopChan := make(chan struct{})
 	informerFactory.Start(stopChan)
@@ -180,10 +307,20 @@ func main() {
 		clusterBuilderInformer.Informer(),
 		clusterStoreInformer.Informer(),
 		clusterStackInformer.Informer(),
+		buildQuotaInformer.Informer(),
+		rolloutPolicyInformer.Informer(),
 	)
 
-	err = runGroup(
-		ctx,
+	if err := kpackmetrics.RegisterViews(); err != nil {
+		logger.Fatalw("Error registering metrics", zap.Error(err))
+	}
+
+	pendingBuildsReporter := &kpackmetrics.PendingBuildsReporter{
+		BuildLister: buildInformer.Lister(),
+		Interval:    30 * time.Second,
+	}
+
+	runFuncs := []doneFunc{
 		run(clusterStackController, routinesPerController),
 		run(imageController, routinesPerController),
 		run(buildController, routinesPerController),
@@ -191,7 +328,10 @@ func main() {
 		run(clusterBuilderController, routinesPerController),
 		run(clusterStoreController, routinesPerController),
 		run(sourceResolverController, 2*routinesPerController),
+		run(buildQuotaController, routinesPerController),
+		run(rolloutPolicyController, routinesPerController),
 		configMapWatcher.Start,
+		pendingBuildsReporter.Run,
 		func(done <-chan struct{}) error {
 			return profilingServer.ListenAndServe()
 		},
@@ -199,7 +339,37 @@ func main() {
 			<-done
 			return profilingServer.Shutdown(ctx)
 		},
-	)
+	}
+
+	if *logServerAddress != "" {
+		logServer := &http.Server{
+			Addr:    *logServerAddress,
+			Handler: &logs.Server{K8sClient: k8sClient, Logger: logger},
+		}
+
+		runFuncs = append(runFuncs,
+			func(done <-chan struct{}) error {
+				if *logServerTLSCertFile != "" {
+					return logServer.ListenAndServeTLS(*logServerTLSCertFile, *logServerTLSKeyFile)
+				}
+				return logServer.ListenAndServe()
+			},
+			func(done <-chan struct{}) error {
+				<-done
+				return logServer.Shutdown(ctx)
+			},
+		)
+	}
+
+	if notifier != nil {
+		runFuncs = append(runFuncs, notifier.Run)
+	}
+
+	if logArchiver != nil {
+		runFuncs = append(runFuncs, logArchiver.Run)
+	}
+
+	err = runGroup(ctx, runFuncs...)
 	if err != nil && err != http.ErrServerClosed {
 		logger.Fatalw("Error running controller", zap.Error(err))
 	}
@@ -234,7 +404,7 @@ func newBuildpackRepository(keychain authn.Keychain) func(clusterStore *v1alpha1
 	}
 }
 
-const controllerCount = 7
+const controllerCount = 9
 
 //lifted from knative.dev/pkg/injection/sharedmain
 func genericControllerSetup(ctx context.Context, cfg *rest.Config) (*zap.SugaredLogger, *configmap.InformedWatcher, *http.Server) {
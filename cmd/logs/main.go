@@ -12,6 +12,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
 
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	"github.com/pivotal/kpack/pkg/dockercreds/k8sdockercreds"
 	"github.com/pivotal/kpack/pkg/logs"
 )
 
@@ -21,6 +23,12 @@ var (
 	image      = flag.String("image", "", "The image name to tail logs")
 	build      = flag.String("build", "", "The build number to tail logs")
 	namespace  = flag.String("namespace", "default", "The namespace of the image")
+
+	logArchive         = flag.String("log-archive", os.Getenv("LOG_ARCHIVE"), "The archive the logs of finished builds are read from when their pod is gone: volume, registry or s3")
+	logArchiveDir      = flag.String("log-archive-dir", os.Getenv("LOG_ARCHIVE_DIR"), "The directory of the volume log archive")
+	logArchiveEndpoint = flag.String("log-archive-s3-endpoint", os.Getenv("LOG_ARCHIVE_S3_ENDPOINT"), "The url of the s3 compatible store of the s3 log archive")
+	logArchiveBucket   = flag.String("log-archive-s3-bucket", os.Getenv("LOG_ARCHIVE_S3_BUCKET"), "The bucket of the s3 log archive")
+	logArchiveRegion   = flag.String("log-archive-s3-region", os.Getenv("LOG_ARCHIVE_S3_REGION"), "The region of the s3 log archive bucket")
 )
 
 func main() {
@@ -36,10 +44,15 @@ func main() {
 		log.Fatalf("could not get kubernetes client: %s", err.Error())
 	}
 
+	logsClient, err := newBuildLogsClient(clusterConfig, k8sClient)
+	if err != nil {
+		log.Fatalf("could not configure log archive: %s", err)
+	}
+
 	if (*build) == "" {
-		err = logs.NewBuildLogsClient(k8sClient).TailImage(context.Background(), os.Stdout, *image, *namespace)
+		err = logsClient.TailImage(context.Background(), os.Stdout, *image, *namespace)
 	} else {
-		err = logs.NewBuildLogsClient(k8sClient).Tail(context.Background(), os.Stdout, *image, *build, *namespace)
+		err = logsClient.Tail(context.Background(), os.Stdout, *image, *build, *namespace)
 	}
 
 	if err != nil {
@@ -48,6 +61,37 @@ func main() {
 
 }
 
+func newBuildLogsClient(clusterConfig *rest.Config, k8sClient kubernetes.Interface) (*logs.BuildLogsClient, error) {
+	if *logArchive == "" {
+		return logs.NewBuildLogsClient(k8sClient), nil
+	}
+
+	kpackClient, err := versioned.NewForConfig(clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	keychainFactory, err := k8sdockercreds.NewSecretKeychainFactory(k8sClient)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := logs.NewArchive(logs.ArchiveConfig{
+		Kind:              *logArchive,
+		Dir:               *logArchiveDir,
+		S3Endpoint:        *logArchiveEndpoint,
+		S3Bucket:          *logArchiveBucket,
+		S3Region:          *logArchiveRegion,
+		S3AccessKeyID:     os.Getenv("LOG_ARCHIVE_S3_ACCESS_KEY_ID"),
+		S3SecretAccessKey: os.Getenv("LOG_ARCHIVE_S3_SECRET_ACCESS_KEY"),
+	}, keychainFactory, kpackClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs.NewArchivedBuildLogsClient(k8sClient, kpackClient, archive), nil
+}
+
 func BuildConfigFromFlags(masterURL, kubeconfigPath string) (*rest.Config, error) {
 
 	var clientConfigLoader clientcmd.ClientConfigLoader
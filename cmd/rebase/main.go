@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"flag"
 	"io/ioutil"
 	"log"
@@ -17,6 +18,7 @@ import (
 	"github.com/pivotal/kpack/pkg/buildchange"
 	"github.com/pivotal/kpack/pkg/dockercreds"
 	"github.com/pivotal/kpack/pkg/flaghelpers"
+	"github.com/pivotal/kpack/pkg/tracing"
 )
 
 const (
@@ -49,7 +51,19 @@ func main() {
 		logger.Println(err)
 	}
 
-	cmd.Exit(rebase(tags, logger))
+	ctx, shutdownTracing, err := tracing.FromEnv("kpack-rebase")
+	if err != nil {
+		logger.Printf("Error setting up tracing: %s", err)
+	}
+
+	_, span := tracing.StartSpan(ctx, "rebase")
+	err = rebase(tags, logger)
+	tracing.EndSpan(span, err)
+	if err := shutdownTracing(context.Background()); err != nil {
+		logger.Printf("Error flushing traces: %s", err)
+	}
+
+	cmd.Exit(err)
 }
 
 func rebase(tags []string, logger *log.Logger) error {
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"time"
 
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -22,6 +23,9 @@ import (
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/buildquota"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	"github.com/pivotal/kpack/pkg/client/informers/externalversions"
 )
 
 var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
@@ -31,6 +35,8 @@ var types = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	v1alpha1.SchemeGroupVersion.WithKind("ClusterBuilder"): &v1alpha1.ClusterBuilder{},
 	v1alpha1.SchemeGroupVersion.WithKind("ClusterStore"):   &v1alpha1.ClusterStore{},
 	v1alpha1.SchemeGroupVersion.WithKind("ClusterStack"):   &v1alpha1.ClusterStack{},
+	v1alpha1.SchemeGroupVersion.WithKind("BuildQuota"):     &v1alpha1.BuildQuota{},
+	v1alpha1.SchemeGroupVersion.WithKind("RolloutPolicy"):  &v1alpha1.RolloutPolicy{},
 }
 
 func init() {
@@ -44,11 +50,28 @@ func main() {
 		SecretName:  "webhook-certs",
 	})
 
+	config := sharedmain.ParseAndGetConfigOrDie()
+
+	kpackClient, err := versioned.NewForConfig(config)
+	if err != nil {
+		log.Fatalf("could not get kpack client: %s", err)
+	}
+
+	informerFactory := externalversions.NewSharedInformerFactory(kpackClient, 10*time.Hour)
+	cacheStorageQuota := &buildquota.CacheStorageQuota{
+		QuotaLister: informerFactory.Kpack().V1alpha1().BuildQuotas().Lister(),
+		ImageLister: informerFactory.Kpack().V1alpha1().Images().Lister(),
+	}
+	informerFactory.Start(ctx.Done())
+	informerFactory.WaitForCacheSync(ctx.Done())
+
 	sharedmain.WebhookMainWithConfig(ctx, "webhook",
-		sharedmain.ParseAndGetConfigOrDie(),
+		config,
 		certificates.NewController,
 		defaultingAdmissionController,
-		validatingAdmissionController,
+		func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+			return validatingAdmissionController(ctx, cmw, cacheStorageQuota)
+		},
 	)
 }
 
@@ -71,7 +94,7 @@ func defaultingAdmissionController(ctx context.Context, _ configmap.Watcher) *co
 	)
 }
 
-func validatingAdmissionController(ctx context.Context, _ configmap.Watcher) *controller.Impl {
+func validatingAdmissionController(ctx context.Context, _ configmap.Watcher, cacheStorageQuota v1alpha1.CacheStorageQuota) *controller.Impl {
 	storageClassLister := getStorageClassInformer(ctx).Lister()
 
 	return validation.NewAdmissionController(ctx,
@@ -83,6 +106,7 @@ func validatingAdmissionController(ctx context.Context, _ configmap.Watcher) *co
 		types,
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
 		func(ctx context.Context) context.Context {
+			ctx = context.WithValue(ctx, v1alpha1.CacheStorageQuotaKey, cacheStorageQuota)
 			return withCheckDefaultStorageClass(ctx, storageClassLister)
 		},
 		// Whether to disallow unknown fields.
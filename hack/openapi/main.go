@@ -1023,6 +1023,806 @@ const k8sOpenAPIDefinitions = `{
         "type": "string"
       }
     }
+  },
+  "io.k8s.api.core.v1.Affinity": {
+    "description": "Affinity is a group of affinity scheduling rules.",
+    "type": "object",
+    "properties": {
+      "nodeAffinity": {
+        "description": "Describes node affinity scheduling rules for the pod.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.NodeAffinity"
+      },
+      "podAffinity": {
+        "description": "Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).",
+        "$ref": "#/definitions/io.k8s.api.core.v1.PodAffinity"
+      },
+      "podAntiAffinity": {
+        "description": "Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).",
+        "$ref": "#/definitions/io.k8s.api.core.v1.PodAntiAffinity"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.Capabilities": {
+    "description": "Adds and removes POSIX capabilities from running containers.",
+    "type": "object",
+    "properties": {
+      "add": {
+        "description": "Added capabilities",
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      },
+      "drop": {
+        "description": "Removed capabilities",
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "io.k8s.api.core.v1.ConfigMapEnvSource": {
+    "description": "ConfigMapEnvSource selects a ConfigMap to populate the environment variables with.\n\nThe contents of the target ConfigMap's Data field will represent the key-value pairs as environment variables.",
+    "type": "object",
+    "properties": {
+      "name": {
+        "description": "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+        "type": "string"
+      },
+      "optional": {
+        "description": "Specify whether the ConfigMap must be defined",
+        "type": "boolean"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.Container": {
+    "description": "A single application container that you want to run within a pod.",
+    "type": "object",
+    "required": [
+      "name"
+    ],
+    "properties": {
+      "args": {
+        "description": "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      },
+      "command": {
+        "description": "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      },
+      "env": {
+        "description": "List of environment variables to set in the container. Cannot be updated.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.EnvVar"
+        },
+        "x-kubernetes-patch-merge-key": "name",
+        "x-kubernetes-patch-strategy": "merge"
+      },
+      "envFrom": {
+        "description": "List of sources to populate environment variables in the container. The keys defined within a source must be a C_IDENTIFIER. All invalid keys will be reported as an event when the container is starting. When a key exists in multiple sources, the value associated with the last source will take precedence. Values defined by an Env with a duplicate key will take precedence. Cannot be updated.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.EnvFromSource"
+        }
+      },
+      "image": {
+        "description": "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images This field is optional to allow higher level config management to default or override container images in workload controllers like Deployments and StatefulSets.",
+        "type": "string"
+      },
+      "imagePullPolicy": {
+        "description": "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images",
+        "type": "string"
+      },
+      "lifecycle": {
+        "description": "Actions that the management system should take in response to container lifecycle events. Cannot be updated.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.Lifecycle"
+      },
+      "livenessProbe": {
+        "description": "Periodic probe of container liveness. Container will be restarted if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+        "$ref": "#/definitions/io.k8s.api.core.v1.Probe"
+      },
+      "name": {
+        "description": "Name of the container specified as a DNS_LABEL. Each container in a pod must have a unique name (DNS_LABEL). Cannot be updated.",
+        "type": "string"
+      },
+      "ports": {
+        "description": "List of ports to expose from the container. Exposing a port here gives the system additional information about the network connections a container uses, but is primarily informational. Not specifying a port here DOES NOT prevent that port from being exposed. Any port which is listening on the default \"0.0.0.0\" address inside a container will be accessible from the network. Cannot be updated.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.ContainerPort"
+        },
+        "x-kubernetes-list-map-keys": [
+          "containerPort",
+          "protocol"
+        ],
+        "x-kubernetes-list-type": "map",
+        "x-kubernetes-patch-merge-key": "containerPort",
+        "x-kubernetes-patch-strategy": "merge"
+      },
+      "readinessProbe": {
+        "description": "Periodic probe of container service readiness. Container will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+        "$ref": "#/definitions/io.k8s.api.core.v1.Probe"
+      },
+      "resources": {
+        "description": "Compute Resources required by this container. Cannot be updated. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/",
+        "$ref": "#/definitions/io.k8s.api.core.v1.ResourceRequirements"
+      },
+      "securityContext": {
+        "description": "Security options the pod should run with. More info: https://kubernetes.io/docs/concepts/policy/security-context/ More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+        "$ref": "#/definitions/io.k8s.api.core.v1.SecurityContext"
+      },
+      "startupProbe": {
+        "description": "StartupProbe indicates that the Pod has successfully initialized. If specified, no other probes are executed until this completes successfully. If this probe fails, the Pod will be restarted, just as if the livenessProbe failed. This can be used to provide different probe parameters at the beginning of a Pod's lifecycle, when it might take a long time to load data or warm a cache, than during steady-state operation. This cannot be updated. This is an alpha feature enabled by the StartupProbe feature flag. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+        "$ref": "#/definitions/io.k8s.api.core.v1.Probe"
+      },
+      "stdin": {
+        "description": "Whether this container should allocate a buffer for stdin in the container runtime. If this is not set, reads from stdin in the container will always result in EOF. Default is false.",
+        "type": "boolean"
+      },
+      "stdinOnce": {
+        "description": "Whether the container runtime should close the stdin channel after it has been opened by a single attach. When stdin is true the stdin stream will remain open across multiple attach sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the first client attaches to stdin, and then remains open and accepts data until the client disconnects, at which time stdin is closed and remains closed until the container is restarted. If this flag is false, a container processes that reads from stdin will never receive an EOF. Default is false",
+        "type": "boolean"
+      },
+      "terminationMessagePath": {
+        "description": "Optional: Path at which the file to which the container's termination message will be written is mounted into the container's filesystem. Message written is intended to be brief final status, such as an assertion failure message. Will be truncated by the node if greater than 4096 bytes. The total message length across all containers will be limited to 12kb. Defaults to /dev/termination-log. Cannot be updated.",
+        "type": "string"
+      },
+      "terminationMessagePolicy": {
+        "description": "Indicate how the termination message should be populated. File will use the contents of terminationMessagePath to populate the container status message on both success and failure. FallbackToLogsOnError will use the last chunk of container log output if the termination message file is empty and the container exited with an error. The log output is limited to 2048 bytes or 80 lines, whichever is smaller. Defaults to File. Cannot be updated.",
+        "type": "string"
+      },
+      "tty": {
+        "description": "Whether this container should allocate a TTY for itself, also requires 'stdin' to be true. Default is false.",
+        "type": "boolean"
+      },
+      "volumeDevices": {
+        "description": "volumeDevices is the list of block devices to be used by the container. This is a beta feature.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.VolumeDevice"
+        },
+        "x-kubernetes-patch-merge-key": "devicePath",
+        "x-kubernetes-patch-strategy": "merge"
+      },
+      "volumeMounts": {
+        "description": "Pod volumes to mount into the container's filesystem. Cannot be updated.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.VolumeMount"
+        },
+        "x-kubernetes-patch-merge-key": "mountPath",
+        "x-kubernetes-patch-strategy": "merge"
+      },
+      "workingDir": {
+        "description": "Container's working directory. If not specified, the container runtime's default will be used, which might be configured in the container image. Cannot be updated.",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.ContainerPort": {
+    "description": "ContainerPort represents a network port in a single container.",
+    "type": "object",
+    "required": [
+      "containerPort"
+    ],
+    "properties": {
+      "containerPort": {
+        "description": "Number of port to expose on the pod's IP address. This must be a valid port number, 0 \u003c x \u003c 65536.",
+        "type": "integer",
+        "format": "int32"
+      },
+      "hostIP": {
+        "description": "What host IP to bind the external port to.",
+        "type": "string"
+      },
+      "hostPort": {
+        "description": "Number of port to expose on the host. If specified, this must be a valid port number, 0 \u003c x \u003c 65536. If HostNetwork is specified, this must match ContainerPort. Most containers do not need this.",
+        "type": "integer",
+        "format": "int32"
+      },
+      "name": {
+        "description": "If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services.",
+        "type": "string"
+      },
+      "protocol": {
+        "description": "Protocol for port. Must be UDP, TCP, or SCTP. Defaults to \"TCP\".",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.EnvFromSource": {
+    "description": "EnvFromSource represents the source of a set of ConfigMaps",
+    "type": "object",
+    "properties": {
+      "configMapRef": {
+        "description": "The ConfigMap to select from",
+        "$ref": "#/definitions/io.k8s.api.core.v1.ConfigMapEnvSource"
+      },
+      "prefix": {
+        "description": "An optional identifier to prepend to each key in the ConfigMap. Must be a C_IDENTIFIER.",
+        "type": "string"
+      },
+      "secretRef": {
+        "description": "The Secret to select from",
+        "$ref": "#/definitions/io.k8s.api.core.v1.SecretEnvSource"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.ExecAction": {
+    "description": "ExecAction describes a \"run in container\" action.",
+    "type": "object",
+    "properties": {
+      "command": {
+        "description": "Command is the command line to execute inside the container, the working directory for the command  is root ('/') in the container's filesystem. The command is simply exec'd, it is not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use a shell, you need to explicitly call out to that shell. Exit status of 0 is treated as live/healthy and non-zero is unhealthy.",
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "io.k8s.api.core.v1.HTTPGetAction": {
+    "description": "HTTPGetAction describes an action based on HTTP Get requests.",
+    "type": "object",
+    "required": [
+      "port"
+    ],
+    "properties": {
+      "host": {
+        "description": "Host name to connect to, defaults to the pod IP. You probably want to set \"Host\" in httpHeaders instead.",
+        "type": "string"
+      },
+      "httpHeaders": {
+        "description": "Custom headers to set in the request. HTTP allows repeated headers.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.HTTPHeader"
+        }
+      },
+      "path": {
+        "description": "Path to access on the HTTP server.",
+        "type": "string"
+      },
+      "port": {
+        "description": "Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.",
+        "$ref": "#/definitions/io.k8s.apimachinery.pkg.util.intstr.IntOrString"
+      },
+      "scheme": {
+        "description": "Scheme to use for connecting to the host. Defaults to HTTP.",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.HTTPHeader": {
+    "description": "HTTPHeader describes a custom header to be used in HTTP probes",
+    "type": "object",
+    "required": [
+      "name",
+      "value"
+    ],
+    "properties": {
+      "name": {
+        "description": "The header field name",
+        "type": "string"
+      },
+      "value": {
+        "description": "The header field value",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.Handler": {
+    "description": "Handler defines a specific action that should be taken",
+    "type": "object",
+    "properties": {
+      "exec": {
+        "description": "One and only one of the following should be specified. Exec specifies the action to take.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.ExecAction"
+      },
+      "httpGet": {
+        "description": "HTTPGet specifies the http request to perform.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.HTTPGetAction"
+      },
+      "tcpSocket": {
+        "description": "TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported",
+        "$ref": "#/definitions/io.k8s.api.core.v1.TCPSocketAction"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.Lifecycle": {
+    "description": "Lifecycle describes actions that the management system should take in response to container lifecycle events. For the PostStart and PreStop lifecycle handlers, management of the container blocks until the action is complete, unless the container process fails, in which case the handler is aborted.",
+    "type": "object",
+    "properties": {
+      "postStart": {
+        "description": "PostStart is called immediately after a container is created. If the handler fails, the container is terminated and restarted according to its restart policy. Other management of the container blocks until the hook completes. More info: https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/#container-hooks",
+        "$ref": "#/definitions/io.k8s.api.core.v1.Handler"
+      },
+      "preStop": {
+        "description": "PreStop is called immediately before a container is terminated due to an API request or management event such as liveness/startup probe failure, preemption, resource contention, etc. The handler is not called if the container crashes or exits. The reason for termination is passed to the handler. The Pod's termination grace period countdown begins before the PreStop hooked is executed. Regardless of the outcome of the handler, the container will eventually terminate within the Pod's termination grace period. Other management of the container blocks until the hook completes or until the termination grace period is reached. More info: https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/#container-hooks",
+        "$ref": "#/definitions/io.k8s.api.core.v1.Handler"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.NodeAffinity": {
+    "description": "Node affinity is a group of node affinity scheduling rules.",
+    "type": "object",
+    "properties": {
+      "preferredDuringSchedulingIgnoredDuringExecution": {
+        "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.PreferredSchedulingTerm"
+        }
+      },
+      "requiredDuringSchedulingIgnoredDuringExecution": {
+        "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.NodeSelector"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.NodeSelector": {
+    "description": "A node selector represents the union of the results of one or more label queries over a set of nodes; that is, it represents the OR of the selectors represented by the node selector terms.",
+    "type": "object",
+    "required": [
+      "nodeSelectorTerms"
+    ],
+    "properties": {
+      "nodeSelectorTerms": {
+        "description": "Required. A list of node selector terms. The terms are ORed.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.NodeSelectorTerm"
+        }
+      }
+    }
+  },
+  "io.k8s.api.core.v1.NodeSelectorRequirement": {
+    "description": "A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+    "type": "object",
+    "required": [
+      "key",
+      "operator"
+    ],
+    "properties": {
+      "key": {
+        "description": "The label key that the selector applies to.",
+        "type": "string"
+      },
+      "operator": {
+        "description": "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+        "type": "string"
+      },
+      "values": {
+        "description": "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "io.k8s.api.core.v1.NodeSelectorTerm": {
+    "description": "A null or empty node selector term matches no objects. The requirements of them are ANDed. The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.",
+    "type": "object",
+    "properties": {
+      "matchExpressions": {
+        "description": "A list of node selector requirements by node's labels.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.NodeSelectorRequirement"
+        }
+      },
+      "matchFields": {
+        "description": "A list of node selector requirements by node's fields.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.NodeSelectorRequirement"
+        }
+      }
+    }
+  },
+  "io.k8s.api.core.v1.PodAffinity": {
+    "description": "Pod affinity is a group of inter pod affinity scheduling rules.",
+    "type": "object",
+    "properties": {
+      "preferredDuringSchedulingIgnoredDuringExecution": {
+        "description": "The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.WeightedPodAffinityTerm"
+        }
+      },
+      "requiredDuringSchedulingIgnoredDuringExecution": {
+        "description": "If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.PodAffinityTerm"
+        }
+      }
+    }
+  },
+  "io.k8s.api.core.v1.PodAffinityTerm": {
+    "description": "Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key \u003ctopologyKey\u003e matches that of any node on which a pod of the set of pods is running",
+    "type": "object",
+    "required": [
+      "topologyKey"
+    ],
+    "properties": {
+      "labelSelector": {
+        "description": "A label query over a set of resources, in this case pods.",
+        "$ref": "#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector"
+      },
+      "namespaces": {
+        "description": "namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means \"this pod's namespace\"",
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      },
+      "topologyKey": {
+        "description": "This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.PodAntiAffinity": {
+    "description": "Pod anti affinity is a group of inter pod anti affinity scheduling rules.",
+    "type": "object",
+    "properties": {
+      "preferredDuringSchedulingIgnoredDuringExecution": {
+        "description": "The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding \"weight\" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.WeightedPodAffinityTerm"
+        }
+      },
+      "requiredDuringSchedulingIgnoredDuringExecution": {
+        "description": "If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.api.core.v1.PodAffinityTerm"
+        }
+      }
+    }
+  },
+  "io.k8s.api.core.v1.PreferredSchedulingTerm": {
+    "description": "An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).",
+    "type": "object",
+    "required": [
+      "weight",
+      "preference"
+    ],
+    "properties": {
+      "preference": {
+        "description": "A node selector term, associated with the corresponding weight.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.NodeSelectorTerm"
+      },
+      "weight": {
+        "description": "Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.",
+        "type": "integer",
+        "format": "int32"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.Probe": {
+    "description": "Probe describes a health check to be performed against a container to determine whether it is alive or ready to receive traffic.",
+    "type": "object",
+    "properties": {
+      "exec": {
+        "description": "One and only one of the following should be specified. Exec specifies the action to take.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.ExecAction"
+      },
+      "failureThreshold": {
+        "description": "Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.",
+        "type": "integer",
+        "format": "int32"
+      },
+      "httpGet": {
+        "description": "HTTPGet specifies the http request to perform.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.HTTPGetAction"
+      },
+      "initialDelaySeconds": {
+        "description": "Number of seconds after the container has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+        "type": "integer",
+        "format": "int32"
+      },
+      "periodSeconds": {
+        "description": "How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.",
+        "type": "integer",
+        "format": "int32"
+      },
+      "successThreshold": {
+        "description": "Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness and startup. Minimum value is 1.",
+        "type": "integer",
+        "format": "int32"
+      },
+      "tcpSocket": {
+        "description": "TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported",
+        "$ref": "#/definitions/io.k8s.api.core.v1.TCPSocketAction"
+      },
+      "timeoutSeconds": {
+        "description": "Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+        "type": "integer",
+        "format": "int32"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.SELinuxOptions": {
+    "description": "SELinuxOptions are the labels to be applied to the container",
+    "type": "object",
+    "properties": {
+      "level": {
+        "description": "Level is SELinux level label that applies to the container.",
+        "type": "string"
+      },
+      "role": {
+        "description": "Role is a SELinux role label that applies to the container.",
+        "type": "string"
+      },
+      "type": {
+        "description": "Type is a SELinux type label that applies to the container.",
+        "type": "string"
+      },
+      "user": {
+        "description": "User is a SELinux user label that applies to the container.",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.SecretEnvSource": {
+    "description": "SecretEnvSource selects a Secret to populate the environment variables with.\n\nThe contents of the target Secret's Data field will represent the key-value pairs as environment variables.",
+    "type": "object",
+    "properties": {
+      "name": {
+        "description": "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+        "type": "string"
+      },
+      "optional": {
+        "description": "Specify whether the Secret must be defined",
+        "type": "boolean"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.SecurityContext": {
+    "description": "SecurityContext holds security configuration that will be applied to a container. Some fields are present in both SecurityContext and PodSecurityContext.  When both are set, the values in SecurityContext take precedence.",
+    "type": "object",
+    "properties": {
+      "allowPrivilegeEscalation": {
+        "description": "AllowPrivilegeEscalation controls whether a process can gain more privileges than its parent process. This bool directly controls if the no_new_privs flag will be set on the container process. AllowPrivilegeEscalation is true always when the container is: 1) run as Privileged 2) has CAP_SYS_ADMIN",
+        "type": "boolean"
+      },
+      "capabilities": {
+        "description": "The capabilities to add/drop when running containers. Defaults to the default set of capabilities granted by the container runtime.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.Capabilities"
+      },
+      "privileged": {
+        "description": "Run container in privileged mode. Processes in privileged containers are essentially equivalent to root on the host. Defaults to false.",
+        "type": "boolean"
+      },
+      "procMount": {
+        "description": "procMount denotes the type of proc mount to use for the containers. The default is DefaultProcMount which uses the container runtime defaults for readonly paths and masked paths. This requires the ProcMountType feature flag to be enabled.",
+        "type": "string"
+      },
+      "readOnlyRootFilesystem": {
+        "description": "Whether this container has a read-only root filesystem. Default is false.",
+        "type": "boolean"
+      },
+      "runAsGroup": {
+        "description": "The GID to run the entrypoint of the container process. Uses runtime default if unset. May also be set in PodSecurityContext.  If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+        "type": "integer",
+        "format": "int64"
+      },
+      "runAsNonRoot": {
+        "description": "Indicates that the container must run as a non-root user. If true, the Kubelet will validate the image at runtime to ensure that it does not run as UID 0 (root) and fail to start the container if it does. If unset or false, no such validation will be performed. May also be set in PodSecurityContext.  If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+        "type": "boolean"
+      },
+      "runAsUser": {
+        "description": "The UID to run the entrypoint of the container process. Defaults to user specified in image metadata if unspecified. May also be set in PodSecurityContext.  If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+        "type": "integer",
+        "format": "int64"
+      },
+      "seLinuxOptions": {
+        "description": "The SELinux context to be applied to the container. If unspecified, the container runtime will allocate a random SELinux context for each container.  May also be set in PodSecurityContext.  If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.SELinuxOptions"
+      },
+      "windowsOptions": {
+        "description": "The Windows specific settings applied to all containers. If unspecified, the options from the PodSecurityContext will be used. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.WindowsSecurityContextOptions"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.TCPSocketAction": {
+    "description": "TCPSocketAction describes an action based on opening a socket",
+    "type": "object",
+    "required": [
+      "port"
+    ],
+    "properties": {
+      "host": {
+        "description": "Optional: Host name to connect to, defaults to the pod IP.",
+        "type": "string"
+      },
+      "port": {
+        "description": "Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.",
+        "$ref": "#/definitions/io.k8s.apimachinery.pkg.util.intstr.IntOrString"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.Toleration": {
+    "description": "The pod this Toleration is attached to tolerates any taint that matches the triple \u003ckey,value,effect\u003e using the matching operator \u003coperator\u003e.",
+    "type": "object",
+    "properties": {
+      "effect": {
+        "description": "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+        "type": "string"
+      },
+      "key": {
+        "description": "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
+        "type": "string"
+      },
+      "operator": {
+        "description": "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
+        "type": "string"
+      },
+      "tolerationSeconds": {
+        "description": "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
+        "type": "integer",
+        "format": "int64"
+      },
+      "value": {
+        "description": "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.VolumeDevice": {
+    "description": "volumeDevice describes a mapping of a raw block device within a container.",
+    "type": "object",
+    "required": [
+      "name",
+      "devicePath"
+    ],
+    "properties": {
+      "devicePath": {
+        "description": "devicePath is the path inside of the container that the device will be mapped to.",
+        "type": "string"
+      },
+      "name": {
+        "description": "name must match the name of a persistentVolumeClaim in the pod",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.VolumeMount": {
+    "description": "VolumeMount describes a mounting of a Volume within a container.",
+    "type": "object",
+    "required": [
+      "name",
+      "mountPath"
+    ],
+    "properties": {
+      "mountPath": {
+        "description": "Path within the container at which the volume should be mounted.  Must not contain ':'.",
+        "type": "string"
+      },
+      "mountPropagation": {
+        "description": "mountPropagation determines how mounts are propagated from the host to container and the other way around. When not set, MountPropagationNone is used. This field is beta in 1.10.",
+        "type": "string"
+      },
+      "name": {
+        "description": "This must match the Name of a Volume.",
+        "type": "string"
+      },
+      "readOnly": {
+        "description": "Mounted read-only if true, read-write otherwise (false or unspecified). Defaults to false.",
+        "type": "boolean"
+      },
+      "subPath": {
+        "description": "Path within the volume from which the container's volume should be mounted. Defaults to \"\" (volume's root).",
+        "type": "string"
+      },
+      "subPathExpr": {
+        "description": "Expanded path within the volume from which the container's volume should be mounted. Behaves similarly to SubPath but environment variable references $(VAR_NAME) are expanded using the container's environment. Defaults to \"\" (volume's root). SubPathExpr and SubPath are mutually exclusive.",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.WeightedPodAffinityTerm": {
+    "description": "The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)",
+    "type": "object",
+    "required": [
+      "weight",
+      "podAffinityTerm"
+    ],
+    "properties": {
+      "podAffinityTerm": {
+        "description": "Required. A pod affinity term, associated with the corresponding weight.",
+        "$ref": "#/definitions/io.k8s.api.core.v1.PodAffinityTerm"
+      },
+      "weight": {
+        "description": "weight associated with matching the corresponding podAffinityTerm, in the range 1-100.",
+        "type": "integer",
+        "format": "int32"
+      }
+    }
+  },
+  "io.k8s.api.core.v1.WindowsSecurityContextOptions": {
+    "description": "WindowsSecurityContextOptions contain Windows-specific options and credentials.",
+    "type": "object",
+    "properties": {
+      "gmsaCredentialSpec": {
+        "description": "GMSACredentialSpec is where the GMSA admission webhook (https://github.com/kubernetes-sigs/windows-gmsa) inlines the contents of the GMSA credential spec named by the GMSACredentialSpecName field. This field is alpha-level and is only honored by servers that enable the WindowsGMSA feature flag.",
+        "type": "string"
+      },
+      "gmsaCredentialSpecName": {
+        "description": "GMSACredentialSpecName is the name of the GMSA credential spec to use. This field is alpha-level and is only honored by servers that enable the WindowsGMSA feature flag.",
+        "type": "string"
+      },
+      "runAsUserName": {
+        "description": "The UserName in Windows to run the entrypoint of the container process. Defaults to the user specified in image metadata if unspecified. May also be set in PodSecurityContext. If set in both SecurityContext and PodSecurityContext, the value specified in SecurityContext takes precedence. This field is beta-level and may be disabled with the WindowsRunAsUserName feature flag.",
+        "type": "string"
+      }
+    }
+  },
+  "io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector": {
+    "description": "A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. An empty label selector matches all objects. A null label selector matches no objects.",
+    "type": "object",
+    "properties": {
+      "matchExpressions": {
+        "description": "matchExpressions is a list of label selector requirements. The requirements are ANDed.",
+        "type": "array",
+        "items": {
+          "$ref": "#/definitions/io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement"
+        }
+      },
+      "matchLabels": {
+        "description": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is \"key\", the operator is \"In\", and the values array contains only \"value\". The requirements are ANDed.",
+        "type": "object",
+        "additionalProperties": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement": {
+    "description": "A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.",
+    "type": "object",
+    "required": [
+      "key",
+      "operator"
+    ],
+    "properties": {
+      "key": {
+        "description": "key is the label key that the selector applies to.",
+        "type": "string",
+        "x-kubernetes-patch-merge-key": "key",
+        "x-kubernetes-patch-strategy": "merge"
+      },
+      "operator": {
+        "description": "operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.",
+        "type": "string"
+      },
+      "values": {
+        "description": "values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.",
+        "type": "array",
+        "items": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "io.k8s.apimachinery.pkg.util.intstr.IntOrString": {
+    "description": "IntOrString is a type that can hold an int32 or a string.  When used in JSON or YAML marshalling and unmarshalling, it produces or consumes the inner type.  This allows you to have, for example, a JSON field that can accept a name or number.",
+    "type": "string",
+    "format": "int-or-string"
   }
 }`
 
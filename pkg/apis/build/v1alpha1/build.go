@@ -130,11 +130,23 @@ func (b *Build) NotaryV1Config() *NotaryV1Config {
 	return b.Spec.Notary.V1
 }
 
+func (b *Build) CosignConfig() *CosignConfig {
+	if b == nil || b.Spec.Signing == nil {
+		return nil
+	}
+	return b.Spec.Signing.Cosign
+}
+
 func (b *Build) rebasable(builderStack string) bool {
 	return b.Spec.LastBuild != nil &&
 		b.Annotations[BuildReasonAnnotation] == BuildReasonStack && b.Spec.LastBuild.StackId == builderStack
 }
 
+// Outdated is true when a successful build was not built with the run image and buildpacks the builder has now
+func (b *Build) Outdated(builder BuilderResource) bool {
+	return b.IsSuccess() && !(b.builtWithStack(builder.RunImage()) && b.builtWithBuildpacks(builder.BuildpackMetadata()))
+}
+
 func (b *Build) builtWithStack(runImage string) bool {
 	if b.Status.Stack.RunImage == "" {
 		return false
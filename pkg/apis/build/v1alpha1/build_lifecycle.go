@@ -1,11 +1,21 @@
 package v1alpha1
 
 import (
+	"fmt"
+
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	HookFailed = "HookFailed"
+	// BuildPending is the reason of builds waiting in the build admission queue to create their pod
+	BuildPending = "Pending"
+	// BuildQuotaExceeded is the reason of builds waiting for a BuildQuota of their namespace to allow their pod
+	BuildQuotaExceeded = "QuotaExceeded"
+)
+
 func (bs *BuildStatus) Error(err error) {
 	bs.Conditions = corev1alpha1.Conditions{
 		{
@@ -16,3 +26,31 @@ func (bs *BuildStatus) Error(err error) {
 		},
 	}
 }
+
+// Pending marks a build as waiting at a position of the build admission queue
+func (bs *BuildStatus) Pending(position int) {
+	bs.QueuePosition = position
+	bs.Conditions = corev1alpha1.Conditions{
+		{
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+			Type:               corev1alpha1.ConditionSucceeded,
+			Status:             corev1.ConditionUnknown,
+			Reason:             BuildPending,
+			Message:            fmt.Sprintf("waiting for a build slot at queue position %d", position),
+		},
+	}
+}
+
+// QuotaExceeded marks a build as waiting for the BuildQuota described by message
+func (bs *BuildStatus) QuotaExceeded(message string) {
+	bs.QueuePosition = 0
+	bs.Conditions = corev1alpha1.Conditions{
+		{
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+			Type:               corev1alpha1.ConditionSucceeded,
+			Status:             corev1.ConditionUnknown,
+			Reason:             BuildQuotaExceeded,
+			Message:            message,
+		},
+	}
+}
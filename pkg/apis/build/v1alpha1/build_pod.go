@@ -3,6 +3,8 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -22,6 +24,13 @@ const (
 	BuildLabel                   = "kpack.io/build"
 	DOCKERSecretAnnotationPrefix = "kpack.io/docker"
 	GITSecretAnnotationPrefix    = "kpack.io/git"
+	PrepareContainerName         = "prepare"
+	LabelContainerName           = "label"
+	CreateContainerName          = "create"
+	RebaseContainerName          = "rebase"
+	CompletionContainerName      = "completion"
+	PreBuildHookPrefix           = "pre-build-"
+	PostBuildHookPrefix          = "post-build-"
 
 	cacheDirName              = "cache-dir"
 	layersDirName             = "layers-dir"
@@ -32,15 +41,31 @@ const (
 	builderPullSecretsDirName = "builder-pull-secrets-dir"
 
 	notaryDirName = "notary-dir"
+	cosignDirName = "cosign-dir"
 	reportDirName = "report-dir"
+	tmpDirName    = "tmp-dir"
 
 	envVarBuildChanges = "BUILD_CHANGES"
+	envVarHookImage    = "KPACK_IMAGE"
+	envVarHookReport   = "KPACK_REPORT"
 )
 
 type BuildPodImages struct {
 	BuildInitImage  string
 	CompletionImage string
 	RebaseImage     string
+	Scheduling      BuildPodScheduling
+	StepResources   StepResources
+}
+
+// BuildPodScheduling holds cluster-wide scheduling defaults for build pods.
+// Values set on a Build take precedence over these defaults.
+type BuildPodScheduling struct {
+	Tolerations       []corev1.Toleration `json:"tolerations,omitempty"`
+	NodeSelector      map[string]string   `json:"nodeSelector,omitempty"`
+	Affinity          *corev1.Affinity    `json:"affinity,omitempty"`
+	PriorityClassName string              `json:"priorityClassName,omitempty"`
+	RuntimeClassName  *string             `json:"runtimeClassName,omitempty"`
 }
 
 type BuildPodBuilderConfig struct {
@@ -49,6 +74,8 @@ type BuildPodBuilderConfig struct {
 	Uid         int64
 	Gid         int64
 	PlatformAPI string
+	// LifecycleVersion is recorded in the provenance of signed builds
+	LifecycleVersion string
 }
 
 var (
@@ -76,6 +103,10 @@ var (
 		Name:      layersDirName,
 		MountPath: "/projectMetadata",
 	}
+	tmpVolume = corev1.VolumeMount{
+		Name:      tmpDirName,
+		MountPath: "/tmp",
+	}
 	homeEnv = corev1.EnvVar{
 		Name:  "HOME",
 		Value: "/builder/home",
@@ -95,28 +126,48 @@ var (
 		MountPath: "/var/notary/v1",
 		ReadOnly:  true,
 	}
+	cosignVolume = corev1.VolumeMount{
+		Name:      cosignDirName,
+		MountPath: "/var/cosign",
+		ReadOnly:  true,
+	}
 	reportVolume = corev1.VolumeMount{
 		Name:      reportDirName,
 		MountPath: "/var/report",
 		ReadOnly:  false,
 	}
+	readOnlyReportVolume = corev1.VolumeMount{
+		Name:      reportDirName,
+		MountPath: "/var/report",
+		ReadOnly:  true,
+	}
 )
 
 func (b *Build) BuildPod(config BuildPodImages, secrets []corev1.Secret, bc BuildPodBuilderConfig) (*corev1.Pod, error) {
-	if bc.unsupported() {
+	api, ok := lookupPlatformAPI(bc.PlatformAPI)
+	if !ok {
 		return nil, errors.Errorf("incompatible builder platform API version: %s", bc.PlatformAPI)
 	}
 
+	if bc.Uid == 0 {
+		return nil, errors.New("builder must not run as the root user")
+	}
+
 	if b.rebasable(bc.StackID) {
 		return b.rebasePod(secrets, config, bc)
 	}
 
+	if len(b.postBuildHooks()) > 0 && !api.separateReport {
+		return nil, errors.Errorf("post-build hooks require platform API 0.3 or newer, builder uses %s", bc.PlatformAPI)
+	}
+
 	envVars, err := json.Marshal(b.Spec.Env)
 	if err != nil {
 		return nil, err
 	}
 
 	secretVolumes, secretVolumeMounts, secretArgs := b.setupSecretVolumesAndArgs(secrets, gitAndDockerSecrets)
+	_, completionSecretVolumeMounts, completionSecretArgs := b.setupSecretVolumesAndArgs(secrets, dockerSecrets)
 
 	bindingVolumes, bindingVolumeMounts := b.setupBindings()
 
@@ -135,7 +186,9 @@ func (b *Build) BuildPod(config BuildPodImages, secrets []corev1.Secret, bc Buil
 			Labels: combine(b.Labels, map[string]string{
 				BuildLabel: b.Name,
 			}),
-			Annotations: b.Annotations,
+			Annotations: combine(b.Annotations, map[string]string{
+				corev1.SeccompPodAnnotationKey: corev1.SeccompProfileRuntimeDefault,
+			}),
 			OwnerReferences: []metav1.OwnerReference{
 				*kmeta.NewControllerRef(b),
 			},
@@ -144,20 +197,16 @@ func (b *Build) BuildPod(config BuildPodImages, secrets []corev1.Secret, bc Buil
 			// If the build fails, don't restart it.
 			RestartPolicy: corev1.RestartPolicyNever,
 			Containers: []corev1.Container{
-				b.completionContainer(config, secretArgs, secretVolumeMounts),
-			},
-			SecurityContext: &corev1.PodSecurityContext{
-				FSGroup: &bc.Gid,
+				b.completionContainer(config, bc, api.separateReport, completionSecretArgs, completionSecretVolumeMounts),
 			},
+			SecurityContext: podSecurityContext(bc),
 			InitContainers: steps(func(step func(corev1.Container)) {
 				step(
 					corev1.Container{
-						Name:  "prepare",
-						Image: config.BuildInitImage,
-						SecurityContext: &corev1.SecurityContext{
-							RunAsUser:  &bc.Uid,
-							RunAsGroup: &bc.Gid,
-						},
+						Name:            PrepareContainerName,
+						Image:           config.BuildInitImage,
+						Resources:       b.stepResources(config.StepResources, PrepareContainerName),
+						SecurityContext: containerSecurityContext(bc, true),
 						Args: args(a(
 							directExecute,
 							buildInitBinary),
@@ -192,14 +241,59 @@ func (b *Build) BuildPod(config BuildPodImages, secrets []corev1.Secret, bc Buil
 							sourceVolume,
 							homeVolume,
 							projectMetadataVolume,
+							tmpVolume,
 						),
 					},
 				)
+				for _, hook := range b.preBuildHooks() {
+					step(hookContainer(hook, PreBuildHookPrefix, bc, nil, workspaceVolume))
+				}
+				if b.Spec.Builder.Trusted && api.creator {
+					step(
+						corev1.Container{
+							Name:            CreateContainerName,
+							Image:           builderImage,
+							Resources:       b.stepResources(config.StepResources, CreateContainerName),
+							SecurityContext: containerSecurityContext(bc, false),
+							Command:         []string{"/cnb/lifecycle/creator"},
+							Args: args(a(
+								"-layers=/layers",
+								"-app=/workspace",
+							),
+								b.cacheArgs(),
+								a(
+									"-previous-image="+b.previousImage(),
+									"-project-metadata=/layers/project-metadata.toml",
+									"-report=/var/report/report.toml",
+								),
+								api.uidGidArgs(bc),
+								api.runImageArgs(bc),
+								api.processTypeArgs(b.Spec.DefaultProcess),
+								b.additionalTagArgs(),
+								a(b.Tag()),
+							),
+							VolumeMounts: append([]corev1.VolumeMount{
+								layersVolume,
+								platformVolume,
+								workspaceVolume,
+								homeVolume,
+								cacheVolume,
+								reportVolume,
+							}, bindingVolumeMounts...),
+							Env:             api.env(homeEnv),
+							ImagePullPolicy: corev1.PullIfNotPresent,
+						},
+					)
+					b.postBuildHookSteps(step, bc, workspaceVolume)
+					return
+				}
 				step(
 					corev1.Container{
-						Name:    "detect",
-						Image:   builderImage,
-						Command: []string{"/cnb/lifecycle/detector"},
+						Name:            "detect",
+						Image:           builderImage,
+						Resources:       b.stepResources(config.StepResources, "detect"),
+						SecurityContext: containerSecurityContext(bc, false),
+						Command:         []string{"/cnb/lifecycle/detector"},
 						Args: []string{
 							"-app=/workspace",
 							"-group=/layers/group.toml",
@@ -210,60 +304,62 @@ func (b *Build) BuildPod(config BuildPodImages, secrets []corev1.Secret, bc Buil
 							platformVolume,
 							workspaceVolume,
 						}, bindingVolumeMounts...),
+						Env:             api.env(),
 						ImagePullPolicy: corev1.PullIfNotPresent,
 					},
 				)
 				step(
 					corev1.Container{
-						Name:    "analyze",
-						Image:   builderImage,
-						Command: []string{"/cnb/lifecycle/analyzer"},
-						Args: []string{
+						Name:            "analyze",
+						Image:           builderImage,
+						Resources:       b.stepResources(config.StepResources, "analyze"),
+						SecurityContext: containerSecurityContext(bc, false),
+						Command:         []string{"/cnb/lifecycle/analyzer"},
+						Args: args(a(
 							"-layers=/layers",
 							"-group=/layers/group.toml",
 							"-analyzed=/layers/analyzed.toml",
-							"-cache-dir=/cache",
-							func() string {
-								if b.Spec.LastBuild != nil && b.Spec.LastBuild.Image != "" {
-									return b.Spec.LastBuild.Image
-								}
-								return b.Tag()
-							}(),
-						},
+						),
+							b.cacheArgs(),
+							api.uidGidArgs(bc),
+							a(b.previousImage()),
+						),
 						VolumeMounts: []corev1.VolumeMount{
 							layersVolume,
 							workspaceVolume,
 							homeVolume,
 							cacheVolume,
 						},
-						Env: []corev1.EnvVar{
-							homeEnv,
-						},
+						Env:             api.env(homeEnv),
 						ImagePullPolicy: corev1.PullIfNotPresent,
 					},
 				)
 				step(
 					corev1.Container{
-						Name:    "restore",
-						Image:   builderImage,
-						Command: []string{"/cnb/lifecycle/restorer"},
-						Args: []string{
+						Name:            "restore",
+						Image:           builderImage,
+						Resources:       b.stepResources(config.StepResources, "restore"),
+						SecurityContext: containerSecurityContext(bc, false),
+						Command:         []string{"/cnb/lifecycle/restorer"},
+						Args: args(a(
 							"-group=/layers/group.toml",
 							"-layers=/layers",
-							"-cache-dir=/cache",
-						},
-						VolumeMounts: []corev1.VolumeMount{
-							layersVolume,
-							cacheVolume,
-						},
+						),
+							b.cacheArgs(),
+							api.uidGidArgs(bc),
+						),
+						VolumeMounts:    b.restoreVolumeMounts(layersVolume),
+						Env:             api.env(b.restoreEnv()...),
 						ImagePullPolicy: corev1.PullIfNotPresent,
 					},
 				)
 				step(
 					corev1.Container{
-						Name:    "build",
-						Image:   builderImage,
-						Command: []string{"/cnb/lifecycle/builder"},
+						Name:            "build",
+						Image:           builderImage,
+						Resources:       b.stepResources(config.StepResources, "build"),
+						SecurityContext: containerSecurityContext(bc, false),
+						Command:         []string{"/cnb/lifecycle/builder"},
 						Args: []string{
 							"-layers=/layers",
 							"-app=/workspace",
@@ -275,68 +371,59 @@ func (b *Build) BuildPod(config BuildPodImages, secrets []corev1.Secret, bc Buil
 							platformVolume,
 							workspaceVolume,
 						}, bindingVolumeMounts...),
+						Env:             api.env(),
 						ImagePullPolicy: corev1.PullIfNotPresent,
 					},
 				)
-				if bc.legacy() {
-					step(
-						corev1.Container{
-							Name:    "export",
-							Image:   builderImage,
-							Command: []string{"/cnb/lifecycle/exporter"},
-							Args: append([]string{
-								"-layers=/layers",
-								"-app=/workspace",
-								"-group=/layers/group.toml",
-								"-analyzed=/layers/analyzed.toml",
-								"-cache-dir=/cache",
-							}, b.Spec.Tags...),
-							VolumeMounts: []corev1.VolumeMount{
-								layersVolume,
-								workspaceVolume,
-								homeVolume,
-								cacheVolume,
-							},
-							Env: []corev1.EnvVar{
-								homeEnv,
-							},
-							ImagePullPolicy: corev1.PullIfNotPresent,
-						},
-					)
-				} else {
-					step(
-						corev1.Container{
-							Name:    "export",
-							Image:   builderImage,
-							Command: []string{"/cnb/lifecycle/exporter"},
-							Args: append([]string{
-								"-layers=/layers",
-								"-app=/workspace",
-								"-group=/layers/group.toml",
-								"-analyzed=/layers/analyzed.toml",
-								"-cache-dir=/cache",
-								"-project-metadata=/layers/project-metadata.toml",
-								"-report=/var/report/report.toml",
-							}, b.Spec.Tags...),
-							VolumeMounts: []corev1.VolumeMount{
-								layersVolume,
-								workspaceVolume,
-								homeVolume,
-								cacheVolume,
-								reportVolume,
-							},
-							Env: []corev1.EnvVar{
-								homeEnv,
-							},
-							ImagePullPolicy: corev1.PullIfNotPresent,
-						},
-					)
-				}
+				step(
+					corev1.Container{
+						Name:            LabelContainerName,
+						Image:           config.BuildInitImage,
+						Resources:       b.stepResources(config.StepResources, LabelContainerName),
+						SecurityContext: containerSecurityContext(bc, true),
+						Args: a(
+							directExecute,
+							buildInitBinary,
+							"-label",
+						),
+						VolumeMounts:    []corev1.VolumeMount{layersVolume},
+						ImagePullPolicy: corev1.PullIfNotPresent,
+					},
+				)
+				step(
+					corev1.Container{
+						Name:            "export",
+						Image:           builderImage,
+						Resources:       b.stepResources(config.StepResources, "export"),
+						SecurityContext: containerSecurityContext(bc, false),
+						Command:         []string{"/cnb/lifecycle/exporter"},
+						Args: args(a(
+							"-layers=/layers",
+							"-app=/workspace",
+							"-group=/layers/group.toml",
+							"-analyzed=/layers/analyzed.toml",
+						),
+							b.cacheArgs(),
+							api.projectMetadataArgs(),
+							api.reportArgs(),
+							api.uidGidArgs(bc),
+							api.runImageArgs(bc),
+							api.processTypeArgs(b.Spec.DefaultProcess),
+							b.Spec.Tags,
+						),
+						VolumeMounts:    api.reportVolumeMounts(layersVolume, workspaceVolume, homeVolume, cacheVolume),
+						Env:             api.env(homeEnv),
+						ImagePullPolicy: corev1.PullIfNotPresent,
+					},
+				)
+				b.postBuildHookSteps(step, bc, workspaceVolume)
 			}),
 			ServiceAccountName: b.Spec.ServiceAccount,
-			NodeSelector: map[string]string{
-				"kubernetes.io/os": "linux",
-			},
+			NodeSelector:       b.nodeSelector(config.Scheduling),
+			Tolerations:        b.tolerations(config.Scheduling),
+			Affinity:           b.affinity(config.Scheduling),
+			PriorityClassName:  b.priorityClassName(config.Scheduling),
+			RuntimeClassName:   b.runtimeClassName(config.Scheduling),
 			Volumes: append(append(
 				secretVolumes,
 				corev1.Volume{
@@ -373,47 +460,212 @@ func (b *Build) BuildPod(config BuildPodImages, secrets []corev1.Secret, bc Buil
 						EmptyDir: &corev1.EmptyDirVolumeSource{},
 					},
 				},
+				corev1.Volume{
+					Name: tmpDirName,
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
 				b.Spec.Source.Source().ImagePullSecretsVolume(),
 				builderSecretVolume(b.Spec.Builder),
 				b.notarySecretVolume(),
-			), bindingVolumes...),
+			), append(bindingVolumes, b.cosignSecretVolumes()...)...),
 			ImagePullSecrets: b.Spec.Builder.ImagePullSecrets,
 		},
 	}, nil
 }
 
-func (b *Build) completionContainer(images BuildPodImages, secretArgs []string, secretVolumeMounts []corev1.VolumeMount) corev1.Container {
-	config := b.NotaryV1Config()
-	if config == nil {
-		return corev1.Container{
-			Name:            "completion",
-			Image:           images.CompletionImage,
-			ImagePullPolicy: corev1.PullIfNotPresent,
-			Resources:       b.Spec.Resources,
-		}
+func (b *Build) preBuildHooks() []corev1.Container {
+	if b.Spec.Hooks == nil {
+		return nil
+	}
+	return b.Spec.Hooks.PreBuild
+}
+
+func (b *Build) postBuildHooks() []corev1.Container {
+	if b.Spec.Hooks == nil {
+		return nil
+	}
+	return b.Spec.Hooks.PostBuild
+}
+
+// postBuildHookSteps runs the post-build hooks with the exported image and the report describing its digest
+func (b *Build) postBuildHookSteps(step func(corev1.Container), bc BuildPodBuilderConfig, workspaceVolume corev1.VolumeMount) {
+	env := []corev1.EnvVar{
+		{
+			Name:  envVarHookImage,
+			Value: b.Tag(),
+		},
+		{
+			Name:  envVarHookReport,
+			Value: "/var/report/report.toml",
+		},
+	}
+	for _, hook := range b.postBuildHooks() {
+		step(hookContainer(hook, PostBuildHookPrefix, bc, env, workspaceVolume, readOnlyReportVolume))
+	}
+}
+
+// hookContainer runs a user defined hook under the same restrictions as the lifecycle containers
+func hookContainer(hook corev1.Container, prefix string, bc BuildPodBuilderConfig, env []corev1.EnvVar, volumeMounts ...corev1.VolumeMount) corev1.Container {
+	container := hook.DeepCopy()
+	container.Name = prefix + hook.Name
+	container.SecurityContext = containerSecurityContext(bc, false)
+	container.Env = append(container.Env, env...)
+	container.VolumeMounts = volumeMounts
+	if container.WorkingDir == "" {
+		container.WorkingDir = "/workspace"
+	}
+	if container.ImagePullPolicy == "" {
+		container.ImagePullPolicy = corev1.PullIfNotPresent
+	}
+	return *container
+}
+
+func (b *Build) previousImage() string {
+	if b.Spec.LastBuild != nil && b.Spec.LastBuild.Image != "" {
+		return b.Spec.LastBuild.Image
+	}
+	return b.Tag()
+}
+
+// additionalTagArgs passes every tag but the first to the creator which takes the image name as its only argument
+func (b *Build) additionalTagArgs() []string {
+	var tagArgs []string
+	for _, tag := range b.Spec.Tags[1:] {
+		tagArgs = append(tagArgs, "-tag="+tag)
+	}
+	return tagArgs
+}
+
+// completionContainer publishes the SBOM only when the export or rebase step writes a report, the lifecycle does not before platform API 0.3
+func (b *Build) completionContainer(images BuildPodImages, bc BuildPodBuilderConfig, report bool, secretArgs []string, secretVolumeMounts []corev1.VolumeMount) corev1.Container {
+	args := a(directExecute, completionBinary)
+	volumeMounts := append([]corev1.VolumeMount{}, secretVolumeMounts...)
+
+	if report {
+		args = append(args, "-publish-sbom")
+	}
+
+	if config := b.NotaryV1Config(); config != nil {
+		args = append(append(args, "-notary-v1-url="+config.URL), notaryV1Args(config)...)
+		volumeMounts = append(volumeMounts, notaryV1Volume)
+	}
+
+	if b.CosignConfig() != nil {
+		args = append(append(args, "-cosign"), b.provenanceArgs(bc)...)
+		volumeMounts = append(volumeMounts, cosignVolume)
 	}
 
 	return corev1.Container{
-		Name:  "completion",
-		Image: images.CompletionImage,
-		Args: append(
-			[]string{
-				directExecute,
-				completionBinary,
-				"-notary-v1-url=" + config.URL,
-			},
-			secretArgs...,
-		),
-		Resources: b.Spec.Resources,
-		VolumeMounts: append(
-			secretVolumeMounts,
-			notaryV1Volume,
-			reportVolume,
-		),
+		Name:            CompletionContainerName,
+		Image:           images.CompletionImage,
+		Args:            append(args, secretArgs...),
+		Resources:       b.stepResources(images.StepResources, CompletionContainerName),
+		SecurityContext: containerSecurityContext(bc, true),
+		VolumeMounts:    append(volumeMounts, reportVolume, tmpVolume),
 		ImagePullPolicy: corev1.PullIfNotPresent,
 	}
 }
 
+func notaryV1Args(config *NotaryV1Config) []string {
+	var args []string
+	if config.Role != "" {
+		args = append(args, "-notary-v1-role="+config.Role)
+	}
+	if len(config.Tags) > 0 {
+		args = append(args, "-notary-v1-tags="+strings.Join(config.Tags, ","))
+	}
+	if len(config.RootKeyIDs) > 0 {
+		args = append(args, "-notary-v1-root-key-ids="+strings.Join(config.RootKeyIDs, ","))
+	}
+	return args
+}
+
+// provenanceArgs passes the build inputs the completion step records in the provenance attestation
+func (b *Build) provenanceArgs(bc BuildPodBuilderConfig) []string {
+	args := a(
+		"-build="+b.Namespace+"/"+b.Name,
+		"-builder-image="+b.Spec.Builder.Image,
+		"-lifecycle-version="+bc.LifecycleVersion,
+	)
+
+	if !b.CreationTimestamp.IsZero() {
+		args = append(args, "-build-started="+b.CreationTimestamp.UTC().Format(time.RFC3339))
+	}
+
+	if url := b.sourceURL(); url != "" {
+		args = append(args, "-source-url="+url)
+	}
+
+	if digest := b.sourceDigest(); digest != "" {
+		args = append(args, "-source-digest="+digest)
+	}
+
+	if len(b.Spec.Env) > 0 {
+		names := make([]string, 0, len(b.Spec.Env))
+		for _, env := range b.Spec.Env {
+			names = append(names, env.Name)
+		}
+		args = append(args, "-env-names="+strings.Join(names, ","))
+	}
+
+	if len(b.Spec.Bindings) > 0 {
+		names := make([]string, 0, len(b.Spec.Bindings))
+		for _, binding := range b.Spec.Bindings {
+			names = append(names, binding.Name)
+		}
+		args = append(args, "-binding-names="+strings.Join(names, ","))
+	}
+
+	return args
+}
+
+// sourceURL is the location of the build source
+func (b *Build) sourceURL() string {
+	source := b.Spec.Source
+	switch {
+	case source.Git != nil:
+		return source.Git.URL
+	case source.Blob != nil:
+		return source.Blob.URL
+	case source.Registry != nil:
+		return source.Registry.Image
+	case source.Artifact != nil:
+		return source.Artifact.URL
+	default:
+		return ""
+	}
+}
+
+// sourceDigest is the algorithm prefixed digest of the resolved source, blobs are not resolved to a digest
+func (b *Build) sourceDigest() string {
+	source := b.Spec.Source
+	switch {
+	case source.Git != nil:
+		return "sha1:" + source.Git.Revision
+	case source.Registry != nil:
+		if i := strings.Index(source.Registry.Image, "@"); i != -1 {
+			return source.Registry.Image[i+1:]
+		}
+		return ""
+	case source.Artifact != nil:
+		checksum := source.Artifact.Checksum
+		switch {
+		case checksum == "" || strings.Contains(checksum, ":"):
+			return checksum
+		case len(checksum) == 64:
+			return "sha256:" + checksum
+		case len(checksum) == 40:
+			return "sha1:" + checksum
+		default:
+			return ""
+		}
+	default:
+		return ""
+	}
+}
+
 func (b *Build) notarySecretVolume() corev1.Volume {
 	config := b.NotaryV1Config()
 	if config == nil {
@@ -436,6 +688,25 @@ func (b *Build) notarySecretVolume() corev1.Volume {
 	}
 }
 
+// cosignSecretVolumes mounts the cosign key secret when the build is signed with cosign
+func (b *Build) cosignSecretVolumes() []corev1.Volume {
+	config := b.CosignConfig()
+	if config == nil {
+		return nil
+	}
+
+	return []corev1.Volume{
+		{
+			Name: cosignDirName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: config.SecretRef.Name,
+				},
+			},
+		},
+	}
+}
+
 func (b *Build) rebasePod(secrets []corev1.Secret, config BuildPodImages, buildPodBuilderConfig BuildPodBuilderConfig) (*corev1.Pod, error) {
 	secretVolumes, secretVolumeMounts, secretArgs := b.setupSecretVolumesAndArgs(secrets, dockerSecrets)
 
@@ -446,17 +717,21 @@ func (b *Build) rebasePod(secrets []corev1.Secret, config BuildPodImages, buildP
 			Labels: combine(b.Labels, map[string]string{
 				BuildLabel: b.Name,
 			}),
-			Annotations: b.Annotations,
+			Annotations: combine(b.Annotations, map[string]string{
+				corev1.SeccompPodAnnotationKey: corev1.SeccompProfileRuntimeDefault,
+			}),
 			OwnerReferences: []metav1.OwnerReference{
 				*kmeta.NewControllerRef(b),
 			},
 		},
 		Spec: corev1.PodSpec{
 			ServiceAccountName: b.Spec.ServiceAccount,
-			NodeSelector: map[string]string{
-				"kubernetes.io/os": "linux",
-			},
-			Volumes: append(
+			NodeSelector:       b.nodeSelector(config.Scheduling),
+			Tolerations:        b.tolerations(config.Scheduling),
+			Affinity:           b.affinity(config.Scheduling),
+			PriorityClassName:  b.priorityClassName(config.Scheduling),
+			RuntimeClassName:   b.runtimeClassName(config.Scheduling),
+			Volumes: append(append(
 				secretVolumes,
 				corev1.Volume{
 					Name: reportDirName,
@@ -464,16 +739,25 @@ func (b *Build) rebasePod(secrets []corev1.Secret, config BuildPodImages, buildP
 						EmptyDir: &corev1.EmptyDirVolumeSource{},
 					},
 				},
+				corev1.Volume{
+					Name: tmpDirName,
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
 				b.notarySecretVolume(),
-			),
-			RestartPolicy: corev1.RestartPolicyNever,
+			), b.cosignSecretVolumes()...),
+			RestartPolicy:   corev1.RestartPolicyNever,
+			SecurityContext: podSecurityContext(buildPodBuilderConfig),
 			Containers: []corev1.Container{
-				b.completionContainer(config, secretArgs, secretVolumeMounts),
+				b.completionContainer(config, buildPodBuilderConfig, true, secretArgs, secretVolumeMounts),
 			},
 			InitContainers: []corev1.Container{
 				{
-					Name:  "rebase",
-					Image: config.RebaseImage,
+					Name:            RebaseContainerName,
+					Image:           config.RebaseImage,
+					Resources:       b.stepResources(config.StepResources, RebaseContainerName),
+					SecurityContext: containerSecurityContext(buildPodBuilderConfig, true),
 					Args: args(a(
 						directExecute,
 						rebaseBinary,
@@ -495,7 +779,7 @@ func (b *Build) rebasePod(secrets []corev1.Secret, config BuildPodImages, buildP
 					},
 					ImagePullPolicy: corev1.PullIfNotPresent,
 					WorkingDir:      "/workspace",
-					VolumeMounts:    append(secretVolumeMounts, reportVolume),
+					VolumeMounts:    append(secretVolumeMounts, reportVolume, tmpVolume),
 				},
 			},
 		},
@@ -503,6 +787,132 @@ func (b *Build) rebasePod(secrets []corev1.Secret, config BuildPodImages, buildP
 	}, nil
 }
 
+// stepResources returns the resources of a build pod step. Resources set on the Build take
+// precedence over the defaults and the creator uses the resources of the build step.
+func (b *Build) stepResources(defaults StepResources, step string) corev1.ResourceRequirements {
+	if b.Spec.StepResources != nil {
+		if resources := b.Spec.StepResources.forStep(step); resources != nil {
+			return *resources.DeepCopy()
+		}
+	}
+
+	// resources predate step resources and only ever applied to completion
+	if step == "completion" && (len(b.Spec.Resources.Limits) > 0 || len(b.Spec.Resources.Requests) > 0) {
+		return *b.Spec.Resources.DeepCopy()
+	}
+
+	if resources := defaults.forStep(step); resources != nil {
+		return *resources.DeepCopy()
+	}
+	return corev1.ResourceRequirements{}
+}
+
+func (s *StepResources) forStep(step string) *corev1.ResourceRequirements {
+	switch step {
+	case PrepareContainerName, LabelContainerName:
+		return s.Prepare
+	case "detect":
+		return s.Detect
+	case "analyze":
+		return s.Analyze
+	case "restore":
+		return s.Restore
+	case "build", CreateContainerName:
+		return s.Build
+	case "export":
+		return s.Export
+	case "rebase":
+		return s.Rebase
+	case "completion":
+		return s.Completion
+	default:
+		return nil
+	}
+}
+
+func (b *Build) nodeSelector(defaults BuildPodScheduling) map[string]string {
+	return combine(combine(defaults.NodeSelector, b.Spec.NodeSelector), map[string]string{
+		"kubernetes.io/os": "linux",
+	})
+}
+
+func (b *Build) tolerations(defaults BuildPodScheduling) []corev1.Toleration {
+	if len(defaults.Tolerations) == 0 && len(b.Spec.Tolerations) == 0 {
+		return nil
+	}
+	return append(append([]corev1.Toleration{}, defaults.Tolerations...), b.Spec.Tolerations...)
+}
+
+func (b *Build) affinity(defaults BuildPodScheduling) *corev1.Affinity {
+	if b.Spec.Affinity != nil {
+		return b.Spec.Affinity
+	}
+	return defaults.Affinity
+}
+
+func (b *Build) priorityClassName(defaults BuildPodScheduling) string {
+	if b.Spec.PriorityClassName != "" {
+		return b.Spec.PriorityClassName
+	}
+	return defaults.PriorityClassName
+}
+
+func (b *Build) runtimeClassName(defaults BuildPodScheduling) *string {
+	if b.Spec.RuntimeClassName != nil {
+		return b.Spec.RuntimeClassName
+	}
+	return defaults.RuntimeClassName
+}
+
+// podSecurityContext runs every step as the non-root CNB user of the builder
+func podSecurityContext(bc BuildPodBuilderConfig) *corev1.PodSecurityContext {
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot: boolPointer(true),
+		RunAsUser:    &bc.Uid,
+		RunAsGroup:   &bc.Gid,
+		FSGroup:      &bc.Gid,
+	}
+}
+
+// containerSecurityContext satisfies the restricted pod security standard. Containers
+// running buildpack code keep a writable root filesystem as buildpacks may write outside of the mounted volumes.
+func containerSecurityContext(bc BuildPodBuilderConfig, readOnlyRootFilesystem bool) *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		RunAsNonRoot:             boolPointer(true),
+		RunAsUser:                &bc.Uid,
+		RunAsGroup:               &bc.Gid,
+		AllowPrivilegeEscalation: boolPointer(false),
+		ReadOnlyRootFilesystem:   boolPointer(readOnlyRootFilesystem),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
+
+// cacheArgs points the lifecycle at the registry cache when the build has one and at the cache volume otherwise
+func (b *Build) cacheArgs() []string {
+	if b.Spec.CacheImage != "" {
+		return a("-cache-image=" + b.Spec.CacheImage)
+	}
+	return a("-cache-dir=/cache")
+}
+
+// restoreVolumeMounts gives the restorer the registry credentials in the home directory instead of the
+// cache volume when it restores from the registry cache
+func (b *Build) restoreVolumeMounts(volumeMounts ...corev1.VolumeMount) []corev1.VolumeMount {
+	if b.Spec.CacheImage != "" {
+		return append(volumeMounts, homeVolume)
+	}
+	return append(volumeMounts, cacheVolume)
+}
+
+func (b *Build) restoreEnv() []corev1.EnvVar {
+	if b.Spec.CacheImage != "" {
+		return []corev1.EnvVar{homeEnv}
+	}
+	return nil
+}
+
 func (b *Build) cacheVolume() corev1.VolumeSource {
 	if b.Spec.CacheName != "" {
 		return corev1.VolumeSource{
@@ -620,14 +1030,6 @@ func (b *Build) setupBindings() ([]corev1.Volume, []corev1.VolumeMount) {
 	return volumes, volumeMounts
 }
 
-func (bc *BuildPodBuilderConfig) legacy() bool {
-	return bc.PlatformAPI == "0.2"
-}
-
-func (bc *BuildPodBuilderConfig) unsupported() bool {
-	return bc.PlatformAPI != "0.2" && bc.PlatformAPI != "0.3"
-}
-
 func builderSecretVolume(bbs BuildBuilderSpec) corev1.Volume {
 	if len(bbs.ImagePullSecrets) > 0 {
 		return corev1.Volume{
@@ -667,3 +1069,7 @@ func steps(f func(step func(corev1.Container))) []corev1.Container {
 	})
 	return containers
 }
+
+func boolPointer(b bool) *bool {
+	return &b
+}
@@ -176,6 +176,21 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 		PlatformAPI: "0.2",
 	}
 
+	restrictedSecurityContext := func(readOnlyRootFilesystem bool) *corev1.SecurityContext {
+		nonRoot, allowPrivilegeEscalation := true, false
+		uid, gid := int64(2000), int64(3000)
+		return &corev1.SecurityContext{
+			RunAsNonRoot:             &nonRoot,
+			RunAsUser:                &uid,
+			RunAsGroup:               &gid,
+			AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+			ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		}
+	}
+
 	when("BuildPod", func() {
 		when(">= 0.2 platform api", func() {
 			it("creates a pod with a builder owner reference and build labels and annotations", func() {
@@ -190,7 +205,8 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 						"kpack.io/build": buildName,
 					},
 					Annotations: map[string]string{
-						"some/annotation": "to-pass-through",
+						"some/annotation":              "to-pass-through",
+						corev1.SeccompPodAnnotationKey: corev1.SeccompProfileRuntimeDefault,
 					},
 					OwnerReferences: []metav1.OwnerReference{
 						*kmeta.NewControllerRef(build),
@@ -212,6 +228,69 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				assert.Equal(t, map[string]string{"kubernetes.io/os": "linux"}, pod.Spec.NodeSelector)
 			})
 
+			it("configures the pod scheduling from the build", func() {
+				runtimeClass := "some-runtime-class"
+				build.Spec.Tolerations = []corev1.Toleration{
+					{Key: "builds", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+				}
+				build.Spec.NodeSelector = map[string]string{"pool": "builds"}
+				build.Spec.Affinity = &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+							{
+								Weight: 1,
+								Preference: corev1.NodeSelectorTerm{
+									MatchExpressions: []corev1.NodeSelectorRequirement{
+										{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}},
+									},
+								},
+							},
+						},
+					},
+				}
+				build.Spec.PriorityClassName = "some-priority-class"
+				build.Spec.RuntimeClassName = &runtimeClass
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Equal(t, build.Spec.Tolerations, pod.Spec.Tolerations)
+				assert.Equal(t, map[string]string{"kubernetes.io/os": "linux", "pool": "builds"}, pod.Spec.NodeSelector)
+				assert.Equal(t, build.Spec.Affinity, pod.Spec.Affinity)
+				assert.Equal(t, "some-priority-class", pod.Spec.PriorityClassName)
+				assert.Equal(t, &runtimeClass, pod.Spec.RuntimeClassName)
+			})
+
+			it("merges the build pod scheduling defaults with the build", func() {
+				defaultRuntimeClass := "default-runtime-class"
+				config.Scheduling = v1alpha1.BuildPodScheduling{
+					Tolerations: []corev1.Toleration{
+						{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "kpack", Effect: corev1.TaintEffectNoSchedule},
+					},
+					NodeSelector:      map[string]string{"pool": "default", "disk": "ssd", "kubernetes.io/os": "windows"},
+					Affinity:          &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{}},
+					PriorityClassName: "default-priority-class",
+					RuntimeClassName:  &defaultRuntimeClass,
+				}
+				build.Spec.Tolerations = []corev1.Toleration{
+					{Key: "builds", Operator: corev1.TolerationOpExists},
+				}
+				build.Spec.NodeSelector = map[string]string{"pool": "builds"}
+				build.Spec.PriorityClassName = "some-priority-class"
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Equal(t, []corev1.Toleration{
+					{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "kpack", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "builds", Operator: corev1.TolerationOpExists},
+				}, pod.Spec.Tolerations)
+				assert.Equal(t, map[string]string{"kubernetes.io/os": "linux", "pool": "builds", "disk": "ssd"}, pod.Spec.NodeSelector)
+				assert.Equal(t, config.Scheduling.Affinity, pod.Spec.Affinity)
+				assert.Equal(t, "some-priority-class", pod.Spec.PriorityClassName)
+				assert.Equal(t, &defaultRuntimeClass, pod.Spec.RuntimeClassName)
+			})
+
 			it("configures the FS Mount Group with the supplied group", func() {
 				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
 				require.NoError(t, err)
@@ -234,6 +313,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 					"analyze",
 					"restore",
 					"build",
+					"label",
 					"export",
 				}, names)
 			})
@@ -438,6 +518,33 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 					})
 			})
 
+			it("configures prepare with the artifact source", func() {
+				build.Spec.Source.Git = nil
+				build.Spec.Source.Artifact = &v1alpha1.Artifact{
+					URL:      "http://source-controller.flux-system/some-repo/abcdef.tar.gz",
+					Revision: "main/abcdef",
+					Checksum: "sha256:4f1b1e5d8a2c",
+				}
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Equal(t, "prepare", pod.Spec.InitContainers[0].Name)
+				assert.Subset(t, pod.Spec.InitContainers[0].Env, []corev1.EnvVar{
+					{
+						Name:  "ARTIFACT_URL",
+						Value: "http://source-controller.flux-system/some-repo/abcdef.tar.gz",
+					},
+					{
+						Name:  "ARTIFACT_REVISION",
+						Value: "main/abcdef",
+					},
+					{
+						Name:  "ARTIFACT_CHECKSUM",
+						Value: "sha256:4f1b1e5d8a2c",
+					},
+				})
+			})
+
 			it("configures prepare with the registry source and empty imagePullSecrets when not provided", func() {
 				build.Spec.Source.Git = nil
 				build.Spec.Source.Blob = nil
@@ -604,13 +711,24 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				}))
 			})
 
+			it("configures the label step to add the source labels before export", func() {
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				label := pod.Spec.InitContainers[5]
+				assert.Equal(t, "label", label.Name)
+				assert.Equal(t, config.BuildInitImage, label.Image)
+				assert.Equal(t, []string{"--", "build-init", "-label"}, label.Args)
+				assert.Equal(t, []string{"layers-dir"}, names(label.VolumeMounts))
+			})
+
 			it("configures export step", func() {
 				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
 				require.NoError(t, err)
 
-				assert.Equal(t, pod.Spec.InitContainers[5].Name, "export")
-				assert.Equal(t, pod.Spec.InitContainers[5].Image, builderImage)
-				assert.Equal(t, names(pod.Spec.InitContainers[5].VolumeMounts), []string{
+				assert.Equal(t, pod.Spec.InitContainers[6].Name, "export")
+				assert.Equal(t, pod.Spec.InitContainers[6].Image, builderImage)
+				assert.Equal(t, names(pod.Spec.InitContainers[6].VolumeMounts), []string{
 					"layers-dir",
 					"workspace-dir",
 					"home-dir",
@@ -625,7 +743,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 					build.Tag(),
 					"someimage/name:tag2",
 					"someimage/name:tag3",
-				}, pod.Spec.InitContainers[5].Args)
+				}, pod.Spec.InitContainers[6].Args)
 			})
 
 			it("configures the builder image in all lifecycle steps", func() {
@@ -633,7 +751,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				require.NoError(t, err)
 
 				for _, container := range pod.Spec.InitContainers {
-					if container.Name != "prepare" {
+					if container.Name != "prepare" && container.Name != "label" {
 						assert.Equal(t, builderImage, container.Image, fmt.Sprintf("image on container '%s'", container.Name))
 					}
 				}
@@ -647,11 +765,98 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				assert.Equal(t, resources, completionContainer.Resources)
 			})
 
+			when("step resources are configured", func() {
+				memory := func(quantity string) *corev1.ResourceRequirements {
+					return &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse(quantity)},
+					}
+				}
+
+				config.StepResources = v1alpha1.StepResources{
+					Prepare:    memory("100M"),
+					Detect:     memory("200M"),
+					Build:      memory("1G"),
+					Export:     memory("500M"),
+					Rebase:     memory("300M"),
+					Completion: memory("150M"),
+				}
+
+				build.Spec.StepResources = &v1alpha1.StepResources{
+					Build:   memory("4G"),
+					Analyze: memory("250M"),
+				}
+
+				it("applies step resources on the build over the defaults", func() {
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					containers := map[string]corev1.Container{}
+					for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+						containers[container.Name] = container
+					}
+
+					assert.Equal(t, *memory("100M"), containers["prepare"].Resources)
+					assert.Equal(t, *memory("200M"), containers["detect"].Resources)
+					assert.Equal(t, *memory("250M"), containers["analyze"].Resources)
+					assert.Equal(t, corev1.ResourceRequirements{}, containers["restore"].Resources)
+					assert.Equal(t, *memory("4G"), containers["build"].Resources)
+					assert.Equal(t, *memory("500M"), containers["export"].Resources)
+				})
+
+				it("prefers the build resources over the default completion resources", func() {
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					assert.Equal(t, resources, pod.Spec.Containers[0].Resources)
+				})
+
+				it("applies the completion step resources over the build resources", func() {
+					build.Spec.StepResources.Completion = memory("175M")
+
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					assert.Equal(t, *memory("175M"), pod.Spec.Containers[0].Resources)
+				})
+
+				it("applies the default completion resources when the build has no resources", func() {
+					build.Spec.Resources = corev1.ResourceRequirements{}
+
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					assert.Equal(t, *memory("150M"), pod.Spec.Containers[0].Resources)
+				})
+
+				it("applies the build step resources to the creator", func() {
+					buildPodBuilderConfig.PlatformAPI = "0.3"
+					build.Spec.Builder.Trusted = true
+
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					assert.Equal(t, "create", pod.Spec.InitContainers[1].Name)
+					assert.Equal(t, *memory("4G"), pod.Spec.InitContainers[1].Resources)
+				})
+
+				it("applies the rebase step resources to the rebase pod", func() {
+					build.Annotations = map[string]string{
+						v1alpha1.BuildReasonAnnotation: v1alpha1.BuildReasonStack,
+					}
+
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					assert.Equal(t, "rebase", pod.Spec.InitContainers[0].Name)
+					assert.Equal(t, *memory("300M"), pod.Spec.InitContainers[0].Resources)
+				})
+			})
+
 			it("creates a pod with reusable cache when name is provided", func() {
 				pod, err := build.BuildPod(config, nil, buildPodBuilderConfig)
 				require.NoError(t, err)
 
-				require.Len(t, pod.Spec.Volumes, 12)
+				require.Len(t, pod.Spec.Volumes, 13)
 				assert.Equal(t, corev1.Volume{
 					Name: "cache-dir",
 					VolumeSource: corev1.VolumeSource{
@@ -665,7 +870,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				pod, err := build.BuildPod(config, nil, buildPodBuilderConfig)
 				require.NoError(t, err)
 
-				require.Len(t, pod.Spec.Volumes, 12)
+				require.Len(t, pod.Spec.Volumes, 13)
 				assert.Equal(t, corev1.Volume{
 					Name: "cache-dir",
 					VolumeSource: corev1.VolumeSource{
@@ -674,6 +879,25 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				}, pod.Spec.Volumes[0])
 			})
 
+			it("uses the registry cache when a cache image is provided", func() {
+				build.Spec.CacheName = ""
+				build.Spec.CacheImage = "someimage/cache:latest"
+				pod, err := build.BuildPod(config, nil, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				containers := map[string]corev1.Container{}
+				for _, container := range pod.Spec.InitContainers {
+					containers[container.Name] = container
+				}
+
+				for _, phase := range []string{"analyze", "restore", "export"} {
+					assert.Contains(t, containers[phase].Args, "-cache-image=someimage/cache:latest")
+					assert.NotContains(t, containers[phase].Args, "-cache-dir=/cache")
+				}
+				assert.Equal(t, []string{"layers-dir", "home-dir"}, names(containers["restore"].VolumeMounts))
+				assert.Contains(t, containers["restore"].Env, corev1.EnvVar{Name: "HOME", Value: "/builder/home"})
+			})
+
 			it("attach volumes for secrets", func() {
 				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
 				require.NoError(t, err)
@@ -710,9 +934,9 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
 				require.NoError(t, err)
 
-				assert.Equal(t, pod.Spec.InitContainers[5].Name, "export")
-				assert.Equal(t, pod.Spec.InitContainers[5].Image, builderImage)
-				assert.Equal(t, names(pod.Spec.InitContainers[5].VolumeMounts), []string{
+				assert.Equal(t, pod.Spec.InitContainers[6].Name, "export")
+				assert.Equal(t, pod.Spec.InitContainers[6].Image, builderImage)
+				assert.Equal(t, names(pod.Spec.InitContainers[6].VolumeMounts), []string{
 					"layers-dir",
 					"workspace-dir",
 					"home-dir",
@@ -730,7 +954,362 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 					build.Tag(),
 					"someimage/name:tag2",
 					"someimage/name:tag3",
-				}, pod.Spec.InitContainers[5].Args)
+				}, pod.Spec.InitContainers[6].Args)
+			})
+		})
+
+		when("hooks are configured", func() {
+			buildPodBuilderConfig.PlatformAPI = "0.3"
+			build.Spec.Source.SubPath = "some/src/path"
+			build.Spec.Hooks = &v1alpha1.BuildHooks{
+				PreBuild: []corev1.Container{
+					{
+						Name:    "unit-tests",
+						Image:   "some/test-runner",
+						Command: []string{"make", "test"},
+						Env:     []corev1.EnvVar{{Name: "SOME_ENV", Value: "some-value"}},
+					},
+				},
+				PostBuild: []corev1.Container{
+					{
+						Name:       "smoke-tests",
+						Image:      "some/smoke-tests",
+						WorkingDir: "/workspace/smoke",
+					},
+				},
+			}
+
+			containerNames := func(containers []corev1.Container) []string {
+				var names []string
+				for _, container := range containers {
+					names = append(names, container.Name)
+				}
+				return names
+			}
+
+			it("runs pre-build hooks on the source before detection", func() {
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Equal(t, []string{"prepare", "pre-build-unit-tests", "detect", "analyze", "restore", "build", "label", "export", "post-build-smoke-tests"}, containerNames(pod.Spec.InitContainers))
+
+				hook := pod.Spec.InitContainers[1]
+				assert.Equal(t, "some/test-runner", hook.Image)
+				assert.Equal(t, []string{"make", "test"}, hook.Command)
+				assert.Equal(t, []corev1.EnvVar{{Name: "SOME_ENV", Value: "some-value"}}, hook.Env)
+				assert.Equal(t, "/workspace", hook.WorkingDir)
+				assert.Equal(t, corev1.PullIfNotPresent, hook.ImagePullPolicy)
+				assert.Equal(t, restrictedSecurityContext(false), hook.SecurityContext)
+				assert.Equal(t, []corev1.VolumeMount{
+					{
+						Name:      "workspace-dir",
+						MountPath: "/workspace",
+						SubPath:   "some/src/path",
+					},
+				}, hook.VolumeMounts)
+			})
+
+			it("runs post-build hooks with the exported image after export", func() {
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				hook := pod.Spec.InitContainers[len(pod.Spec.InitContainers)-1]
+				assert.Equal(t, "post-build-smoke-tests", hook.Name)
+				assert.Equal(t, "/workspace/smoke", hook.WorkingDir)
+				assert.Equal(t, restrictedSecurityContext(false), hook.SecurityContext)
+				assert.Equal(t, []corev1.EnvVar{
+					{Name: "KPACK_IMAGE", Value: "someimage/name"},
+					{Name: "KPACK_REPORT", Value: "/var/report/report.toml"},
+				}, hook.Env)
+				assert.Equal(t, []corev1.VolumeMount{
+					{
+						Name:      "workspace-dir",
+						MountPath: "/workspace",
+						SubPath:   "some/src/path",
+					},
+					{
+						Name:      "report-dir",
+						MountPath: "/var/report",
+						ReadOnly:  true,
+					},
+				}, hook.VolumeMounts)
+			})
+
+			it("does not modify the hooks on the build", func() {
+				_, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Equal(t, "unit-tests", build.Spec.Hooks.PreBuild[0].Name)
+				assert.Nil(t, build.Spec.Hooks.PreBuild[0].SecurityContext)
+				assert.Empty(t, build.Spec.Hooks.PostBuild[0].Env)
+			})
+
+			it("runs post-build hooks after the creator for trusted builders", func() {
+				build.Spec.Builder.Trusted = true
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Equal(t, []string{"prepare", "pre-build-unit-tests", "create", "post-build-smoke-tests"}, containerNames(pod.Spec.InitContainers))
+			})
+
+			it("errors for post-build hooks on platform api 0.2", func() {
+				buildPodBuilderConfig.PlatformAPI = "0.2"
+
+				_, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.EqualError(t, err, "post-build hooks require platform API 0.3 or newer, builder uses 0.2")
+			})
+
+			it("supports pre-build hooks on platform api 0.2", func() {
+				buildPodBuilderConfig.PlatformAPI = "0.2"
+				build.Spec.Hooks.PostBuild = nil
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Equal(t, []string{"prepare", "pre-build-unit-tests", "detect", "analyze", "restore", "build", "label", "export"}, containerNames(pod.Spec.InitContainers))
+			})
+		})
+
+		when("generating lifecycle containers for each platform api", func() {
+			type lifecycleContainers struct {
+				analyze      []string
+				restore      []string
+				export       []string
+				exportMounts []string
+				create       []string
+				env          []corev1.EnvVar
+			}
+
+			platformAPIEnv := func(version string) []corev1.EnvVar {
+				return []corev1.EnvVar{{Name: "CNB_PLATFORM_API", Value: version}}
+			}
+
+			strs := func(s ...string) []string {
+				return s
+			}
+
+			tags := []string{"someimage/name", "someimage/name:tag2", "someimage/name:tag3"}
+
+			for _, tc := range []struct {
+				platformAPI string
+				expected    lifecycleContainers
+			}{
+				{
+					platformAPI: "0.2",
+					expected: lifecycleContainers{
+						analyze:      strs("-layers=/layers", "-group=/layers/group.toml", "-analyzed=/layers/analyzed.toml", "-cache-dir=/cache", previousAppImage),
+						restore:      strs("-group=/layers/group.toml", "-layers=/layers", "-cache-dir=/cache"),
+						export:       append(strs("-layers=/layers", "-app=/workspace", "-group=/layers/group.toml", "-analyzed=/layers/analyzed.toml", "-cache-dir=/cache"), tags...),
+						exportMounts: strs("layers-dir", "workspace-dir", "home-dir", "cache-dir"),
+					},
+				},
+				{
+					platformAPI: "0.3",
+					expected: lifecycleContainers{
+						analyze: strs("-layers=/layers", "-group=/layers/group.toml", "-analyzed=/layers/analyzed.toml", "-cache-dir=/cache", previousAppImage),
+						restore: strs("-group=/layers/group.toml", "-layers=/layers", "-cache-dir=/cache"),
+						export: append(strs("-layers=/layers", "-app=/workspace", "-group=/layers/group.toml", "-analyzed=/layers/analyzed.toml", "-cache-dir=/cache",
+							"-project-metadata=/layers/project-metadata.toml", "-report=/var/report/report.toml"), tags...),
+						exportMounts: strs("layers-dir", "workspace-dir", "home-dir", "cache-dir", "report-dir"),
+						create: strs("-layers=/layers", "-app=/workspace", "-cache-dir=/cache", "-previous-image="+previousAppImage,
+							"-project-metadata=/layers/project-metadata.toml", "-report=/var/report/report.toml",
+							"-tag=someimage/name:tag2", "-tag=someimage/name:tag3", "someimage/name"),
+					},
+				},
+				{
+					platformAPI: "0.4",
+					expected: lifecycleContainers{
+						analyze: strs("-layers=/layers", "-group=/layers/group.toml", "-analyzed=/layers/analyzed.toml", "-cache-dir=/cache", "-uid=2000", "-gid=3000", previousAppImage),
+						restore: strs("-group=/layers/group.toml", "-layers=/layers", "-cache-dir=/cache", "-uid=2000", "-gid=3000"),
+						export: append(strs("-layers=/layers", "-app=/workspace", "-group=/layers/group.toml", "-analyzed=/layers/analyzed.toml", "-cache-dir=/cache",
+							"-project-metadata=/layers/project-metadata.toml", "-report=/var/report/report.toml", "-uid=2000", "-gid=3000", "-process-type=web"), tags...),
+						exportMounts: strs("layers-dir", "workspace-dir", "home-dir", "cache-dir", "report-dir"),
+						create: strs("-layers=/layers", "-app=/workspace", "-cache-dir=/cache", "-previous-image="+previousAppImage,
+							"-project-metadata=/layers/project-metadata.toml", "-report=/var/report/report.toml", "-uid=2000", "-gid=3000", "-process-type=web",
+							"-tag=someimage/name:tag2", "-tag=someimage/name:tag3", "someimage/name"),
+						env: platformAPIEnv("0.4"),
+					},
+				},
+				{
+					platformAPI: "0.5",
+					expected: lifecycleContainers{
+						analyze: strs("-layers=/layers", "-group=/layers/group.toml", "-analyzed=/layers/analyzed.toml", "-cache-dir=/cache", "-uid=2000", "-gid=3000", previousAppImage),
+						restore: strs("-group=/layers/group.toml", "-layers=/layers", "-cache-dir=/cache", "-uid=2000", "-gid=3000"),
+						export: append(strs("-layers=/layers", "-app=/workspace", "-group=/layers/group.toml", "-analyzed=/layers/analyzed.toml", "-cache-dir=/cache",
+							"-project-metadata=/layers/project-metadata.toml", "-report=/var/report/report.toml", "-uid=2000", "-gid=3000",
+							"-run-image=builderregistry.io/run", "-process-type=web"), tags...),
+						exportMounts: strs("layers-dir", "workspace-dir", "home-dir", "cache-dir", "report-dir"),
+						create: strs("-layers=/layers", "-app=/workspace", "-cache-dir=/cache", "-previous-image="+previousAppImage,
+							"-project-metadata=/layers/project-metadata.toml", "-report=/var/report/report.toml", "-uid=2000", "-gid=3000",
+							"-run-image=builderregistry.io/run", "-process-type=web",
+							"-tag=someimage/name:tag2", "-tag=someimage/name:tag3", "someimage/name"),
+						env: platformAPIEnv("0.5"),
+					},
+				},
+			} {
+				platformAPI := tc.platformAPI
+				expected := tc.expected
+
+				when("platform api "+platformAPI, func() {
+					buildPodBuilderConfig.PlatformAPI = platformAPI
+					build.Spec.DefaultProcess = "web"
+
+					it("configures the lifecycle phases", func() {
+						pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+						require.NoError(t, err)
+
+						containers := map[string]corev1.Container{}
+						for _, container := range pod.Spec.InitContainers {
+							containers[container.Name] = container
+						}
+
+						assert.Equal(t, expected.analyze, containers["analyze"].Args)
+						assert.Equal(t, expected.restore, containers["restore"].Args)
+						assert.Equal(t, expected.export, containers["export"].Args)
+						assert.Equal(t, expected.exportMounts, names(containers["export"].VolumeMounts))
+
+						homeEnv := corev1.EnvVar{Name: "HOME", Value: "/builder/home"}
+						assert.Equal(t, expected.env, containers["detect"].Env)
+						assert.Equal(t, append([]corev1.EnvVar{homeEnv}, expected.env...), containers["analyze"].Env)
+						assert.Equal(t, expected.env, containers["restore"].Env)
+						assert.Equal(t, expected.env, containers["build"].Env)
+						assert.Equal(t, append([]corev1.EnvVar{homeEnv}, expected.env...), containers["export"].Env)
+					})
+
+					it("configures the creator for trusted builders", func() {
+						build.Spec.Builder.Trusted = true
+
+						pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+						require.NoError(t, err)
+
+						if expected.create == nil {
+							assert.Equal(t, "detect", pod.Spec.InitContainers[1].Name)
+							return
+						}
+
+						require.Len(t, pod.Spec.InitContainers, 2)
+						create := pod.Spec.InitContainers[1]
+						assert.Equal(t, "create", create.Name)
+						assert.Equal(t, expected.create, create.Args)
+						assert.Equal(t, append([]corev1.EnvVar{{Name: "HOME", Value: "/builder/home"}}, expected.env...), create.Env)
+					})
+				})
+			}
+		})
+
+		it("passes the previous image to the analyzer as an argument for every supported platform api", func() {
+			for _, platformAPI := range v1alpha1.SupportedPlatformAPIs() {
+				buildPodBuilderConfig.PlatformAPI = platformAPI
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				var analyze *corev1.Container
+				for i := range pod.Spec.InitContainers {
+					if pod.Spec.InitContainers[i].Name == "analyze" {
+						analyze = &pod.Spec.InitContainers[i]
+					}
+				}
+				require.NotNil(t, analyze, platformAPI)
+
+				assert.Equal(t, build.Spec.LastBuild.Image, analyze.Args[len(analyze.Args)-1], platformAPI)
+				for _, arg := range analyze.Args {
+					assert.NotContains(t, arg, "-previous-image", platformAPI)
+				}
+			}
+		})
+
+		when("the builder is trusted", func() {
+			build.Spec.Builder.Trusted = true
+
+			it("runs the lifecycle in a single creator container with the 0.3 platform api", func() {
+				buildPodBuilderConfig.PlatformAPI = "0.3"
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				require.Len(t, pod.Spec.InitContainers, 2)
+				assert.Equal(t, "prepare", pod.Spec.InitContainers[0].Name)
+
+				create := pod.Spec.InitContainers[1]
+				assert.Equal(t, "create", create.Name)
+				assert.Equal(t, builderImage, create.Image)
+				assert.Equal(t, []string{"/cnb/lifecycle/creator"}, create.Command)
+				assert.Equal(t, []string{
+					"-layers=/layers",
+					"-app=/workspace",
+					"-cache-dir=/cache",
+					"-previous-image=" + build.Spec.LastBuild.Image,
+					"-project-metadata=/layers/project-metadata.toml",
+					"-report=/var/report/report.toml",
+					"-tag=someimage/name:tag2",
+					"-tag=someimage/name:tag3",
+					build.Tag(),
+				}, create.Args)
+				assert.Equal(t, []string{
+					"layers-dir",
+					"platform-dir",
+					"workspace-dir",
+					"home-dir",
+					"cache-dir",
+					"report-dir",
+					"binding-metadata-database",
+					"binding-metadata-apm",
+					"binding-secret-apm",
+				}, names(create.VolumeMounts))
+				assert.Equal(t, []corev1.EnvVar{{Name: "HOME", Value: "/builder/home"}}, create.Env)
+				assert.Equal(t, restrictedSecurityContext(false), create.SecurityContext)
+			})
+
+			it("passes the registry cache to the creator", func() {
+				buildPodBuilderConfig.PlatformAPI = "0.3"
+				build.Spec.CacheImage = "someimage/cache:latest"
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				create := pod.Spec.InitContainers[1]
+				assert.Equal(t, []string{
+					"-layers=/layers",
+					"-app=/workspace",
+					"-cache-image=someimage/cache:latest",
+					"-previous-image=" + build.Spec.LastBuild.Image,
+					"-project-metadata=/layers/project-metadata.toml",
+					"-report=/var/report/report.toml",
+					"-tag=someimage/name:tag2",
+					"-tag=someimage/name:tag3",
+					build.Tag(),
+				}, create.Args)
+			})
+
+			it("uses the build tag as the previous image without a last build", func() {
+				buildPodBuilderConfig.PlatformAPI = "0.3"
+				build.Spec.LastBuild = nil
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Contains(t, pod.Spec.InitContainers[1].Args, "-previous-image="+build.Tag())
+			})
+
+			it("runs every lifecycle phase in its own container with the 0.2 platform api", func() {
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				var containerNames []string
+				for _, container := range pod.Spec.InitContainers {
+					containerNames = append(containerNames, container.Name)
+				}
+				assert.Equal(t, []string{
+					"prepare",
+					"detect",
+					"analyze",
+					"restore",
+					"build",
+					"label",
+					"export",
+				}, containerNames)
 			})
 		})
 
@@ -743,6 +1322,83 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
+		when("enforcing the restricted pod security standard", func() {
+			assertRestricted := func(pod *corev1.Pod, readOnlyRootFilesystem map[string]bool) {
+				t.Helper()
+				require.NotNil(t, pod.Spec.SecurityContext)
+				assert.Equal(t, true, *pod.Spec.SecurityContext.RunAsNonRoot)
+				assert.Equal(t, buildPodBuilderConfig.Uid, *pod.Spec.SecurityContext.RunAsUser)
+				assert.Equal(t, buildPodBuilderConfig.Gid, *pod.Spec.SecurityContext.RunAsGroup)
+				assert.Equal(t, buildPodBuilderConfig.Gid, *pod.Spec.SecurityContext.FSGroup)
+				assert.Equal(t, corev1.SeccompProfileRuntimeDefault, pod.Annotations[corev1.SeccompPodAnnotationKey])
+
+				containers := append(append([]corev1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+				require.Len(t, containers, len(readOnlyRootFilesystem))
+				for _, container := range containers {
+					readOnly, ok := readOnlyRootFilesystem[container.Name]
+					require.True(t, ok, "unexpected container %s", container.Name)
+					assert.Equal(t, restrictedSecurityContext(readOnly), container.SecurityContext, container.Name)
+				}
+			}
+
+			buildContainers := map[string]bool{
+				"prepare":    true,
+				"detect":     false,
+				"analyze":    false,
+				"restore":    false,
+				"build":      false,
+				"label":      true,
+				"export":     false,
+				"completion": true,
+			}
+
+			it("restricts every container for the 0.2 platform api", func() {
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assertRestricted(pod, buildContainers)
+			})
+
+			it("restricts every container for the 0.3 platform api", func() {
+				buildPodBuilderConfig.PlatformAPI = "0.3"
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assertRestricted(pod, buildContainers)
+			})
+
+			it("restricts every container in a rebase pod", func() {
+				build.Annotations = map[string]string{
+					v1alpha1.BuildReasonAnnotation:  v1alpha1.BuildReasonStack,
+					v1alpha1.BuildChangesAnnotation: "some-stack-change",
+				}
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assertRestricted(pod, map[string]bool{
+					"rebase":     true,
+					"completion": true,
+				})
+			})
+
+			it("mounts a writable tmp dir in containers with a read only root filesystem", func() {
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Contains(t, names(pod.Spec.InitContainers[0].VolumeMounts), "tmp-dir")
+				assert.Contains(t, names(pod.Spec.Containers[0].VolumeMounts), "tmp-dir")
+			})
+
+			it("returns an error when the builder runs as root", func() {
+				buildPodBuilderConfig.Uid = 0
+
+				_, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.EqualError(t, err, "builder must not run as the root user")
+			})
+		})
+
 		when("creating a rebase pod", func() {
 			build.Annotations = map[string]string{
 				v1alpha1.BuildReasonAnnotation:  v1alpha1.BuildReasonStack,
@@ -751,6 +1407,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			}
 
 			it("creates a pod just to rebase", func() {
+				nonRoot := true
 				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
 				require.NoError(t, err)
 
@@ -765,6 +1422,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 						"some/annotation":               "to-pass-through",
 						v1alpha1.BuildReasonAnnotation:  v1alpha1.BuildReasonStack,
 						v1alpha1.BuildChangesAnnotation: "some-stack-change",
+						corev1.SeccompPodAnnotationKey:  corev1.SeccompProfileRuntimeDefault,
 					},
 					OwnerReferences: []metav1.OwnerReference{
 						*kmeta.NewControllerRef(build),
@@ -807,6 +1465,12 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 								EmptyDir: &corev1.EmptyDirVolumeSource{},
 							},
 						},
+						{
+							Name: "tmp-dir",
+							VolumeSource: corev1.VolumeSource{
+								EmptyDir: &corev1.EmptyDirVolumeSource{},
+							},
+						},
 						{
 							Name: "notary-dir",
 							VolumeSource: corev1.VolumeSource{
@@ -815,18 +1479,56 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 					RestartPolicy: corev1.RestartPolicyNever,
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: &nonRoot,
+						RunAsUser:    &buildPodBuilderConfig.Uid,
+						RunAsGroup:   &buildPodBuilderConfig.Gid,
+						FSGroup:      &buildPodBuilderConfig.Gid,
+					},
 					Containers: []corev1.Container{
 						{
-							Name:            "completion",
-							Image:           config.CompletionImage,
+							Name:  "completion",
+							Image: config.CompletionImage,
+							Args: []string{
+								directExecute,
+								"completion",
+								"-publish-sbom",
+								"-basic-docker=docker-secret-1=acr.io",
+								"-dockerconfig=docker-secret-2",
+								"-dockercfg=docker-secret-3",
+							},
 							ImagePullPolicy: corev1.PullIfNotPresent,
 							Resources:       build.Spec.Resources,
+							SecurityContext: restrictedSecurityContext(true),
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "secret-volume-docker-secret-1",
+									MountPath: "/var/build-secrets/docker-secret-1",
+								},
+								{
+									Name:      "secret-volume-docker-secret-2",
+									MountPath: "/var/build-secrets/docker-secret-2",
+								},
+								{
+									Name:      "secret-volume-docker-secret-3",
+									MountPath: "/var/build-secrets/docker-secret-3",
+								},
+								{
+									Name:      "report-dir",
+									MountPath: "/var/report",
+								},
+								{
+									Name:      "tmp-dir",
+									MountPath: "/tmp",
+								},
+							},
 						},
 					},
 					InitContainers: []corev1.Container{
 						{
-							Name:  "rebase",
-							Image: config.RebaseImage,
+							Name:            "rebase",
+							Image:           config.RebaseImage,
+							SecurityContext: restrictedSecurityContext(true),
 							Args: []string{
 								directExecute,
 								"rebase",
@@ -866,12 +1568,54 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 									Name:      "report-dir",
 									MountPath: "/var/report",
 								},
+								{
+									Name:      "tmp-dir",
+									MountPath: "/tmp",
+								},
 							},
 						},
 					},
 				}, pod.Spec)
 			})
 
+			it("mounts a writable tmp dir in the rebase container with a read only root filesystem", func() {
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				require.Len(t, pod.Spec.InitContainers, 1)
+				rebase := pod.Spec.InitContainers[0]
+				assert.Equal(t, "rebase", rebase.Name)
+				assert.True(t, *rebase.SecurityContext.ReadOnlyRootFilesystem)
+				assert.Contains(t, rebase.VolumeMounts, corev1.VolumeMount{
+					Name:      "tmp-dir",
+					MountPath: "/tmp",
+				})
+				assert.Contains(t, pod.Spec.Volumes, corev1.Volume{
+					Name: "tmp-dir",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				})
+			})
+
+			it("configures the rebase pod scheduling", func() {
+				config.Scheduling = v1alpha1.BuildPodScheduling{
+					NodeSelector:      map[string]string{"disk": "ssd"},
+					PriorityClassName: "default-priority-class",
+				}
+				build.Spec.Tolerations = []corev1.Toleration{
+					{Key: "builds", Operator: corev1.TolerationOpExists},
+				}
+				build.Spec.NodeSelector = map[string]string{"pool": "builds"}
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Equal(t, build.Spec.Tolerations, pod.Spec.Tolerations)
+				assert.Equal(t, map[string]string{"kubernetes.io/os": "linux", "pool": "builds", "disk": "ssd"}, pod.Spec.NodeSelector)
+				assert.Equal(t, "default-priority-class", pod.Spec.PriorityClassName)
+			})
+
 			when("a notary config is present on the build", func() {
 				it("sets up the completion image to sign the image", func() {
 					build.Spec.Notary = &v1alpha1.NotaryConfig{
@@ -890,6 +1634,7 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 						[]string{
 							directExecute,
 							"completion",
+							"-publish-sbom",
 							"-notary-v1-url=some-notary-url",
 							"-basic-docker=docker-secret-1=acr.io",
 							"-dockerconfig=docker-secret-2",
@@ -918,6 +1663,89 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 						},
 					})
 				})
+
+				it("passes the notary role, tags and root key ids to the completion image", func() {
+					build.Spec.Notary = &v1alpha1.NotaryConfig{
+						V1: &v1alpha1.NotaryV1Config{
+							URL: "some-notary-url",
+							SecretRef: v1alpha1.NotarySecretRef{
+								Name: "some-notary-secret",
+							},
+							Role:       "targets/releases",
+							Tags:       []string{"latest", "v*"},
+							RootKeyIDs: []string{"some-root-key-id", "other-root-key-id"},
+						},
+					}
+
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					require.Equal(t,
+						[]string{
+							directExecute,
+							"completion",
+							"-publish-sbom",
+							"-notary-v1-url=some-notary-url",
+							"-notary-v1-role=targets/releases",
+							"-notary-v1-tags=latest,v*",
+							"-notary-v1-root-key-ids=some-root-key-id,other-root-key-id",
+							"-basic-docker=docker-secret-1=acr.io",
+							"-dockerconfig=docker-secret-2",
+							"-dockercfg=docker-secret-3",
+						},
+						pod.Spec.Containers[0].Args,
+					)
+				})
+			})
+
+			when("a cosign config is present on the build", func() {
+				it("sets up the completion image to sign the image with cosign", func() {
+					buildPodBuilderConfig.LifecycleVersion = "0.9.1"
+					build.Spec.Signing = &v1alpha1.SigningConfig{
+						Cosign: &v1alpha1.CosignConfig{
+							SecretRef: corev1.LocalObjectReference{
+								Name: "some-cosign-secret",
+							},
+						},
+					}
+
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					require.Contains(t, pod.Spec.Containers[0].Args, "-cosign")
+					require.Subset(t, pod.Spec.Containers[0].Args, []string{
+						"-build=some-namespace/build-name",
+						"-builder-image=" + builderImage,
+						"-lifecycle-version=0.9.1",
+						"-source-url=giturl.com/git.git",
+						"-source-digest=sha1:gitrev1234",
+						"-env-names=keyA,keyB",
+						"-binding-names=database,apm",
+					})
+					require.Contains(t, pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+						Name:      "cosign-dir",
+						ReadOnly:  true,
+						MountPath: "/var/cosign",
+					})
+					require.Contains(t, pod.Spec.Volumes, corev1.Volume{
+						Name: "cosign-dir",
+						VolumeSource: corev1.VolumeSource{
+							Secret: &corev1.SecretVolumeSource{
+								SecretName: "some-cosign-secret",
+							},
+						},
+					})
+				})
+
+				it("does not mount a cosign secret without a cosign config", func() {
+					pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+					require.NoError(t, err)
+
+					require.NotContains(t, pod.Spec.Containers[0].Args, "-cosign")
+					for _, volume := range pod.Spec.Volumes {
+						require.NotEqual(t, "cosign-dir", volume.Name)
+					}
+				})
 			})
 		})
 
@@ -940,8 +1768,6 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 						directExecute,
 						"completion",
 						"-notary-v1-url=some-notary-url",
-						"-basic-git=git-secret-1=https://github.com",
-						"-ssh-git=git-secret-2=https://bitbucket.com",
 						"-basic-docker=docker-secret-1=acr.io",
 						"-dockerconfig=docker-secret-2",
 						"-dockercfg=docker-secret-3",
@@ -971,6 +1797,46 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			})
 		})
 
+		when("a cosign config is present on the build", func() {
+			it("sets up the completion image to sign the image with cosign", func() {
+				build.Spec.Signing = &v1alpha1.SigningConfig{
+					Cosign: &v1alpha1.CosignConfig{
+						SecretRef: corev1.LocalObjectReference{
+							Name: "some-cosign-secret",
+						},
+					},
+				}
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				require.Contains(t, pod.Spec.Containers[0].Args, "-cosign")
+				require.Contains(t, pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+					Name:      "cosign-dir",
+					ReadOnly:  true,
+					MountPath: "/var/cosign",
+				})
+				require.Contains(t, pod.Spec.Volumes, corev1.Volume{
+					Name: "cosign-dir",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: "some-cosign-secret",
+						},
+					},
+				})
+			})
+
+			it("does not mount a cosign secret without a cosign config", func() {
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				require.NotContains(t, pod.Spec.Containers[0].Args, "-cosign")
+				for _, volume := range pod.Spec.Volumes {
+					require.NotEqual(t, "cosign-dir", volume.Name)
+				}
+			})
+		})
+
 		it("creates the pod container correctly", func() {
 			pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
 			require.NoError(t, err)
@@ -978,6 +1844,39 @@ func testBuildPod(t *testing.T, when spec.G, it spec.S) {
 			require.Len(t, pod.Spec.Containers, 1)
 			assert.Equal(t, "completion/image:image", pod.Spec.Containers[0].Image)
 		})
+
+		it("does not publish the SBOM on platform API 0.2 which writes no report", func() {
+			buildPodBuilderConfig.PlatformAPI = "0.2"
+
+			pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+			require.NoError(t, err)
+
+			assert.NotContains(t, pod.Spec.Containers[0].Args, "-publish-sbom")
+		})
+
+		it("publishes the SBOM from platform API 0.3", func() {
+			for _, platformAPI := range []string{"0.3", "0.4", "0.5"} {
+				buildPodBuilderConfig.PlatformAPI = platformAPI
+
+				pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+				require.NoError(t, err)
+
+				assert.Contains(t, pod.Spec.Containers[0].Args, "-publish-sbom", platformAPI)
+			}
+		})
+
+		it("does not pass git secrets to the completion container", func() {
+			pod, err := build.BuildPod(config, secrets, buildPodBuilderConfig)
+			require.NoError(t, err)
+
+			for _, arg := range pod.Spec.Containers[0].Args {
+				assert.NotContains(t, arg, "-basic-git")
+				assert.NotContains(t, arg, "-ssh-git")
+			}
+			for _, mount := range pod.Spec.Containers[0].VolumeMounts {
+				assert.NotContains(t, mount.Name, "git-secret")
+			}
+		})
 	})
 }
 
@@ -0,0 +1,144 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// AddRequests counts a build with a pod of the effective requests
+func (u *BuildQuotaUsage) AddRequests(requests corev1.ResourceList) {
+	u.ConcurrentBuilds++
+	if cpu, ok := requests[corev1.ResourceCPU]; ok {
+		u.CPU.Add(cpu)
+	}
+	if memory, ok := requests[corev1.ResourceMemory]; ok {
+		u.Memory.Add(memory)
+	}
+}
+
+// AddImage counts the cache of an image
+func (u *BuildQuotaUsage) AddImage(image *Image) {
+	if image.Spec.CacheSize != nil {
+		u.CacheStorage.Add(*image.Spec.CacheSize)
+	}
+}
+
+// Exceeded describes why creating a build pod of the effective requests would exceed the quota or is empty if the build fits
+func (q *BuildQuota) Exceeded(used BuildQuotaUsage, requests corev1.ResourceList) string {
+	if exceeded := q.exceededConcurrentBuilds(used); exceeded != "" {
+		return exceeded
+	}
+
+	for _, r := range []struct {
+		name  corev1.ResourceName
+		used  resource.Quantity
+		limit *resource.Quantity
+	}{
+		{name: corev1.ResourceCPU, used: used.CPU, limit: q.Spec.CPU},
+		{name: corev1.ResourceMemory, used: used.Memory, limit: q.Spec.Memory},
+	} {
+		if r.limit == nil {
+			continue
+		}
+
+		requested, ok := requests[r.name]
+		if !ok {
+			return fmt.Sprintf("build pod must request %s limited by quota: %s", r.name, q.Name)
+		}
+
+		total := r.used.DeepCopy()
+		total.Add(requested)
+		if total.Cmp(*r.limit) > 0 {
+			return fmt.Sprintf("exceeded quota: %s, requested: %s=%s, used: %s=%s, limited: %s=%s",
+				q.Name, r.name, requested.String(), r.name, r.used.String(), r.name, r.limit.String())
+		}
+	}
+	return ""
+}
+
+// exceededConcurrentBuilds describes why one more build would exceed the concurrent builds of the quota or is empty if it fits
+func (q *BuildQuota) exceededConcurrentBuilds(used BuildQuotaUsage) string {
+	if q.Spec.ConcurrentBuilds == nil || used.ConcurrentBuilds+1 <= *q.Spec.ConcurrentBuilds {
+		return ""
+	}
+	return fmt.Sprintf("exceeded quota: %s, requested: concurrentBuilds=1, used: concurrentBuilds=%d, limited: concurrentBuilds=%d",
+		q.Name, used.ConcurrentBuilds, *q.Spec.ConcurrentBuilds)
+}
+
+// ExceededCacheStorage describes why an image cache of size would exceed the quota or is empty if it fits
+func (q *BuildQuota) ExceededCacheStorage(used BuildQuotaUsage, size resource.Quantity) string {
+	if q.Spec.CacheStorage == nil {
+		return ""
+	}
+
+	total := used.CacheStorage.DeepCopy()
+	total.Add(size)
+	if total.Cmp(*q.Spec.CacheStorage) <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("exceeded quota: %s, requested: cacheStorage=%s, used: cacheStorage=%s, limited: cacheStorage=%s",
+		q.Name, size.String(), used.CacheStorage.String(), q.Spec.CacheStorage.String())
+}
+
+// PodRequests are the effective requests of the pod the build will get. The steps of the pod depend on its builder
+// so the largest of every step it may run is used, the same resources BuildPod gives the steps.
+func (b *Build) PodRequests(defaults StepResources) corev1.ResourceList {
+	var initContainers []corev1.ResourceRequirements
+	for _, step := range []string{PrepareContainerName, "detect", "analyze", "restore", "build", CreateContainerName, LabelContainerName, "export", RebaseContainerName} {
+		initContainers = append(initContainers, b.stepResources(defaults, step))
+	}
+	for _, hook := range append(b.preBuildHooks(), b.postBuildHooks()...) {
+		initContainers = append(initContainers, hook.Resources)
+	}
+
+	return effectiveRequests([]corev1.ResourceRequirements{b.stepResources(defaults, CompletionContainerName)}, initContainers)
+}
+
+// PodRequests are the effective requests of a pod
+func PodRequests(pod *corev1.Pod) corev1.ResourceList {
+	containers := make([]corev1.ResourceRequirements, 0, len(pod.Spec.Containers))
+	for _, c := range pod.Spec.Containers {
+		containers = append(containers, c.Resources)
+	}
+	initContainers := make([]corev1.ResourceRequirements, 0, len(pod.Spec.InitContainers))
+	for _, c := range pod.Spec.InitContainers {
+		initContainers = append(initContainers, c.Resources)
+	}
+	return effectiveRequests(containers, initContainers)
+}
+
+// effectiveRequests are computed like the scheduler does: the sum of the containers that run at once
+// or the largest init container, which run one after another, if it requests more.
+// A container without a request for a resource requests its limit.
+func effectiveRequests(containers, initContainers []corev1.ResourceRequirements) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, c := range containers {
+		for name, q := range containerRequests(c) {
+			total := requests[name]
+			total.Add(q)
+			requests[name] = total
+		}
+	}
+
+	for _, c := range initContainers {
+		for name, q := range containerRequests(c) {
+			if current, ok := requests[name]; !ok || q.Cmp(current) > 0 {
+				requests[name] = q.DeepCopy()
+			}
+		}
+	}
+	return requests
+}
+
+func containerRequests(resources corev1.ResourceRequirements) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for name, q := range resources.Limits {
+		requests[name] = q
+	}
+	for name, q := range resources.Requests {
+		requests[name] = q
+	}
+	return requests
+}
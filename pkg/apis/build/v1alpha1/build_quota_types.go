@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const BuildQuotaKind = "BuildQuota"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// +k8s:openapi-gen=true
+type BuildQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BuildQuotaSpec   `json:"spec"`
+	Status BuildQuotaStatus `json:"status,omitempty"`
+}
+
+// BuildQuotaSpec limits the builds and image caches of a namespace. Omitted limits are unlimited.
+// +k8s:openapi-gen=true
+type BuildQuotaSpec struct {
+	// ConcurrentBuilds is the number of builds in the namespace that may have a pod at once
+	ConcurrentBuilds *int64 `json:"concurrentBuilds,omitempty"`
+	// CPU is the total cpu the running builds of the namespace may request
+	CPU *resource.Quantity `json:"cpu,omitempty"`
+	// Memory is the total memory the running builds of the namespace may request
+	Memory *resource.Quantity `json:"memory,omitempty"`
+	// CacheStorage is the total cacheSize of the images of the namespace
+	CacheStorage *resource.Quantity `json:"cacheStorage,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type BuildQuotaStatus struct {
+	corev1alpha1.Status `json:",inline"`
+	Used                BuildQuotaUsage `json:"used,omitempty"`
+}
+
+// BuildQuotaUsage is what the running builds and the images of a namespace count against its quotas
+// +k8s:openapi-gen=true
+type BuildQuotaUsage struct {
+	ConcurrentBuilds int64             `json:"concurrentBuilds"`
+	CPU              resource.Quantity `json:"cpu"`
+	Memory           resource.Quantity `json:"memory"`
+	CacheStorage     resource.Quantity `json:"cacheStorage"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type BuildQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []BuildQuota `json:"items"`
+}
+
+func (*BuildQuota) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(BuildQuotaKind)
+}
@@ -0,0 +1,33 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"knative.dev/pkg/apis"
+)
+
+func (q *BuildQuota) SetDefaults(context.Context) {
+}
+
+func (q *BuildQuota) Validate(ctx context.Context) *apis.FieldError {
+	return q.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (qs *BuildQuotaSpec) Validate(context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if qs.ConcurrentBuilds != nil && *qs.ConcurrentBuilds < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(*qs.ConcurrentBuilds, "concurrentBuilds"))
+	}
+	return errs.
+		Also(validateNonNegative(qs.CPU, "cpu")).
+		Also(validateNonNegative(qs.Memory, "memory")).
+		Also(validateNonNegative(qs.CacheStorage, "cacheStorage"))
+}
+
+func validateNonNegative(q *resource.Quantity, field string) *apis.FieldError {
+	if q != nil && q.Sign() < 0 {
+		return apis.ErrInvalidValue(q.String(), field)
+	}
+	return nil
+}
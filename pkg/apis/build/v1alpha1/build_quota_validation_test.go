@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestBuildQuotaValidation(t *testing.T) {
+	spec.Run(t, "BuildQuota Validation", testBuildQuotaValidation)
+}
+
+func testBuildQuotaValidation(t *testing.T, when spec.G, it spec.S) {
+	concurrentBuilds := int64(5)
+	cpu := resource.MustParse("8")
+	memory := resource.MustParse("16Gi")
+	cacheStorage := resource.MustParse("100G")
+
+	quota := &BuildQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "quota-name",
+			Namespace: "quota-namespace",
+		},
+		Spec: BuildQuotaSpec{
+			ConcurrentBuilds: &concurrentBuilds,
+			CPU:              &cpu,
+			Memory:           &memory,
+			CacheStorage:     &cacheStorage,
+		},
+	}
+
+	when("Validate", func() {
+		assertValidationError := func(quota *BuildQuota, expectedError *apis.FieldError) {
+			t.Helper()
+			err := quota.Validate(context.TODO())
+			assert.EqualError(t, err, expectedError.Error())
+		}
+
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, quota.Validate(context.TODO()))
+		})
+
+		it("returns nil without limits", func() {
+			assert.Nil(t, (&BuildQuota{}).Validate(context.TODO()))
+		})
+
+		it("negative concurrent builds", func() {
+			negative := int64(-1)
+			quota.Spec.ConcurrentBuilds = &negative
+
+			assertValidationError(quota, apis.ErrInvalidValue(int64(-1), "concurrentBuilds").ViaField("spec"))
+		})
+
+		it("negative cpu", func() {
+			negative := resource.MustParse("-1")
+			quota.Spec.CPU = &negative
+
+			assertValidationError(quota, apis.ErrInvalidValue("-1", "cpu").ViaField("spec"))
+		})
+
+		it("negative cache storage", func() {
+			negative := resource.MustParse("-1G")
+			quota.Spec.CacheStorage = &negative
+
+			assertValidationError(quota, apis.ErrInvalidValue("-1G", "cacheStorage").ViaField("spec"))
+		})
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
@@ -86,3 +87,84 @@ func TestBuildLifecycle(t *testing.T) {
 		},
 	}))
 }
+
+func TestPodRequests(t *testing.T) {
+	build := &Build{
+		Spec: BuildSpec{
+			Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+			StepResources: &StepResources{
+				Build: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		},
+	}
+	defaults := StepResources{
+		Prepare: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			},
+		},
+	}
+
+	requests := build.PodRequests(defaults)
+	require.Equal(t, "2", requests.Cpu().String())
+	require.Equal(t, "4Gi", requests.Memory().String())
+
+	build.Spec.Hooks = &BuildHooks{
+		PostBuild: []corev1.Container{
+			{
+				Name: "scan",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("3"),
+					},
+				},
+			},
+		},
+	}
+
+	requests = build.PodRequests(defaults)
+	require.Equal(t, "3", requests.Cpu().String())
+	require.Equal(t, "4Gi", requests.Memory().String())
+}
+
+func TestPodRequestsOfPod(t *testing.T) {
+	requests := func(cpu string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse(cpu),
+			},
+		}
+	}
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{Name: "prepare", Resources: requests("500m")},
+				{Name: "build", Resources: requests("1500m")},
+			},
+			Containers: []corev1.Container{
+				{Name: "completion", Resources: requests("1")},
+				{Name: "sidecar", Resources: requests("1")},
+			},
+		},
+	}
+	podRequests := PodRequests(pod)
+	require.Equal(t, "2", podRequests.Cpu().String())
+
+	pod.Spec.InitContainers[1].Resources = requests("3")
+	podRequests = PodRequests(pod)
+	require.Equal(t, "3", podRequests.Cpu().String())
+
+	_, ok := podRequests[corev1.ResourceMemory]
+	require.False(t, ok)
+}
@@ -50,6 +50,9 @@ type BuildBuilderSpec struct {
 	// +patchStrategy=merge
 	// +listType
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,15,rep,name=imagePullSecrets"`
+	// Trusted builders run every lifecycle phase in a single creator container
+	// that has access to the registry credentials of the build.
+	Trusted bool `json:"trusted,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -60,13 +63,54 @@ type BuildSpec struct {
 	ServiceAccount string           `json:"serviceAccount,omitempty"`
 	Source         SourceConfig     `json:"source"`
 	CacheName      string           `json:"cacheName,omitempty"`
+	// CacheImage is the tag of the registry cache, it takes precedence over the cache volume.
+	CacheImage string `json:"cacheImage,omitempty"`
 	// +listType
 	Bindings Bindings `json:"bindings,omitempty"`
 	// +listType
 	Env       []corev1.EnvVar             `json:"env,omitempty"`
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
-	LastBuild *LastBuild                  `json:"lastBuild,omitempty"`
-	Notary    *NotaryConfig               `json:"notary,omitempty"`
+	// StepResources configures the resources of individual build pod steps.
+	StepResources *StepResources `json:"stepResources,omitempty"`
+	Hooks         *BuildHooks    `json:"hooks,omitempty"`
+	LastBuild     *LastBuild     `json:"lastBuild,omitempty"`
+	Notary        *NotaryConfig  `json:"notary,omitempty"`
+	Signing       *SigningConfig `json:"signing,omitempty"`
+	// DefaultProcess is the process type the built image starts by default.
+	// It requires platform API 0.4 or newer.
+	DefaultProcess string `json:"defaultProcess,omitempty"`
+	// +listType
+	Tolerations       []corev1.Toleration `json:"tolerations,omitempty"`
+	NodeSelector      map[string]string   `json:"nodeSelector,omitempty"`
+	Affinity          *corev1.Affinity    `json:"affinity,omitempty"`
+	PriorityClassName string              `json:"priorityClassName,omitempty"`
+	RuntimeClassName  *string             `json:"runtimeClassName,omitempty"`
+}
+
+// StepResources holds the resource requirements of each build pod step.
+// Steps without resources fall back to the cluster-wide defaults.
+// +k8s:openapi-gen=true
+type StepResources struct {
+	// Prepare also applies to the label step which runs the same image
+	Prepare    *corev1.ResourceRequirements `json:"prepare,omitempty"`
+	Detect     *corev1.ResourceRequirements `json:"detect,omitempty"`
+	Analyze    *corev1.ResourceRequirements `json:"analyze,omitempty"`
+	Restore    *corev1.ResourceRequirements `json:"restore,omitempty"`
+	Build      *corev1.ResourceRequirements `json:"build,omitempty"`
+	Export     *corev1.ResourceRequirements `json:"export,omitempty"`
+	Rebase     *corev1.ResourceRequirements `json:"rebase,omitempty"`
+	Completion *corev1.ResourceRequirements `json:"completion,omitempty"`
+}
+
+// BuildHooks are containers run as additional steps of the build pod.
+// Pre-build hooks run on the source before detection and post-build hooks
+// run after the image is exported.
+// +k8s:openapi-gen=true
+type BuildHooks struct {
+	// +listType
+	PreBuild []corev1.Container `json:"preBuild,omitempty"`
+	// +listType
+	PostBuild []corev1.Container `json:"postBuild,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -98,10 +142,51 @@ type BuildStatus struct {
 	Stack               BuildStack            `json:"stack,omitempty"`
 	LatestImage         string                `json:"latestImage,omitempty"`
 	PodName             string                `json:"podName,omitempty"`
+	Commit              *GitCommit            `json:"commit,omitempty"`
 	// +listType
 	StepStates []corev1.ContainerState `json:"stepStates,omitempty"`
 	// +listType
 	StepsCompleted []string `json:"stepsCompleted,omitempty"`
+	// +listType
+	Steps  []BuildStep  `json:"steps,omitempty"`
+	Timing *BuildTiming `json:"timing,omitempty"`
+	// +listType
+	BOM       []BOMPackage `json:"bom,omitempty"`
+	SBOMImage string       `json:"sbomImage,omitempty"`
+	// +listType
+	SignedTargets []NotarySignedTarget `json:"signedTargets,omitempty"`
+	// LogArchive is where the controller archived the logs of the finished build
+	LogArchive string `json:"logArchive,omitempty"`
+	// QueuePosition is the position of a pending build in the build admission queue
+	QueuePosition int `json:"queuePosition,omitempty"`
+}
+
+// BOMPackage summarizes a top-level entry of the bill of materials of the built image
+// +k8s:openapi-gen=true
+type BOMPackage struct {
+	Name      string            `json:"name"`
+	Version   string            `json:"version,omitempty"`
+	Buildpack BuildpackMetadata `json:"buildpack"`
+}
+
+// BuildStep describes the progress of a single step of the build pod
+// +k8s:openapi-gen=true
+type BuildStep struct {
+	Name       string           `json:"name"`
+	StartedAt  *metav1.Time     `json:"startedAt,omitempty"`
+	FinishedAt *metav1.Time     `json:"finishedAt,omitempty"`
+	Duration   *metav1.Duration `json:"duration,omitempty"`
+	ExitCode   *int32           `json:"exitCode,omitempty"`
+	Message    string           `json:"message,omitempty"`
+}
+
+// BuildTiming breaks down the time a build spent waiting to run and running
+// +k8s:openapi-gen=true
+type BuildTiming struct {
+	// Queued is the time from the creation of the build until its first step started
+	Queued *metav1.Duration `json:"queued,omitempty"`
+	// Running is the time from the start of the first step until the build finished
+	Running *metav1.Duration `json:"running,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
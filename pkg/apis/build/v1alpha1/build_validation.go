@@ -26,8 +26,12 @@ func (bs *BuildSpec) Validate(ctx context.Context) *apis.FieldError {
 		Also(validate.Tags(bs.Tags)).
 		Also(bs.Builder.Validate(ctx).ViaField("builder")).
 		Also(bs.Source.Validate(ctx).ViaField("source")).
+		Also(bs.validateResolvedSource(ctx).ViaField("source")).
 		Also(bs.Bindings.Validate(ctx).ViaField("bindings")).
+		Also(bs.StepResources.Validate(ctx).ViaField("stepResources")).
+		Also(bs.Hooks.Validate(ctx).ViaField("hooks")).
 		Also(bs.LastBuild.Validate(ctx).ViaField("lastBuild")).
+		Also(bs.Signing.Validate(ctx).ViaField("signing")).
 		Also(bs.validateImmutableFields(ctx))
 }
 
@@ -53,6 +57,13 @@ func (bs *BuildSpec) validateImmutableFields(ctx context.Context) *apis.FieldErr
 	return nil
 }
 
+func (bs *BuildSpec) validateResolvedSource(ctx context.Context) *apis.FieldError {
+	if bs.Source.ArtifactRef != nil {
+		return apis.ErrDisallowedFields("artifactRef")
+	}
+	return nil
+}
+
 func (bbs *BuildBuilderSpec) Validate(ctx context.Context) *apis.FieldError {
 	return validate.Image(bbs.Image)
 }
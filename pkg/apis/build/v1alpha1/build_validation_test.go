@@ -105,7 +105,19 @@ func testBuildValidation(t *testing.T, when spec.G, it spec.S) {
 		it("missing source", func() {
 			build.Spec.Source = SourceConfig{}
 
-			assertValidationError(build, apis.ErrMissingOneOf("git", "blob", "registry").ViaField("spec", "source"))
+			assertValidationError(build, apis.ErrMissingOneOf("git", "blob", "registry", "artifact", "artifactRef").ViaField("spec", "source"))
+		})
+
+		it("disallows an unresolved artifactRef", func() {
+			build.Spec.Source = SourceConfig{
+				ArtifactRef: &ArtifactRef{
+					APIVersion: "source.toolkit.fluxcd.io/v1beta1",
+					Kind:       "GitRepository",
+					Name:       "some-repo",
+				},
+			}
+
+			assertValidationError(build, apis.ErrDisallowedFields("artifactRef").ViaField("spec", "source"))
 		})
 
 		it("validates git url", func() {
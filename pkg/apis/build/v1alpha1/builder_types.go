@@ -29,6 +29,9 @@ type BuilderSpec struct {
 	Store corev1.ObjectReference `json:"store,omitempty"`
 	// +listType
 	Order []OrderEntry `json:"order,omitempty"`
+	// Trusted builders run every lifecycle phase in a single creator container
+	// that has access to the registry credentials of the build.
+	Trusted bool `json:"trusted,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ImageCacheConfig selects where builds of an image keep the lifecycle cache.
+// Without it the cache is a volume sized by spec.cacheSize.
+// +k8s:openapi-gen=true
+type ImageCacheConfig struct {
+	Volume   *VolumeCache   `json:"volume,omitempty"`
+	Registry *RegistryCache `json:"registry,omitempty"`
+}
+
+// VolumeCache configures the persistent volume claim of the cache, its size is spec.cacheSize.
+// +k8s:openapi-gen=true
+type VolumeCache struct {
+	// StorageClassName is the storage class of the claim, the default storage class is used when it is empty.
+	StorageClassName string `json:"storageClassName,omitempty"`
+	// AccessModes of the claim, ReadWriteOnce when empty.
+	// +listType
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+}
+
+// RegistryCache keeps the cache in an image pushed with the credentials of the image service account.
+// +k8s:openapi-gen=true
+type RegistryCache struct {
+	Tag string `json:"tag"`
+}
+
+type CacheKind string
+
+const (
+	CacheKindNone     CacheKind = "None"
+	CacheKindVolume   CacheKind = "Volume"
+	CacheKindRegistry CacheKind = "Registry"
+)
+
+type CacheState string
+
+const (
+	CacheStatePending    CacheState = "Pending"
+	CacheStateBound      CacheState = "Bound"
+	CacheStateLost       CacheState = "Lost"
+	CacheStateRecreating CacheState = "Recreating"
+)
+
+// +k8s:openapi-gen=true
+type ImageCacheStatus struct {
+	Kind CacheKind `json:"kind"`
+	// Image is the tag of the registry cache.
+	Image string `json:"image,omitempty"`
+	// State of the cache volume claim. Builds wait while it is Recreating.
+	State CacheState `json:"state,omitempty"`
+	// Size is the capacity of the cache volume, or its requested size until the claim is bound.
+	Size *resource.Quantity `json:"size,omitempty"`
+	// LastClearRequest is the last value of the clear cache annotation the cache was cleared for.
+	LastClearRequest string `json:"lastClearRequest,omitempty"`
+}
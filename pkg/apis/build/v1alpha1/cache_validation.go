@@ -0,0 +1,50 @@
+package v1alpha1
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/validate"
+)
+
+func (c *ImageCacheConfig) Validate(ctx context.Context) *apis.FieldError {
+	if c == nil {
+		return nil
+	}
+
+	if c.Volume != nil && c.Registry != nil {
+		return apis.ErrMultipleOneOf("volume", "registry")
+	}
+
+	return c.Volume.Validate(ctx).ViaField("volume").
+		Also(c.Registry.Validate(ctx).ViaField("registry"))
+}
+
+func (v *VolumeCache) Validate(ctx context.Context) *apis.FieldError {
+	if v == nil {
+		return nil
+	}
+
+	if v.StorageClassName == "" && ctx.Value(HasDefaultStorageClass) == nil {
+		return apis.ErrMissingField("storageClassName")
+	}
+
+	var errs *apis.FieldError
+	for i, accessMode := range v.AccessModes {
+		switch accessMode {
+		case corev1.ReadWriteOnce, corev1.ReadWriteMany:
+		default:
+			errs = errs.Also(apis.ErrInvalidArrayValue(accessMode, "accessModes", i))
+		}
+	}
+	return errs
+}
+
+func (r *RegistryCache) Validate(ctx context.Context) *apis.FieldError {
+	if r == nil {
+		return nil
+	}
+	return validate.Tag(r.Tag)
+}
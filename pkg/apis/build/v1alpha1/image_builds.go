@@ -19,6 +19,7 @@ const (
 	BuildReasonAnnotation  = "image.kpack.io/reason"
 	BuildChangesAnnotation = "image.kpack.io/buildChanges"
 	BuildNeededAnnotation  = "image.kpack.io/additionalBuildNeeded"
+	ClearCacheAnnotation   = "image.kpack.io/clearCache"
 
 	BuildReasonConfig    = "CONFIG"
 	BuildReasonCommit    = "COMMIT"
@@ -31,6 +32,7 @@ type BuildReason string
 
 func (im *Image) Build(sourceResolver *SourceResolver, builder BuilderResource, latestBuild *Build, reasons, changes, cacheName string, nextBuildNumber int64) *Build {
 	buildNumber := strconv.Itoa(int(nextBuildNumber))
+	imageBuild := im.imageBuild()
 
 	return &Build{
 		ObjectMeta: metav1.ObjectMeta{
@@ -50,16 +52,26 @@ func (im *Image) Build(sourceResolver *SourceResolver, builder BuilderResource,
 			}),
 		},
 		Spec: BuildSpec{
-			Tags:           im.generateTags(buildNumber),
-			Builder:        builder.BuildBuilderSpec(),
-			Bindings:       im.Bindings(),
-			Env:            im.Env(),
-			Resources:      im.Resources(),
-			ServiceAccount: im.Spec.ServiceAccount,
-			Source:         sourceResolver.SourceConfig(),
-			CacheName:      im.Status.BuildCacheName,
-			LastBuild:      lastBuild(latestBuild),
-			Notary:         im.Spec.Notary,
+			Tags:              im.generateTags(buildNumber),
+			Builder:           builder.BuildBuilderSpec(),
+			Bindings:          im.Bindings(),
+			Env:               im.Env(),
+			Resources:         im.Resources(),
+			StepResources:     im.StepResources(),
+			Hooks:             im.Hooks(),
+			DefaultProcess:    imageBuild.DefaultProcess,
+			ServiceAccount:    im.Spec.ServiceAccount,
+			Source:            sourceResolver.SourceConfig(),
+			CacheName:         im.Status.BuildCacheName,
+			CacheImage:        im.cacheImage(),
+			LastBuild:         lastBuild(latestBuild),
+			Notary:            im.Spec.Notary,
+			Signing:           im.Spec.Signing,
+			Tolerations:       imageBuild.Tolerations,
+			NodeSelector:      imageBuild.NodeSelector,
+			Affinity:          imageBuild.Affinity,
+			PriorityClassName: imageBuild.PriorityClassName,
+			RuntimeClassName:  imageBuild.RuntimeClassName,
 		},
 	}
 }
@@ -107,12 +119,82 @@ func (im *Image) Resources() corev1.ResourceRequirements {
 	return im.Spec.Build.Resources
 }
 
+func (im *Image) StepResources() *StepResources {
+	if im.Spec.Build == nil {
+		return nil
+	}
+	return im.Spec.Build.StepResources
+}
+
+func (im *Image) Hooks() *BuildHooks {
+	if im.Spec.Build == nil {
+		return nil
+	}
+	return im.Spec.Build.Hooks
+}
+
+func (im *Image) imageBuild() ImageBuild {
+	if im.Spec.Build == nil {
+		return ImageBuild{}
+	}
+	return *im.Spec.Build
+}
+
 func (im *Image) CacheName() string {
 	return kmeta.ChildName(im.Name, "-cache")
 }
 
 func (im *Image) NeedCache() bool {
-	return im.Spec.CacheSize != nil
+	return im.Spec.CacheSize != nil && im.Spec.RegistryCache() == nil
+}
+
+func (is *ImageSpec) VolumeCache() *VolumeCache {
+	if is.Cache == nil {
+		return nil
+	}
+	return is.Cache.Volume
+}
+
+func (is *ImageSpec) storageClassName() string {
+	if volumeCache := is.VolumeCache(); volumeCache != nil {
+		return volumeCache.StorageClassName
+	}
+	return ""
+}
+
+func (is *ImageSpec) RegistryCache() *RegistryCache {
+	if is.Cache == nil {
+		return nil
+	}
+	return is.Cache.Registry
+}
+
+func (im *Image) cacheImage() string {
+	if registryCache := im.Spec.RegistryCache(); registryCache != nil {
+		return registryCache.Tag
+	}
+	return ""
+}
+
+// ClearCacheRequested is true when the clear cache annotation changed since the cache was last cleared
+func (im *Image) ClearCacheRequested() bool {
+	request, ok := im.Annotations[ClearCacheAnnotation]
+	if !ok {
+		return false
+	}
+	return im.Status.Cache == nil || im.Status.Cache.LastClearRequest != request
+}
+
+// CacheStatus reports the kind of cache builds of the image use
+func (im *Image) CacheStatus() *ImageCacheStatus {
+	switch {
+	case im.Spec.RegistryCache() != nil:
+		return &ImageCacheStatus{Kind: CacheKindRegistry, Image: im.cacheImage()}
+	case im.NeedCache():
+		return &ImageCacheStatus{Kind: CacheKindVolume}
+	default:
+		return &ImageCacheStatus{Kind: CacheKindNone}
+	}
 }
 
 func (im *Image) BuildCache() *corev1.PersistentVolumeClaim {
@@ -126,16 +208,31 @@ func (im *Image) BuildCache() *corev1.PersistentVolumeClaim {
 			Labels: im.Labels,
 		},
 		Spec: corev1.PersistentVolumeClaimSpec{
-			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			AccessModes: im.cacheAccessModes(),
 			Resources: corev1.ResourceRequirements{
 				Requests: corev1.ResourceList{
 					corev1.ResourceStorage: *im.Spec.CacheSize,
 				},
 			},
+			StorageClassName: im.cacheStorageClassName(),
 		},
 	}
 }
 
+func (im *Image) cacheAccessModes() []corev1.PersistentVolumeAccessMode {
+	if volumeCache := im.Spec.VolumeCache(); volumeCache != nil && len(volumeCache.AccessModes) > 0 {
+		return volumeCache.AccessModes
+	}
+	return []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+}
+
+func (im *Image) cacheStorageClassName() *string {
+	if storageClassName := im.Spec.storageClassName(); storageClassName != "" {
+		return &storageClassName
+	}
+	return nil
+}
+
 func (im *Image) SourceResolverName() string {
 	return kmeta.ChildName(im.Name, "-source")
 }
@@ -245,6 +245,26 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 			assert.Equal(t, image.Spec.Build.Resources, build.Spec.Resources)
 		})
 
+		it("adds build pod scheduling", func() {
+			runtimeClass := "some-runtime-class"
+			image.Spec.Build = &ImageBuild{
+				Tolerations: []corev1.Toleration{
+					{Key: "builds", Operator: corev1.TolerationOpExists},
+				},
+				NodeSelector:      map[string]string{"pool": "builds"},
+				Affinity:          &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{}},
+				PriorityClassName: "some-priority-class",
+				RuntimeClassName:  &runtimeClass,
+			}
+
+			build := image.Build(sourceResolver, builder, latestBuild, "", "", "some-cache-name", 1)
+			assert.Equal(t, image.Spec.Build.Tolerations, build.Spec.Tolerations)
+			assert.Equal(t, image.Spec.Build.NodeSelector, build.Spec.NodeSelector)
+			assert.Equal(t, image.Spec.Build.Affinity, build.Spec.Affinity)
+			assert.Equal(t, "some-priority-class", build.Spec.PriorityClassName)
+			assert.Equal(t, &runtimeClass, build.Spec.RuntimeClassName)
+		})
+
 		it("sets the notary config when present", func() {
 			image.Spec.Notary = &NotaryConfig{
 				V1: &NotaryV1Config{
@@ -258,6 +278,49 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 
 			assert.Equal(t, image.Spec.Notary, build.Spec.Notary)
 		})
+
+		it("sets the signing config when present", func() {
+			image.Spec.Signing = &SigningConfig{
+				Cosign: &CosignConfig{
+					SecretRef: corev1.LocalObjectReference{
+						Name: "some-cosign-secret",
+					},
+				},
+			}
+			build := image.Build(sourceResolver, builder, latestBuild, "", "", "some-cache-name", 27)
+
+			assert.Equal(t, image.Spec.Signing, build.Spec.Signing)
+		})
+
+		it("sets the cache image when a registry cache is configured", func() {
+			image.Spec.Cache = &ImageCacheConfig{
+				Registry: &RegistryCache{Tag: "some/image-cache"},
+			}
+			build := image.Build(sourceResolver, builder, latestBuild, "", "", "", 27)
+
+			assert.Equal(t, "some/image-cache", build.Spec.CacheImage)
+		})
+	})
+
+	when("#CacheStatus", func() {
+		it("reports a volume cache when a cache size is set", func() {
+			cacheSize := resource.MustParse("2G")
+			image.Spec.CacheSize = &cacheSize
+
+			assert.Equal(t, &ImageCacheStatus{Kind: CacheKindVolume}, image.CacheStatus())
+		})
+
+		it("reports a registry cache when a registry cache is configured", func() {
+			image.Spec.Cache = &ImageCacheConfig{
+				Registry: &RegistryCache{Tag: "some/image-cache"},
+			}
+
+			assert.Equal(t, &ImageCacheStatus{Kind: CacheKindRegistry, Image: "some/image-cache"}, image.CacheStatus())
+		})
+
+		it("reports no cache otherwise", func() {
+			assert.Equal(t, &ImageCacheStatus{Kind: CacheKindNone}, image.CacheStatus())
+		})
 	})
 }
 
@@ -45,11 +45,13 @@ type ImageSpec struct {
 	ServiceAccount           string                 `json:"serviceAccount,omitempty"`
 	Source                   SourceConfig           `json:"source"`
 	CacheSize                *resource.Quantity     `json:"cacheSize,omitempty"`
+	Cache                    *ImageCacheConfig      `json:"cache,omitempty"`
 	FailedBuildHistoryLimit  *int64                 `json:"failedBuildHistoryLimit,omitempty"`
 	SuccessBuildHistoryLimit *int64                 `json:"successBuildHistoryLimit,omitempty"`
 	ImageTaggingStrategy     ImageTaggingStrategy   `json:"imageTaggingStrategy,omitempty"`
 	Build                    *ImageBuild            `json:"build,omitempty"`
 	Notary                   *NotaryConfig          `json:"notary,omitempty"`
+	Signing                  *SigningConfig         `json:"signing,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -72,18 +74,31 @@ type ImageBuild struct {
 	// +listType
 	Env       []corev1.EnvVar             `json:"env,omitempty"`
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+	// StepResources configures the resources of individual build pod steps.
+	StepResources *StepResources `json:"stepResources,omitempty"`
+	Hooks         *BuildHooks    `json:"hooks,omitempty"`
+	// DefaultProcess is the process type the built image starts by default.
+	// It requires platform API 0.4 or newer.
+	DefaultProcess string `json:"defaultProcess,omitempty"`
+	// +listType
+	Tolerations       []corev1.Toleration `json:"tolerations,omitempty"`
+	NodeSelector      map[string]string   `json:"nodeSelector,omitempty"`
+	Affinity          *corev1.Affinity    `json:"affinity,omitempty"`
+	PriorityClassName string              `json:"priorityClassName,omitempty"`
+	RuntimeClassName  *string             `json:"runtimeClassName,omitempty"`
 }
 
 // +k8s:openapi-gen=true
 type ImageStatus struct {
 	corev1alpha1.Status        `json:",inline"`
-	LatestBuildRef             string `json:"latestBuildRef,omitempty"`
-	LatestBuildImageGeneration int64  `json:"latestBuildImageGeneration,omitempty"`
-	LatestImage                string `json:"latestImage,omitempty"`
-	LatestStack                string `json:"latestStack,omitempty"`
-	BuildCounter               int64  `json:"buildCounter,omitempty"`
-	BuildCacheName             string `json:"buildCacheName,omitempty"`
-	LatestBuildReason          string `json:"latestBuildReason,omitempty"`
+	LatestBuildRef             string            `json:"latestBuildRef,omitempty"`
+	LatestBuildImageGeneration int64             `json:"latestBuildImageGeneration,omitempty"`
+	LatestImage                string            `json:"latestImage,omitempty"`
+	LatestStack                string            `json:"latestStack,omitempty"`
+	BuildCounter               int64             `json:"buildCounter,omitempty"`
+	BuildCacheName             string            `json:"buildCacheName,omitempty"`
+	LatestBuildReason          string            `json:"latestBuildReason,omitempty"`
+	Cache                      *ImageCacheStatus `json:"cache,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
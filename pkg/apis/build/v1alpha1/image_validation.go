@@ -6,6 +6,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
 
 	"github.com/pivotal/kpack/pkg/apis/validate"
@@ -15,8 +16,15 @@ type ImageContextKey string
 
 const (
 	HasDefaultStorageClass ImageContextKey = "hasDefaultStorageClass"
+	CacheStorageQuotaKey   ImageContextKey = "cacheStorageQuota"
 )
 
+// CacheStorageQuota checks the cacheSize of an image against the BuildQuotas of its namespace
+type CacheStorageQuota interface {
+	// ExceededCacheStorage describes the quota the cache of the image would exceed or is empty if it fits
+	ExceededCacheStorage(image *Image) (string, error)
+}
+
 var (
 	defaultFailedBuildHistoryLimit     int64 = 10
 	defaultSuccessfulBuildHistoryLimit int64 = 10
@@ -44,13 +52,55 @@ func (i *Image) SetDefaults(ctx context.Context) {
 		i.Spec.SuccessBuildHistoryLimit = &defaultSuccessfulBuildHistoryLimit
 	}
 
-	if i.Spec.CacheSize == nil && ctx.Value(HasDefaultStorageClass) != nil {
+	// the registry cache takes precedence over the size of the volume cache it replaces
+	if i.Spec.CacheSize != nil && i.Spec.RegistryCache() != nil && i.cacheSizeCarriedOver(ctx) {
+		i.Spec.CacheSize = nil
+	}
+
+	if i.Spec.CacheSize == nil && i.Spec.RegistryCache() == nil && (ctx.Value(HasDefaultStorageClass) != nil || i.Spec.storageClassName() != "") {
 		i.Spec.CacheSize = &defaultCacheSize
 	}
 }
 
+// cacheSizeCarriedOver is true for updates that add a registry cache to an Image with a volume cache of the same
+// size, usually the defaulted cacheSize that was never set by the user
+func (i *Image) cacheSizeCarriedOver(ctx context.Context) bool {
+	if !apis.IsInUpdate(ctx) {
+		return false
+	}
+
+	original, ok := apis.GetBaseline(ctx).(*Image)
+	if !ok || original.Spec.CacheSize == nil || original.Spec.RegistryCache() != nil {
+		return false
+	}
+	return original.Spec.CacheSize.Cmp(*i.Spec.CacheSize) == 0
+}
+
 func (i *Image) Validate(ctx context.Context) *apis.FieldError {
-	return i.Spec.Validate(ctx).ViaField("spec")
+	return i.Spec.Validate(ctx).ViaField("spec").
+		Also(i.validateCacheStorageQuota(ctx))
+}
+
+func (i *Image) validateCacheStorageQuota(ctx context.Context) *apis.FieldError {
+	quota, ok := ctx.Value(CacheStorageQuotaKey).(CacheStorageQuota)
+	if !ok || i.Spec.CacheSize == nil {
+		return nil
+	}
+
+	if apis.IsInUpdate(ctx) {
+		original := apis.GetBaseline(ctx).(*Image)
+		if original.Spec.CacheSize != nil && i.Spec.CacheSize.Cmp(*original.Spec.CacheSize) <= 0 {
+			return nil
+		}
+	}
+
+	exceeded, err := quota.ExceededCacheStorage(i)
+	if err != nil {
+		return &apis.FieldError{Message: err.Error(), Paths: []string{"spec.cacheSize"}}
+	} else if exceeded != "" {
+		return &apis.FieldError{Message: exceeded, Paths: []string{"spec.cacheSize"}}
+	}
+	return nil
 }
 
 func (is *ImageSpec) Validate(ctx context.Context) *apis.FieldError {
@@ -59,7 +109,9 @@ func (is *ImageSpec) Validate(ctx context.Context) *apis.FieldError {
 		Also(is.Source.Validate(ctx).ViaField("source")).
 		Also(is.Build.Validate(ctx).ViaField("build")).
 		Also(is.validateCacheSize(ctx)).
-		Also(is.Notary.Validate(ctx).ViaField("notary"))
+		Also(is.Cache.Validate(ctx).ViaField("cache")).
+		Also(is.Notary.Validate(ctx).ViaField("notary")).
+		Also(is.Signing.Validate(ctx).ViaField("signing"))
 }
 
 func (is *ImageSpec) validateTag(ctx context.Context) *apis.FieldError {
@@ -72,19 +124,12 @@ func (is *ImageSpec) validateTag(ctx context.Context) *apis.FieldError {
 }
 
 func (is *ImageSpec) validateCacheSize(ctx context.Context) *apis.FieldError {
-	if is.CacheSize != nil && ctx.Value(HasDefaultStorageClass) == nil {
-		return apis.ErrGeneric("spec.cacheSize cannot be set with no default StorageClass")
+	if is.CacheSize != nil && is.RegistryCache() != nil {
+		return apis.ErrMultipleOneOf("cacheSize", "cache.registry")
 	}
 
-	if apis.IsInUpdate(ctx) {
-		original := apis.GetBaseline(ctx).(*Image)
-		if original.Spec.CacheSize != nil && is.CacheSize.Cmp(*original.Spec.CacheSize) < 0 {
-			return &apis.FieldError{
-				Message: "Field cannot be decreased",
-				Paths:   []string{"cacheSize"},
-				Details: fmt.Sprintf("current: %v, requested: %v", original.Spec.CacheSize, is.CacheSize),
-			}
-		}
+	if is.CacheSize != nil && is.storageClassName() == "" && ctx.Value(HasDefaultStorageClass) == nil {
+		return apis.ErrGeneric("spec.cacheSize cannot be set with no default StorageClass")
 	}
 
 	return nil
@@ -105,7 +150,7 @@ func validateBuilder(builder v1.ObjectReference) *apis.FieldError {
 }
 
 func (s *SourceConfig) Validate(ctx context.Context) *apis.FieldError {
-	sources := make([]string, 0, 3)
+	sources := make([]string, 0, 5)
 	if s.Git != nil {
 		sources = append(sources, "git")
 	}
@@ -115,9 +160,15 @@ func (s *SourceConfig) Validate(ctx context.Context) *apis.FieldError {
 	if s.Registry != nil {
 		sources = append(sources, "registry")
 	}
+	if s.Artifact != nil {
+		sources = append(sources, "artifact")
+	}
+	if s.ArtifactRef != nil {
+		sources = append(sources, "artifactRef")
+	}
 
 	if len(sources) == 0 {
-		return apis.ErrMissingOneOf("git", "blob", "registry")
+		return apis.ErrMissingOneOf("git", "blob", "registry", "artifact", "artifactRef")
 	}
 
 	if len(sources) != 1 {
@@ -126,7 +177,9 @@ func (s *SourceConfig) Validate(ctx context.Context) *apis.FieldError {
 
 	return (s.Git.Validate(ctx).ViaField("git")).
 		Also(s.Blob.Validate(ctx).ViaField("blob")).
-		Also(s.Registry.Validate(ctx).ViaField("registry"))
+		Also(s.Registry.Validate(ctx).ViaField("registry")).
+		Also(s.Artifact.Validate(ctx).ViaField("artifact")).
+		Also(s.ArtifactRef.Validate(ctx).ViaField("artifactRef"))
 }
 
 func (g *Git) Validate(ctx context.Context) *apis.FieldError {
@@ -154,10 +207,141 @@ func (r *Registry) Validate(ctx context.Context) *apis.FieldError {
 	return validate.Image(r.Image)
 }
 
+func (a *Artifact) Validate(ctx context.Context) *apis.FieldError {
+	if a == nil {
+		return nil
+	}
+
+	return validate.FieldNotEmpty(a.URL, "url")
+}
+
+func (ar *ArtifactRef) Validate(ctx context.Context) *apis.FieldError {
+	if ar == nil {
+		return nil
+	}
+
+	return validate.FieldNotEmpty(ar.APIVersion, "apiVersion").
+		Also(validate.FieldNotEmpty(ar.Kind, "kind")).
+		Also(validate.FieldNotEmpty(ar.Name, "name"))
+}
+
 func (ib *ImageBuild) Validate(ctx context.Context) *apis.FieldError {
 	if ib == nil {
 		return nil
 	}
 
-	return ib.Bindings.Validate(ctx).ViaField("bindings")
+	return ib.Bindings.Validate(ctx).ViaField("bindings").
+		Also(ib.StepResources.Validate(ctx).ViaField("stepResources")).
+		Also(ib.Hooks.Validate(ctx).ViaField("hooks"))
+}
+
+func (bh *BuildHooks) Validate(ctx context.Context) *apis.FieldError {
+	if bh == nil {
+		return nil
+	}
+
+	return validateHooks(bh.PreBuild, PreBuildHookPrefix).ViaField("preBuild").
+		Also(validateHooks(bh.PostBuild, PostBuildHookPrefix).ViaField("postBuild"))
+}
+
+func validateHooks(hooks []v1.Container, prefix string) *apis.FieldError {
+	var errs *apis.FieldError
+	names := map[string]int{}
+	for i, hook := range hooks {
+		if n, ok := names[hook.Name]; ok {
+			errs = errs.Also(
+				apis.ErrGeneric(
+					fmt.Sprintf("duplicate hook name %q", hook.Name),
+					fmt.Sprintf("[%d].name", n),
+					fmt.Sprintf("[%d].name", i),
+				),
+			)
+		}
+		names[hook.Name] = i
+		errs = errs.Also(validateHook(hook, prefix).ViaIndex(i))
+	}
+	return errs
+}
+
+// validateHook rejects the container fields kpack manages or that init containers do not support
+func validateHook(hook v1.Container, prefix string) *apis.FieldError {
+	var errs *apis.FieldError
+	if hook.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	} else if len(validation.IsDNS1123Label(prefix+hook.Name)) > 0 {
+		errs = errs.Also(apis.ErrInvalidValue(hook.Name, "name"))
+	}
+
+	errs = errs.Also(validate.Image(hook.Image))
+
+	var disallowed []string
+	if len(hook.VolumeMounts) > 0 {
+		disallowed = append(disallowed, "volumeMounts")
+	}
+	if len(hook.VolumeDevices) > 0 {
+		disallowed = append(disallowed, "volumeDevices")
+	}
+	if hook.SecurityContext != nil {
+		disallowed = append(disallowed, "securityContext")
+	}
+	if hook.Lifecycle != nil {
+		disallowed = append(disallowed, "lifecycle")
+	}
+	if hook.LivenessProbe != nil {
+		disallowed = append(disallowed, "livenessProbe")
+	}
+	if hook.ReadinessProbe != nil {
+		disallowed = append(disallowed, "readinessProbe")
+	}
+	if hook.StartupProbe != nil {
+		disallowed = append(disallowed, "startupProbe")
+	}
+	if len(disallowed) > 0 {
+		errs = errs.Also(apis.ErrDisallowedFields(disallowed...))
+	}
+	return errs
+}
+
+func (sr *StepResources) Validate(ctx context.Context) *apis.FieldError {
+	if sr == nil {
+		return nil
+	}
+
+	return validateResourceRequirements(sr.Prepare).ViaField("prepare").
+		Also(validateResourceRequirements(sr.Detect).ViaField("detect")).
+		Also(validateResourceRequirements(sr.Analyze).ViaField("analyze")).
+		Also(validateResourceRequirements(sr.Restore).ViaField("restore")).
+		Also(validateResourceRequirements(sr.Build).ViaField("build")).
+		Also(validateResourceRequirements(sr.Export).ViaField("export")).
+		Also(validateResourceRequirements(sr.Rebase).ViaField("rebase")).
+		Also(validateResourceRequirements(sr.Completion).ViaField("completion"))
+}
+
+func validateResourceRequirements(resources *v1.ResourceRequirements) *apis.FieldError {
+	if resources == nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+	for name, limit := range resources.Limits {
+		if limit.Sign() < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(limit.String(), fmt.Sprintf("limits.%s", name)))
+		}
+	}
+
+	for name, request := range resources.Requests {
+		if request.Sign() < 0 {
+			errs = errs.Also(apis.ErrInvalidValue(request.String(), fmt.Sprintf("requests.%s", name)))
+			continue
+		}
+
+		if limit, ok := resources.Limits[name]; ok && request.Cmp(limit) > 0 {
+			errs = errs.Also(&apis.FieldError{
+				Message: "request must be less than or equal to limit",
+				Paths:   []string{fmt.Sprintf("requests.%s", name)},
+				Details: fmt.Sprintf("request: %s, limit: %s", request.String(), limit.String()),
+			})
+		}
+	}
+	return errs
 }
@@ -109,6 +109,68 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 					assert.Nil(t, image.Spec.CacheSize)
 				})
 			})
+
+			when("a cache volume storage class is configured", func() {
+				it("sets the default cache size without a default storage class", func() {
+					image.Spec.Cache = &ImageCacheConfig{
+						Volume: &VolumeCache{StorageClassName: "some-storage-class"},
+					}
+					image.SetDefaults(context.TODO())
+
+					assert.NotNil(t, image.Spec.CacheSize)
+					assert.Equal(t, image.Spec.CacheSize.String(), "2G")
+				})
+			})
+
+			when("a registry cache is configured", func() {
+				it("does not set the default cache size", func() {
+					image.Spec.Cache = &ImageCacheConfig{
+						Registry: &RegistryCache{Tag: "some/image-cache"},
+					}
+					image.SetDefaults(ctx)
+
+					assert.Nil(t, image.Spec.CacheSize)
+				})
+			})
+		})
+
+		when("a registry cache is added to an image with a volume cache", func() {
+			var original *Image
+
+			it.Before(func() {
+				image.Spec.CacheSize = nil
+				image.SetDefaults(ctx)
+				original = image.DeepCopy()
+
+				image.Spec.Cache = &ImageCacheConfig{
+					Registry: &RegistryCache{Tag: "some/image-cache"},
+				}
+			})
+
+			it("drops the defaulted cache size so the update is valid", func() {
+				updateCtx := apis.WithinUpdate(ctx, original)
+				image.SetDefaults(updateCtx)
+
+				assert.Nil(t, image.Spec.CacheSize)
+				assert.Nil(t, image.Validate(updateCtx))
+			})
+
+			it("keeps a cache size changed in the same update", func() {
+				changedSize := resource.MustParse("10G")
+				image.Spec.CacheSize = &changedSize
+
+				updateCtx := apis.WithinUpdate(ctx, original)
+				image.SetDefaults(updateCtx)
+
+				assert.Equal(t, &changedSize, image.Spec.CacheSize)
+				assert.Equal(t, apis.ErrMultipleOneOf("spec.cacheSize", "spec.cache.registry").Error(), image.Validate(updateCtx).Error())
+			})
+
+			it("keeps the cache size when the image is created with both", func() {
+				image.SetDefaults(ctx)
+
+				assert.NotNil(t, image.Spec.CacheSize)
+			})
 		})
 	})
 
@@ -174,7 +236,7 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 		it("missing source", func() {
 			image.Spec.Source = SourceConfig{}
 
-			assertValidationError(image, ctx, apis.ErrMissingOneOf("git", "blob", "registry").ViaField("spec", "source"))
+			assertValidationError(image, ctx, apis.ErrMissingOneOf("git", "blob", "registry", "artifact", "artifactRef").ViaField("spec", "source"))
 		})
 
 		it("validates git url", func() {
@@ -216,6 +278,22 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 			assertValidationError(image, ctx, apis.ErrInvalidValue(image.Spec.Source.Registry.Image, "image").ViaField("spec", "source", "registry"))
 		})
 
+		it("validates artifact url", func() {
+			image.Spec.Source.Git = nil
+			image.Spec.Source.Artifact = &Artifact{URL: ""}
+
+			assertValidationError(image, ctx, apis.ErrMissingField("url").ViaField("spec", "source", "artifact"))
+		})
+
+		it("validates artifactRef fields", func() {
+			image.Spec.Source.Git = nil
+			image.Spec.Source.ArtifactRef = &ArtifactRef{Kind: "GitRepository"}
+
+			assertValidationError(image, ctx,
+				apis.ErrMissingField("apiVersion").ViaField("spec", "source", "artifactRef").
+					Also(apis.ErrMissingField("name").ViaField("spec", "source", "artifactRef")))
+		})
+
 		it("validates build bindings", func() {
 			image.Spec.Build.Bindings = []Binding{
 				{MetadataRef: &corev1.LocalObjectReference{Name: "metadata"}},
@@ -224,12 +302,127 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 			assertValidationError(image, ctx, apis.ErrMissingField("spec.build.bindings[0].name"))
 		})
 
+		it("handles valid step resources", func() {
+			image.Spec.Build.StepResources = &StepResources{
+				Build: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1G")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2G")},
+				},
+				Export: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			}
+
+			assert.Nil(t, image.Validate(ctx))
+		})
+
+		it("validates step resource requests do not exceed limits", func() {
+			image.Spec.Build.StepResources = &StepResources{
+				Detect: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+					Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+				},
+			}
+
+			assertValidationError(image, ctx, &apis.FieldError{
+				Message: "request must be less than or equal to limit",
+				Paths:   []string{"spec.build.stepResources.detect.requests.cpu"},
+				Details: "request: 2, limit: 1",
+			})
+		})
+
+		it("validates step resources are not negative", func() {
+			image.Spec.Build.StepResources = &StepResources{
+				Completion: &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1G")},
+				},
+			}
+
+			assertValidationError(image, ctx, apis.ErrInvalidValue("-1G", "spec.build.stepResources.completion.limits.memory"))
+		})
+
+		it("handles valid hooks", func() {
+			image.Spec.Build.Hooks = &BuildHooks{
+				PreBuild:  []corev1.Container{{Name: "unit-tests", Image: "some/test-runner"}},
+				PostBuild: []corev1.Container{{Name: "smoke-tests", Image: "some/smoke-tests"}},
+			}
+
+			assert.Nil(t, image.Validate(ctx))
+		})
+
+		it("validates hook names and images", func() {
+			image.Spec.Build.Hooks = &BuildHooks{
+				PreBuild: []corev1.Container{
+					{Name: "unit-tests", Image: "some/test-runner"},
+					{Name: "unit-tests", Image: "some/test-runner"},
+				},
+				PostBuild: []corev1.Container{
+					{Name: "Smoke_Tests", Image: "some/smoke-tests"},
+					{Image: "some/smoke-tests"},
+					{Name: "verify"},
+				},
+			}
+
+			assertValidationError(image, ctx,
+				apis.ErrGeneric(`duplicate hook name "unit-tests"`, "spec.build.hooks.preBuild[0].name", "spec.build.hooks.preBuild[1].name").
+					Also(apis.ErrInvalidValue("Smoke_Tests", "spec.build.hooks.postBuild[0].name")).
+					Also(apis.ErrMissingField("spec.build.hooks.postBuild[1].name")).
+					Also(apis.ErrMissingField("spec.build.hooks.postBuild[2].image")))
+		})
+
+		it("validates hooks do not set fields managed by kpack", func() {
+			image.Spec.Build.Hooks = &BuildHooks{
+				PreBuild: []corev1.Container{
+					{
+						Name:            "unit-tests",
+						Image:           "some/test-runner",
+						VolumeMounts:    []corev1.VolumeMount{{Name: "some-volume", MountPath: "/some/path"}},
+						SecurityContext: &corev1.SecurityContext{},
+					},
+				},
+			}
+
+			assertValidationError(image, ctx, apis.ErrDisallowedFields("spec.build.hooks.preBuild[0].volumeMounts", "spec.build.hooks.preBuild[0].securityContext"))
+		})
+
 		it("validates cache size is not set when there is no default StorageClass", func() {
 			ctx = context.TODO()
 
 			assertValidationError(image, ctx, apis.ErrGeneric("spec.cacheSize cannot be set with no default StorageClass"))
 		})
 
+		it("validates the registry cache tag", func() {
+			image.Spec.CacheSize = nil
+			image.Spec.Cache = &ImageCacheConfig{
+				Registry: &RegistryCache{Tag: "some/image-cache"},
+			}
+			assert.Nil(t, image.Validate(ctx))
+
+			image.Spec.Cache.Registry.Tag = ""
+			assertValidationError(image, ctx, apis.ErrMissingField("spec.cache.registry.tag"))
+
+			image.Spec.Cache.Registry.Tag = "some/image-cache:invalid:tag"
+			assertValidationError(image, ctx, apis.ErrInvalidValue("some/image-cache:invalid:tag", "spec.cache.registry.tag"))
+		})
+
+		it("validates cache size is not set with a registry cache", func() {
+			image.Spec.Cache = &ImageCacheConfig{
+				Registry: &RegistryCache{Tag: "some/image-cache"},
+			}
+
+			assertValidationError(image, ctx, apis.ErrMultipleOneOf("spec.cacheSize", "spec.cache.registry"))
+		})
+
+		it("allows removing the cache size to switch to a registry cache", func() {
+			original := image.DeepCopy()
+			image.Spec.CacheSize = nil
+			image.Spec.Cache = &ImageCacheConfig{
+				Registry: &RegistryCache{Tag: "some/image-cache"},
+			}
+
+			assert.Nil(t, image.Validate(apis.WithinUpdate(ctx, original)))
+		})
+
 		it("combining errors", func() {
 			image.Spec.Tag = ""
 			image.Spec.Builder.Kind = "FakeBuilder"
@@ -246,12 +439,93 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 			assert.EqualError(t, err, "Immutable field changed: spec.tag\ngot: something/different, want: some/image")
 		})
 
-		it("image.cacheSize has not decreased", func() {
+		it("image.cacheSize can be decreased as the cache is recreated", func() {
 			original := image.DeepCopy()
 			cacheSize := resource.MustParse("4G")
 			image.Spec.CacheSize = &cacheSize
-			err := image.Validate(apis.WithinUpdate(ctx, original))
-			assert.EqualError(t, err, "Field cannot be decreased: spec.cacheSize\ncurrent: 5G, requested: 4G")
+			assert.Nil(t, image.Validate(apis.WithinUpdate(ctx, original)))
+		})
+
+		when("validating the cache volume", func() {
+			it("handles a valid storage class and access modes", func() {
+				image.Spec.Cache = &ImageCacheConfig{
+					Volume: &VolumeCache{
+						StorageClassName: "some-storage-class",
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+					},
+				}
+				assert.Nil(t, image.Validate(ctx))
+			})
+
+			it("allows a cache size with a storage class and no default StorageClass", func() {
+				image.Spec.Cache = &ImageCacheConfig{
+					Volume: &VolumeCache{StorageClassName: "some-storage-class"},
+				}
+				assert.Nil(t, image.Validate(context.TODO()))
+			})
+
+			it("requires a storage class with no default StorageClass", func() {
+				image.Spec.CacheSize = nil
+				image.Spec.Cache = &ImageCacheConfig{
+					Volume: &VolumeCache{},
+				}
+				assertValidationError(image, context.TODO(), apis.ErrMissingField("spec.cache.volume.storageClassName"))
+			})
+
+			it("handles read only access modes", func() {
+				image.Spec.Cache = &ImageCacheConfig{
+					Volume: &VolumeCache{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadOnlyMany},
+					},
+				}
+				assertValidationError(image, ctx, apis.ErrInvalidArrayValue(corev1.ReadOnlyMany, "spec.cache.volume.accessModes", 1))
+			})
+
+			it("handles a volume and a registry cache", func() {
+				image.Spec.CacheSize = nil
+				image.Spec.Cache = &ImageCacheConfig{
+					Volume:   &VolumeCache{},
+					Registry: &RegistryCache{Tag: "some/image-cache"},
+				}
+				assertValidationError(image, ctx, apis.ErrMultipleOneOf("spec.cache.volume", "spec.cache.registry"))
+			})
+		})
+
+		when("validating the cache storage quota", func() {
+			quota := &fakeCacheStorageQuota{}
+			var quotaCtx context.Context
+
+			it.Before(func() {
+				*quota = fakeCacheStorageQuota{}
+				quotaCtx = context.WithValue(ctx, CacheStorageQuotaKey, quota)
+			})
+
+			it("rejects a cache size that exceeds a quota", func() {
+				quota.exceeded = "exceeded quota: some-quota"
+				assertValidationError(image, quotaCtx, &apis.FieldError{Message: "exceeded quota: some-quota", Paths: []string{"spec.cacheSize"}})
+				assert.Equal(t, []string{"image-name"}, quota.checked)
+			})
+
+			it("accepts a cache size that fits the quotas", func() {
+				assert.Nil(t, image.Validate(quotaCtx))
+			})
+
+			it("does not check a cache size that is not increased", func() {
+				quota.exceeded = "exceeded quota: some-quota"
+				original := image.DeepCopy()
+
+				assert.Nil(t, image.Validate(apis.WithinUpdate(quotaCtx, original)))
+				assert.Empty(t, quota.checked)
+			})
+
+			it("checks an increased cache size", func() {
+				quota.exceeded = "exceeded quota: some-quota"
+				original := image.DeepCopy()
+				cacheSize := resource.MustParse("10G")
+				image.Spec.CacheSize = &cacheSize
+
+				assert.EqualError(t, image.Validate(apis.WithinUpdate(quotaCtx, original)), "exceeded quota: some-quota: spec.cacheSize")
+			})
 		})
 
 		when("validating the notary config", func() {
@@ -292,6 +566,94 @@ func testImageValidation(t *testing.T, when spec.G, it spec.S) {
 				err := image.Validate(ctx)
 				assert.EqualError(t, err, "missing field(s): spec.notary.v1.secretRef.name")
 			})
+
+			it("handles a valid notary role, tags and root key ids", func() {
+				image.Spec.Notary = &NotaryConfig{
+					V1: &NotaryV1Config{
+						URL: "some-url",
+						SecretRef: NotarySecretRef{
+							Name: "some-secret-name",
+						},
+						Role:       "targets/releases",
+						Tags:       []string{"latest", "v*"},
+						RootKeyIDs: []string{"9f9d2c8a0e6e1b4d3c2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c"},
+					},
+				}
+				assert.Nil(t, image.Validate(ctx))
+			})
+
+			it("handles an invalid notary role", func() {
+				image.Spec.Notary = &NotaryConfig{
+					V1: &NotaryV1Config{
+						URL: "some-url",
+						SecretRef: NotarySecretRef{
+							Name: "some-secret-name",
+						},
+						Role: "releases",
+					},
+				}
+				err := image.Validate(ctx)
+				assert.EqualError(t, err, "invalid value: releases: spec.notary.v1.role")
+			})
+
+			it("handles invalid notary tag patterns", func() {
+				image.Spec.Notary = &NotaryConfig{
+					V1: &NotaryV1Config{
+						URL: "some-url",
+						SecretRef: NotarySecretRef{
+							Name: "some-secret-name",
+						},
+						Tags: []string{"latest", "v[1"},
+					},
+				}
+				err := image.Validate(ctx)
+				assert.EqualError(t, err, "invalid value: v[1: spec.notary.v1.tags[1]")
+			})
+
+			it("handles invalid notary root key ids", func() {
+				image.Spec.Notary = &NotaryConfig{
+					V1: &NotaryV1Config{
+						URL: "some-url",
+						SecretRef: NotarySecretRef{
+							Name: "some-secret-name",
+						},
+						RootKeyIDs: []string{"not-a-key-id"},
+					},
+				}
+				err := image.Validate(ctx)
+				assert.EqualError(t, err, "invalid value: not-a-key-id: spec.notary.v1.rootKeyIDs[0]")
+			})
+		})
+
+		when("validating the signing config", func() {
+			it("handles a valid cosign config", func() {
+				image.Spec.Signing = &SigningConfig{
+					Cosign: &CosignConfig{
+						SecretRef: corev1.LocalObjectReference{
+							Name: "some-secret-name",
+						},
+					},
+				}
+				assert.Nil(t, image.Validate(ctx))
+			})
+
+			it("handles an empty cosign secret ref", func() {
+				image.Spec.Signing = &SigningConfig{
+					Cosign: &CosignConfig{},
+				}
+				err := image.Validate(ctx)
+				assert.EqualError(t, err, "missing field(s): spec.signing.cosign.secretRef.name")
+			})
 		})
 	})
 }
+
+type fakeCacheStorageQuota struct {
+	exceeded string
+	checked  []string
+}
+
+func (f *fakeCacheStorageQuota) ExceededCacheStorage(image *Image) (string, error) {
+	f.checked = append(f.checked, image.Name)
+	return f.exceeded, nil
+}
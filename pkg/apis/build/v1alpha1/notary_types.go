@@ -9,9 +9,26 @@ type NotaryConfig struct {
 type NotaryV1Config struct {
 	URL       string          `json:"url"`
 	SecretRef NotarySecretRef `json:"secretRef"`
+	// Role is the targets role or targets/<delegation> role that signs the tags.
+	// Every role the key can sign for is used when it is empty.
+	Role string `json:"role,omitempty"`
+	// Tags are glob patterns selecting the tags to sign, all tags are signed when empty.
+	// +listType
+	Tags []string `json:"tags,omitempty"`
+	// RootKeyIDs pin the root keys the trust data must be signed with instead of trusting it on first use.
+	// +listType
+	RootKeyIDs []string `json:"rootKeyIDs,omitempty"`
 }
 
 // +k8s:openapi-gen=true
 type NotarySecretRef struct {
 	Name string `json:"name"`
 }
+
+// +k8s:openapi-gen=true
+type NotarySignedTarget struct {
+	Name   string `json:"name"`
+	Digest string `json:"digest"`
+	// +listType
+	Roles []string `json:"roles,omitempty"`
+}
@@ -2,12 +2,19 @@ package v1alpha1
 
 import (
 	"context"
+	"path"
+	"regexp"
+	"strings"
 
 	"knative.dev/pkg/apis"
 
 	"github.com/pivotal/kpack/pkg/apis/validate"
 )
 
+const notaryTargetsRole = "targets"
+
+var rootKeyIDRegex = regexp.MustCompile("^[a-f0-9]{64}$")
+
 func (n *NotaryConfig) Validate(ctx context.Context) *apis.FieldError {
 	if n == nil {
 		return nil
@@ -20,5 +27,39 @@ func (n *NotaryV1Config) Validate(ctx context.Context) *apis.FieldError {
 		return nil
 	}
 	return validate.FieldNotEmpty(n.URL, "url").
-		Also(validate.FieldNotEmpty(n.SecretRef.Name, "secretRef.name"))
+		Also(validate.FieldNotEmpty(n.SecretRef.Name, "secretRef.name")).
+		Also(validateNotaryRole(n.Role).ViaField("role")).
+		Also(validateNotaryTags(n.Tags).ViaField("tags")).
+		Also(validateRootKeyIDs(n.RootKeyIDs).ViaField("rootKeyIDs"))
+}
+
+func validateNotaryRole(role string) *apis.FieldError {
+	if role == "" || role == notaryTargetsRole {
+		return nil
+	}
+
+	if !strings.HasPrefix(role, notaryTargetsRole+"/") || len(role) == len(notaryTargetsRole+"/") {
+		return apis.ErrInvalidValue(role, "")
+	}
+	return nil
+}
+
+func validateNotaryTags(tags []string) *apis.FieldError {
+	var errs *apis.FieldError
+	for i, tag := range tags {
+		if _, err := path.Match(tag, ""); err != nil || tag == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(tag, "", i))
+		}
+	}
+	return errs
+}
+
+func validateRootKeyIDs(keyIDs []string) *apis.FieldError {
+	var errs *apis.FieldError
+	for i, keyID := range keyIDs {
+		if !rootKeyIDRegex.MatchString(keyID) {
+			errs = errs.Also(apis.ErrInvalidArrayValue(keyID, "", i))
+		}
+	}
+	return errs
 }
@@ -0,0 +1,146 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const platformAPIEnvVar = "CNB_PLATFORM_API"
+
+// platformAPI describes how the lifecycle is invoked for a platform API version
+// +k8s:deepcopy-gen=false
+type platformAPI struct {
+	version string
+	// lifecycles default to platform API 0.3 unless CNB_PLATFORM_API is set
+	platformAPIEnv  bool
+	projectMetadata bool
+	separateReport  bool
+	creator         bool
+	uidGid          bool
+	processType     bool
+	runImage        bool
+}
+
+// platformAPIs are the platform API versions kpack supports in ascending order
+var platformAPIs = []platformAPI{
+	{
+		version: "0.2",
+	},
+	{
+		version:         "0.3",
+		projectMetadata: true,
+		separateReport:  true,
+		creator:         true,
+	},
+	{
+		version:         "0.4",
+		platformAPIEnv:  true,
+		projectMetadata: true,
+		separateReport:  true,
+		creator:         true,
+		uidGid:          true,
+		processType:     true,
+	},
+	{
+		version:         "0.5",
+		platformAPIEnv:  true,
+		projectMetadata: true,
+		separateReport:  true,
+		creator:         true,
+		uidGid:          true,
+		processType:     true,
+		runImage:        true,
+	},
+}
+
+func SupportedPlatformAPIs() []string {
+	versions := make([]string, 0, len(platformAPIs))
+	for _, api := range platformAPIs {
+		versions = append(versions, api.version)
+	}
+	return versions
+}
+
+// NegotiatePlatformAPI selects the highest platform API supported by both kpack and a builder's lifecycle
+func NegotiatePlatformAPI(builderPlatformAPIs []string) (string, error) {
+	for i := len(platformAPIs) - 1; i >= 0; i-- {
+		supported, err := semver.NewVersion(platformAPIs[i].version)
+		if err != nil {
+			return "", err
+		}
+
+		for _, builderPlatformAPI := range builderPlatformAPIs {
+			version, err := semver.NewVersion(builderPlatformAPI)
+			if err != nil {
+				continue
+			}
+
+			if version.Equal(supported) {
+				return platformAPIs[i].version, nil
+			}
+		}
+	}
+
+	return "", errors.Errorf("unsupported builder platform API versions: %v", builderPlatformAPIs)
+}
+
+func lookupPlatformAPI(version string) (platformAPI, bool) {
+	for _, api := range platformAPIs {
+		if api.version == version {
+			return api, true
+		}
+	}
+	return platformAPI{}, false
+}
+
+func (api platformAPI) env(env ...corev1.EnvVar) []corev1.EnvVar {
+	if api.platformAPIEnv {
+		env = append(env, corev1.EnvVar{Name: platformAPIEnvVar, Value: api.version})
+	}
+	return env
+}
+
+func (api platformAPI) projectMetadataArgs() []string {
+	if !api.projectMetadata {
+		return nil
+	}
+	return a("-project-metadata=/layers/project-metadata.toml")
+}
+
+func (api platformAPI) reportArgs() []string {
+	if !api.separateReport {
+		return nil
+	}
+	return a("-report=/var/report/report.toml")
+}
+
+func (api platformAPI) reportVolumeMounts(volumeMounts ...corev1.VolumeMount) []corev1.VolumeMount {
+	if !api.separateReport {
+		return volumeMounts
+	}
+	return append(volumeMounts, reportVolume)
+}
+
+func (api platformAPI) uidGidArgs(bc BuildPodBuilderConfig) []string {
+	if !api.uidGid {
+		return nil
+	}
+	return a(fmt.Sprintf("-uid=%d", bc.Uid), fmt.Sprintf("-gid=%d", bc.Gid))
+}
+
+func (api platformAPI) runImageArgs(bc BuildPodBuilderConfig) []string {
+	if !api.runImage || bc.RunImage == "" {
+		return nil
+	}
+	return a("-run-image=" + bc.RunImage)
+}
+
+func (api platformAPI) processTypeArgs(processType string) []string {
+	if !api.processType || processType == "" {
+		return nil
+	}
+	return a("-process-type=" + processType)
+}
@@ -61,6 +61,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterBuilderList{},
 		&Builder{},
 		&BuilderList{},
+		&BuildQuota{},
+		&BuildQuotaList{},
+		&RolloutPolicy{},
+		&RolloutPolicyList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
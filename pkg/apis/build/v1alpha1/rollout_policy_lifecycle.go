@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	RolloutHalted = "Halted"
+	// RolloutUnselectedWave is the final wave of the images that match no wave of a policy
+	RolloutUnselectedWave = "unselected"
+)
+
+// Wave is the index of the first wave that selects the image or of the final unselected wave after the waves of the spec
+func (p *RolloutPolicy) Wave(image *Image) (int, error) {
+	for i, wave := range p.Spec.Waves {
+		selector, err := metav1.LabelSelectorAsSelector(&wave.Selector)
+		if err != nil {
+			return 0, err
+		}
+
+		if selector.Matches(labels.Set(image.Labels)) {
+			return i, nil
+		}
+	}
+	return len(p.Spec.Waves), nil
+}
+
+// WaveNames are the names of the waves of the spec followed by the unselected wave
+func (p *RolloutPolicy) WaveNames() []string {
+	names := make([]string, 0, len(p.Spec.Waves)+1)
+	for _, wave := range p.Spec.Waves {
+		names = append(names, wave.Name)
+	}
+	return append(names, RolloutUnselectedWave)
+}
+
+// CountsFailure reports whether a failed build counts towards the failure threshold, builds that failed before ResumeFrom do not
+func (p *RolloutPolicy) CountsFailure(build *Build) bool {
+	if p.Spec.ResumeFrom == nil {
+		return true
+	}
+
+	succeeded := build.Status.GetCondition(corev1alpha1.ConditionSucceeded)
+	return succeeded == nil || !succeeded.LastTransitionTime.Inner.Before(p.Spec.ResumeFrom)
+}
+
+// Halted describes the first wave that failed more rebuilds than the failure threshold, it is empty while the rollout proceeds
+func (p *RolloutPolicy) Halted(waves []RolloutWaveStatus) string {
+	threshold := p.failureThreshold()
+	for _, wave := range waves {
+		if wave.Failed*100 > threshold*wave.Images {
+			return fmt.Sprintf("wave %s failed %d of %d rebuilds exceeding the failure threshold of %d%%",
+				wave.Name, wave.Failed, wave.Images, threshold)
+		}
+	}
+	return ""
+}
+
+func (p *RolloutPolicy) failureThreshold() int64 {
+	if p.Spec.FailureThreshold == nil {
+		return defaultRolloutFailureThreshold
+	}
+	return *p.Spec.FailureThreshold
+}
+
+// ActiveWave is the index of the first wave with outdated or building images or the number of waves once all are rebuilt
+func (p *RolloutPolicy) ActiveWave(waves []RolloutWaveStatus) int {
+	for i, wave := range waves {
+		if wave.Outdated > 0 || wave.Building > 0 {
+			return i
+		}
+	}
+	return len(waves)
+}
+
+// Admits reports whether the images of a wave may rebuild for new stacks and buildpacks from the progress in the status.
+// Every wave is held back until the progress of the current spec is reported.
+func (p *RolloutPolicy) Admits(wave int) bool {
+	if p.Status.ObservedGeneration != p.Generation || len(p.Status.Waves) == 0 {
+		return false
+	}
+
+	if ready := p.Status.GetCondition(corev1alpha1.ConditionReady); ready.IsFalse() && ready.Reason == RolloutHalted {
+		return false
+	}
+	return p.activeWave() >= wave
+}
+
+// activeWave is the index of the active wave in the status or the number of waves once all are rebuilt
+func (p *RolloutPolicy) activeWave() int {
+	if p.Status.ActiveWave == "" {
+		return len(p.Status.Waves)
+	}
+
+	for i, wave := range p.Status.Waves {
+		if wave.Name == p.Status.ActiveWave {
+			return i
+		}
+	}
+	return 0
+}
@@ -0,0 +1,77 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const RolloutPolicyKind = "RolloutPolicy"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object,k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMetaAccessor
+
+// +k8s:openapi-gen=true
+type RolloutPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RolloutPolicySpec   `json:"spec"`
+	Status RolloutPolicyStatus `json:"status,omitempty"`
+}
+
+// RolloutPolicySpec rolls out the STACK and BUILDPACK rebuilds of the images of a namespace in waves
+// +k8s:openapi-gen=true
+type RolloutPolicySpec struct {
+	// Waves are rebuilt in order. An image belongs to the first wave whose selector matches its labels,
+	// images that match no wave are rebuilt last in the unselected wave.
+	// +listType
+	Waves []RolloutWave `json:"waves"`
+	// FailureThreshold is the percentage of the images of a wave whose rebuild may fail before the rollout halts, 10 if omitted
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+	// ResumeFrom resumes a halted rollout, rebuilds that failed before it do not count towards the failure threshold
+	ResumeFrom *metav1.Time `json:"resumeFrom,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type RolloutWave struct {
+	Name     string               `json:"name"`
+	Selector metav1.LabelSelector `json:"selector"`
+}
+
+// +k8s:openapi-gen=true
+type RolloutPolicyStatus struct {
+	corev1alpha1.Status `json:",inline"`
+	// ActiveWave is the first wave with images that are not rebuilt yet
+	ActiveWave string `json:"activeWave,omitempty"`
+	// +listType
+	Waves []RolloutWaveStatus `json:"waves,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type RolloutWaveStatus struct {
+	Name   string `json:"name"`
+	Images int64  `json:"images"`
+	// Outdated images wait for a rebuild with the stack and buildpacks of their builder
+	Outdated int64 `json:"outdated"`
+	// Building images run a STACK or BUILDPACK build
+	Building int64 `json:"building"`
+	// Failed images failed their last STACK or BUILDPACK build
+	Failed int64 `json:"failed"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +k8s:openapi-gen=true
+type RolloutPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +k8s:listType=atomic
+	Items []RolloutPolicy `json:"items"`
+}
+
+func (*RolloutPolicy) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind(RolloutPolicyKind)
+}
@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+const defaultRolloutFailureThreshold int64 = 10
+
+func (p *RolloutPolicy) SetDefaults(context.Context) {
+	if p.Spec.FailureThreshold == nil {
+		threshold := defaultRolloutFailureThreshold
+		p.Spec.FailureThreshold = &threshold
+	}
+}
+
+func (p *RolloutPolicy) Validate(ctx context.Context) *apis.FieldError {
+	return p.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (ps *RolloutPolicySpec) Validate(context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	if len(ps.Waves) == 0 {
+		errs = errs.Also(apis.ErrMissingField("waves"))
+	}
+
+	names := map[string]bool{}
+	for i, wave := range ps.Waves {
+		switch {
+		case wave.Name == "":
+			errs = errs.Also(apis.ErrMissingField("name").ViaFieldIndex("waves", i))
+		case names[wave.Name] || wave.Name == RolloutUnselectedWave:
+			errs = errs.Also(apis.ErrInvalidValue(wave.Name, "name").ViaFieldIndex("waves", i))
+		}
+		names[wave.Name] = true
+
+		if _, err := metav1.LabelSelectorAsSelector(&wave.Selector); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(err.Error(), "selector").ViaFieldIndex("waves", i))
+		}
+	}
+
+	if ps.FailureThreshold != nil && (*ps.FailureThreshold < 0 || *ps.FailureThreshold > 100) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*ps.FailureThreshold, 0, 100, "failureThreshold"))
+	}
+	return errs
+}
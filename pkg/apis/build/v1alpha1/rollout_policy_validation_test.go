@@ -0,0 +1,105 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+)
+
+func TestRolloutPolicyValidation(t *testing.T) {
+	spec.Run(t, "RolloutPolicy Validation", testRolloutPolicyValidation)
+}
+
+func testRolloutPolicyValidation(t *testing.T, when spec.G, it spec.S) {
+	var threshold int64 = 20
+	policy := &RolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "policy-name",
+			Namespace: "policy-namespace",
+		},
+		Spec: RolloutPolicySpec{
+			Waves: []RolloutWave{
+				{
+					Name: "canary",
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"rollout": "canary"},
+					},
+				},
+				{
+					Name: "rest",
+				},
+			},
+			FailureThreshold: &threshold,
+		},
+	}
+
+	when("Default", func() {
+		it("does not modify already set fields", func() {
+			oldPolicy := policy.DeepCopy()
+			policy.SetDefaults(context.TODO())
+
+			assert.Equal(t, policy, oldPolicy)
+		})
+
+		it("defaults FailureThreshold to 10 percent", func() {
+			policy.Spec.FailureThreshold = nil
+
+			policy.SetDefaults(context.TODO())
+
+			assert.Equal(t, int64(10), *policy.Spec.FailureThreshold)
+		})
+	})
+
+	when("Validate", func() {
+		assertValidationError := func(policy *RolloutPolicy, expectedError *apis.FieldError) {
+			t.Helper()
+			err := policy.Validate(context.TODO())
+			assert.EqualError(t, err, expectedError.Error())
+		}
+
+		it("returns nil on no validation error", func() {
+			assert.Nil(t, policy.Validate(context.TODO()))
+		})
+
+		it("missing waves", func() {
+			policy.Spec.Waves = nil
+
+			assertValidationError(policy, apis.ErrMissingField("waves").ViaField("spec"))
+		})
+
+		it("missing wave name", func() {
+			policy.Spec.Waves[1].Name = ""
+
+			assertValidationError(policy, apis.ErrMissingField("name").ViaFieldIndex("waves", 1).ViaField("spec"))
+		})
+
+		it("duplicate wave name", func() {
+			policy.Spec.Waves[1].Name = "canary"
+
+			assertValidationError(policy, apis.ErrInvalidValue("canary", "name").ViaFieldIndex("waves", 1).ViaField("spec"))
+		})
+
+		it("reserved wave name", func() {
+			policy.Spec.Waves[1].Name = RolloutUnselectedWave
+
+			assertValidationError(policy, apis.ErrInvalidValue("unselected", "name").ViaFieldIndex("waves", 1).ViaField("spec"))
+		})
+
+		it("invalid selector", func() {
+			policy.Spec.Waves[0].Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "rollout", Operator: "Unknown"}}
+
+			assertValidationError(policy, apis.ErrInvalidValue(`"Unknown" is not a valid pod selector operator`, "selector").ViaFieldIndex("waves", 0).ViaField("spec"))
+		})
+
+		it("failure threshold out of bounds", func() {
+			var outOfBounds int64 = 101
+			policy.Spec.FailureThreshold = &outOfBounds
+
+			assertValidationError(policy, apis.ErrOutOfBoundsValue(int64(101), 0, 100, "failureThreshold").ViaField("spec"))
+		})
+	})
+}
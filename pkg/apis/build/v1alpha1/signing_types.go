@@ -0,0 +1,17 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// +k8s:openapi-gen=true
+type SigningConfig struct {
+	Cosign *CosignConfig `json:"cosign,omitempty"`
+}
+
+// CosignConfig signs built images with a cosign key.
+// The secret holds the private key in `cosign.key` and, for encrypted keys, its password in `cosign.password`.
+// +k8s:openapi-gen=true
+type CosignConfig struct {
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+}
@@ -0,0 +1,23 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/pivotal/kpack/pkg/apis/validate"
+)
+
+func (s *SigningConfig) Validate(ctx context.Context) *apis.FieldError {
+	if s == nil {
+		return nil
+	}
+	return s.Cosign.Validate(ctx).ViaField("cosign")
+}
+
+func (c *CosignConfig) Validate(ctx context.Context) *apis.FieldError {
+	if c == nil {
+		return nil
+	}
+	return validate.FieldNotEmpty(c.SecretRef.Name, "secretRef.name")
+}
@@ -2,11 +2,20 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
 )
 
-const ActivePolling = "ActivePolling"
+const (
+	ActivePolling  = "ActivePolling"
+	SourceResolved = "SourceResolved"
+
+	SourceAuthenticationFailed = "AuthenticationFailed"
+	SourceNotFound             = "SourceNotFound"
+	SourceNetworkError         = "NetworkError"
+	SourceRevisionNotFound     = "RevisionNotFound"
+)
 
 func (sr *SourceResolver) ResolvedSource(config ResolvedSourceConfig) {
 	resolvedSource := config.ResolvedSource()
@@ -17,10 +26,16 @@ func (sr *SourceResolver) ResolvedSource(config ResolvedSourceConfig) {
 
 	sr.Status.Source = config
 
-	sr.Status.Conditions = []corev1alpha1.Condition{{
-		Type:   corev1alpha1.ConditionReady,
-		Status: corev1.ConditionTrue,
-	}}
+	sr.Status.Conditions = []corev1alpha1.Condition{
+		{
+			Type:   corev1alpha1.ConditionReady,
+			Status: corev1.ConditionTrue,
+		},
+		{
+			Type:   SourceResolved,
+			Status: corev1.ConditionTrue,
+		},
+	}
 
 	pollingStatus := corev1.ConditionFalse
 	if resolvedSource.IsPollable() {
@@ -32,6 +47,39 @@ func (sr *SourceResolver) ResolvedSource(config ResolvedSourceConfig) {
 	})
 }
 
+// ResolveFailed records a failure to resolve the source while keeping the last resolved source.
+// The SourceResolved transition time is preserved across repeated failures so polling can back off.
+func (sr *SourceResolver) ResolveFailed(reason, message string) {
+	failedSince := corev1alpha1.VolatileTime{Inner: metav1.Now()}
+	if resolved := sr.Status.GetCondition(SourceResolved); resolved.IsFalse() {
+		failedSince = resolved.LastTransitionTime
+	}
+
+	sr.Status.Conditions = []corev1alpha1.Condition{
+		{
+			Type:    corev1alpha1.ConditionReady,
+			Status:  corev1.ConditionFalse,
+			Reason:  reason,
+			Message: message,
+		},
+		{
+			Type:               SourceResolved,
+			Status:             corev1.ConditionFalse,
+			Reason:             reason,
+			Message:            message,
+			LastTransitionTime: failedSince,
+		},
+		{
+			Type:   ActivePolling,
+			Status: corev1.ConditionTrue,
+		},
+	}
+}
+
+func (sr *SourceResolver) ResolveFailing() bool {
+	return sr.Status.GetCondition(SourceResolved).IsFalse()
+}
+
 func (sr *SourceResolver) PollingReady() bool {
 	return sr.Status.GetCondition(ActivePolling).IsTrue()
 }
@@ -53,6 +101,10 @@ func (sr SourceResolver) IsRegistry() bool {
 	return sr.Spec.Source.Registry != nil
 }
 
+func (sr SourceResolver) IsArtifact() bool {
+	return sr.Spec.Source.Artifact != nil || sr.Spec.Source.ArtifactRef != nil
+}
+
 func (st *SourceResolver) SourceConfig() SourceConfig {
 	return st.Status.Source.ResolvedSource().SourceConfig()
 }
@@ -2,14 +2,17 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // +k8s:openapi-gen=true
 type SourceConfig struct {
-	Git      *Git      `json:"git,omitempty"`
-	Blob     *Blob     `json:"blob,omitempty"`
-	Registry *Registry `json:"registry,omitempty"`
-	SubPath  string    `json:"subPath,omitempty"`
+	Git         *Git         `json:"git,omitempty"`
+	Blob        *Blob        `json:"blob,omitempty"`
+	Registry    *Registry    `json:"registry,omitempty"`
+	Artifact    *Artifact    `json:"artifact,omitempty"`
+	ArtifactRef *ArtifactRef `json:"artifactRef,omitempty"`
+	SubPath     string       `json:"subPath,omitempty"`
 }
 
 func (sc *SourceConfig) Source() Source {
@@ -19,6 +22,8 @@ func (sc *SourceConfig) Source() Source {
 		return sc.Blob
 	} else if sc.Registry != nil {
 		return sc.Registry
+	} else if sc.Artifact != nil {
+		return sc.Artifact
 	}
 	return nil
 }
@@ -117,11 +122,54 @@ func (r *Registry) BuildEnvVars() []corev1.EnvVar {
 	}
 }
 
+// +k8s:openapi-gen=true
+type Artifact struct {
+	URL      string `json:"url"`
+	Revision string `json:"revision,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+func (a *Artifact) ImagePullSecretsVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: imagePullSecretsDirName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+}
+
+func (a *Artifact) BuildEnvVars() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name:  "ARTIFACT_URL",
+			Value: a.URL,
+		},
+		{
+			Name:  "ARTIFACT_REVISION",
+			Value: a.Revision,
+		},
+		{
+			Name:  "ARTIFACT_CHECKSUM",
+			Value: a.Checksum,
+		},
+	}
+}
+
+// ArtifactRef references an object in the Image's namespace whose status
+// exposes an artifact in the form status.artifact{url,revision,checksum}.
+// +k8s:openapi-gen=true
+type ArtifactRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+}
+
 // +k8s:openapi-gen=true
 type ResolvedSourceConfig struct {
 	Git      *ResolvedGitSource      `json:"git,omitempty"`
 	Blob     *ResolvedBlobSource     `json:"blob,omitempty"`
 	Registry *ResolvedRegistrySource `json:"registry,omitempty"`
+	Artifact *ResolvedArtifactSource `json:"artifact,omitempty"`
 }
 
 func (sc ResolvedSourceConfig) ResolvedSource() ResolvedSource {
@@ -131,6 +179,8 @@ func (sc ResolvedSourceConfig) ResolvedSource() ResolvedSource {
 		return sc.Blob
 	} else if sc.Registry != nil {
 		return sc.Registry
+	} else if sc.Artifact != nil {
+		return sc.Artifact
 	}
 	return nil
 }
@@ -156,6 +206,18 @@ type ResolvedGitSource struct {
 	Revision string        `json:"revision"`
 	SubPath  string        `json:"subPath,omitempty"`
 	Type     GitSourceKind `json:"type"`
+	Commit   *GitCommit    `json:"commit,omitempty"`
+}
+
+// GitCommit describes the commit a git revision resolved to
+// +k8s:openapi-gen=true
+type GitCommit struct {
+	Branch    string       `json:"branch,omitempty"`
+	Tag       string       `json:"tag,omitempty"`
+	Author    string       `json:"author,omitempty"`
+	Committer string       `json:"committer,omitempty"`
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+	Subject   string       `json:"subject,omitempty"`
 }
 
 func (gs *ResolvedGitSource) SourceConfig() SourceConfig {
@@ -226,3 +288,31 @@ func (rs *ResolvedRegistrySource) IsUnknown() bool {
 func (rs *ResolvedRegistrySource) IsPollable() bool {
 	return false
 }
+
+// +k8s:openapi-gen=true
+type ResolvedArtifactSource struct {
+	URL      string `json:"url"`
+	Revision string `json:"revision,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+	SubPath  string `json:"subPath,omitempty"`
+}
+
+func (as *ResolvedArtifactSource) SourceConfig() SourceConfig {
+	return SourceConfig{
+		Artifact: &Artifact{
+			URL:      as.URL,
+			Revision: as.Revision,
+			Checksum: as.Checksum,
+		},
+		SubPath: as.SubPath,
+	}
+}
+
+func (as *ResolvedArtifactSource) IsUnknown() bool {
+	return false
+}
+
+// Artifact sources are watched rather than polled
+func (as *ResolvedArtifactSource) IsPollable() bool {
+	return false
+}
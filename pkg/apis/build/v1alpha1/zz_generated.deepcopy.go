@@ -26,6 +26,55 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Artifact) DeepCopyInto(out *Artifact) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Artifact.
+func (in *Artifact) DeepCopy() *Artifact {
+	if in == nil {
+		return nil
+	}
+	out := new(Artifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArtifactRef) DeepCopyInto(out *ArtifactRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArtifactRef.
+func (in *ArtifactRef) DeepCopy() *ArtifactRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ArtifactRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BOMPackage) DeepCopyInto(out *BOMPackage) {
+	*out = *in
+	out.Buildpack = in.Buildpack
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BOMPackage.
+func (in *BOMPackage) DeepCopy() *BOMPackage {
+	if in == nil {
+		return nil
+	}
+	out := new(BOMPackage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Binding) DeepCopyInto(out *Binding) {
 	*out = *in
@@ -139,6 +188,36 @@ func (in *BuildBuilderSpec) DeepCopy() *BuildBuilderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildHooks) DeepCopyInto(out *BuildHooks) {
+	*out = *in
+	if in.PreBuild != nil {
+		in, out := &in.PreBuild, &out.PreBuild
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostBuild != nil {
+		in, out := &in.PostBuild, &out.PostBuild
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildHooks.
+func (in *BuildHooks) DeepCopy() *BuildHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildList) DeepCopyInto(out *BuildList) {
 	*out = *in
@@ -191,6 +270,8 @@ func (in *BuildPodBuilderConfig) DeepCopy() *BuildPodBuilderConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildPodImages) DeepCopyInto(out *BuildPodImages) {
 	*out = *in
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
+	in.StepResources.DeepCopyInto(&out.StepResources)
 	return
 }
 
@@ -204,6 +285,188 @@ func (in *BuildPodImages) DeepCopy() *BuildPodImages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildPodScheduling) DeepCopyInto(out *BuildPodScheduling) {
+	*out = *in
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPodScheduling.
+func (in *BuildPodScheduling) DeepCopy() *BuildPodScheduling {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildPodScheduling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuota) DeepCopyInto(out *BuildQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuota.
+func (in *BuildQuota) DeepCopy() *BuildQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *BuildQuota) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuotaList) DeepCopyInto(out *BuildQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BuildQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuotaList.
+func (in *BuildQuotaList) DeepCopy() *BuildQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BuildQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuotaSpec) DeepCopyInto(out *BuildQuotaSpec) {
+	*out = *in
+	if in.ConcurrentBuilds != nil {
+		in, out := &in.ConcurrentBuilds, &out.ConcurrentBuilds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CacheStorage != nil {
+		in, out := &in.CacheStorage, &out.CacheStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuotaSpec.
+func (in *BuildQuotaSpec) DeepCopy() *BuildQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuotaStatus) DeepCopyInto(out *BuildQuotaStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	in.Used.DeepCopyInto(&out.Used)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuotaStatus.
+func (in *BuildQuotaStatus) DeepCopy() *BuildQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildQuotaUsage) DeepCopyInto(out *BuildQuotaUsage) {
+	*out = *in
+	out.CPU = in.CPU.DeepCopy()
+	out.Memory = in.Memory.DeepCopy()
+	out.CacheStorage = in.CacheStorage.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildQuotaUsage.
+func (in *BuildQuotaUsage) DeepCopy() *BuildQuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 	*out = *in
@@ -229,6 +492,16 @@ func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 		}
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.StepResources != nil {
+		in, out := &in.StepResources, &out.StepResources
+		*out = new(StepResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BuildHooks)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LastBuild != nil {
 		in, out := &in.LastBuild, &out.LastBuild
 		*out = new(LastBuild)
@@ -239,6 +512,35 @@ func (in *BuildSpec) DeepCopyInto(out *BuildSpec) {
 		*out = new(NotaryConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(SigningConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -278,6 +580,11 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 		copy(*out, *in)
 	}
 	out.Stack = in.Stack
+	if in.Commit != nil {
+		in, out := &in.Commit, &out.Commit
+		*out = new(GitCommit)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StepStates != nil {
 		in, out := &in.StepStates, &out.StepStates
 		*out = make([]v1.ContainerState, len(*in))
@@ -290,6 +597,30 @@ func (in *BuildStatus) DeepCopyInto(out *BuildStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]BuildStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Timing != nil {
+		in, out := &in.Timing, &out.Timing
+		*out = new(BuildTiming)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BOM != nil {
+		in, out := &in.BOM, &out.BOM
+		*out = make([]BOMPackage, len(*in))
+		copy(*out, *in)
+	}
+	if in.SignedTargets != nil {
+		in, out := &in.SignedTargets, &out.SignedTargets
+		*out = make([]NotarySignedTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -303,6 +634,66 @@ func (in *BuildStatus) DeepCopy() *BuildStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildStep) DeepCopyInto(out *BuildStep) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ExitCode != nil {
+		in, out := &in.ExitCode, &out.ExitCode
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildStep.
+func (in *BuildStep) DeepCopy() *BuildStep {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildTiming) DeepCopyInto(out *BuildTiming) {
+	*out = *in
+	if in.Queued != nil {
+		in, out := &in.Queued, &out.Queued
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Running != nil {
+		in, out := &in.Running, &out.Running
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildTiming.
+func (in *BuildTiming) DeepCopy() *BuildTiming {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildTiming)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Builder) DeepCopyInto(out *Builder) {
 	*out = *in
@@ -901,25 +1292,62 @@ func (in *ClusterStoreStatus) DeepCopy() *ClusterStoreStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Git) DeepCopyInto(out *Git) {
+func (in *CosignConfig) DeepCopyInto(out *CosignConfig) {
 	*out = *in
+	out.SecretRef = in.SecretRef
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Git.
-func (in *Git) DeepCopy() *Git {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignConfig.
+func (in *CosignConfig) DeepCopy() *CosignConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Git)
+	out := new(CosignConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
+func (in *Git) DeepCopyInto(out *Git) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Git.
+func (in *Git) DeepCopy() *Git {
+	if in == nil {
+		return nil
+	}
+	out := new(Git)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitCommit) DeepCopyInto(out *GitCommit) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCommit.
+func (in *GitCommit) DeepCopy() *GitCommit {
+	if in == nil {
+		return nil
+	}
+	out := new(GitCommit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
@@ -962,6 +1390,40 @@ func (in *ImageBuild) DeepCopyInto(out *ImageBuild) {
 		}
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.StepResources != nil {
+		in, out := &in.StepResources, &out.StepResources
+		*out = new(StepResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(BuildHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -992,6 +1454,53 @@ func (in *ImageBuilder) DeepCopy() *ImageBuilder {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCacheConfig) DeepCopyInto(out *ImageCacheConfig) {
+	*out = *in
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(VolumeCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistryCache)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCacheConfig.
+func (in *ImageCacheConfig) DeepCopy() *ImageCacheConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCacheConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCacheStatus) DeepCopyInto(out *ImageCacheStatus) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCacheStatus.
+func (in *ImageCacheStatus) DeepCopy() *ImageCacheStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCacheStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageList) DeepCopyInto(out *ImageList) {
 	*out = *in
@@ -1035,6 +1544,11 @@ func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(ImageCacheConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FailedBuildHistoryLimit != nil {
 		in, out := &in.FailedBuildHistoryLimit, &out.FailedBuildHistoryLimit
 		*out = new(int64)
@@ -1055,6 +1569,11 @@ func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
 		*out = new(NotaryConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Signing != nil {
+		in, out := &in.Signing, &out.Signing
+		*out = new(SigningConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1072,6 +1591,11 @@ func (in *ImageSpec) DeepCopy() *ImageSpec {
 func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
 	*out = *in
 	in.Status.DeepCopyInto(&out.Status)
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(ImageCacheStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1124,7 +1648,7 @@ func (in *NotaryConfig) DeepCopyInto(out *NotaryConfig) {
 	if in.V1 != nil {
 		in, out := &in.V1, &out.V1
 		*out = new(NotaryV1Config)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -1155,10 +1679,41 @@ func (in *NotarySecretRef) DeepCopy() *NotarySecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotarySignedTarget) DeepCopyInto(out *NotarySignedTarget) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotarySignedTarget.
+func (in *NotarySignedTarget) DeepCopy() *NotarySignedTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(NotarySignedTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NotaryV1Config) DeepCopyInto(out *NotaryV1Config) {
 	*out = *in
 	out.SecretRef = in.SecretRef
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RootKeyIDs != nil {
+		in, out := &in.RootKeyIDs, &out.RootKeyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1236,6 +1791,38 @@ func (in *Registry) DeepCopy() *Registry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryCache) DeepCopyInto(out *RegistryCache) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryCache.
+func (in *RegistryCache) DeepCopy() *RegistryCache {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedArtifactSource) DeepCopyInto(out *ResolvedArtifactSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedArtifactSource.
+func (in *ResolvedArtifactSource) DeepCopy() *ResolvedArtifactSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedArtifactSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvedBlobSource) DeepCopyInto(out *ResolvedBlobSource) {
 	*out = *in
@@ -1278,6 +1865,11 @@ func (in *ResolvedClusterStack) DeepCopy() *ResolvedClusterStack {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvedGitSource) DeepCopyInto(out *ResolvedGitSource) {
 	*out = *in
+	if in.Commit != nil {
+		in, out := &in.Commit, &out.Commit
+		*out = new(GitCommit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1318,7 +1910,7 @@ func (in *ResolvedSourceConfig) DeepCopyInto(out *ResolvedSourceConfig) {
 	if in.Git != nil {
 		in, out := &in.Git, &out.Git
 		*out = new(ResolvedGitSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Blob != nil {
 		in, out := &in.Blob, &out.Blob
@@ -1330,6 +1922,11 @@ func (in *ResolvedSourceConfig) DeepCopyInto(out *ResolvedSourceConfig) {
 		*out = new(ResolvedRegistrySource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(ResolvedArtifactSource)
+		**out = **in
+	}
 	return
 }
 
@@ -1343,6 +1940,183 @@ func (in *ResolvedSourceConfig) DeepCopy() *ResolvedSourceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicy) DeepCopyInto(out *RolloutPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicy.
+func (in *RolloutPolicy) DeepCopy() *RolloutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObjectMetaAccessor is an autogenerated deepcopy function, copying the receiver, creating a new metav1.ObjectMetaAccessor.
+func (in *RolloutPolicy) DeepCopyObjectMetaAccessor() metav1.ObjectMetaAccessor {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicyList) DeepCopyInto(out *RolloutPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolloutPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicyList.
+func (in *RolloutPolicyList) DeepCopy() *RolloutPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicySpec) DeepCopyInto(out *RolloutPolicySpec) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]RolloutWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ResumeFrom != nil {
+		in, out := &in.ResumeFrom, &out.ResumeFrom
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicySpec.
+func (in *RolloutPolicySpec) DeepCopy() *RolloutPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicyStatus) DeepCopyInto(out *RolloutPolicyStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]RolloutWaveStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicyStatus.
+func (in *RolloutPolicyStatus) DeepCopy() *RolloutPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutWave) DeepCopyInto(out *RolloutWave) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutWave.
+func (in *RolloutWave) DeepCopy() *RolloutWave {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutWaveStatus) DeepCopyInto(out *RolloutWaveStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutWaveStatus.
+func (in *RolloutWaveStatus) DeepCopy() *RolloutWaveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutWaveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SigningConfig) DeepCopyInto(out *SigningConfig) {
+	*out = *in
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(CosignConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SigningConfig.
+func (in *SigningConfig) DeepCopy() *SigningConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SigningConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceConfig) DeepCopyInto(out *SourceConfig) {
 	*out = *in
@@ -1361,6 +2135,16 @@ func (in *SourceConfig) DeepCopyInto(out *SourceConfig) {
 		*out = new(Registry)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Artifact != nil {
+		in, out := &in.Artifact, &out.Artifact
+		*out = new(Artifact)
+		**out = **in
+	}
+	if in.ArtifactRef != nil {
+		in, out := &in.ArtifactRef, &out.ArtifactRef
+		*out = new(ArtifactRef)
+		**out = **in
+	}
 	return
 }
 
@@ -1470,6 +2254,62 @@ func (in *SourceResolverStatus) DeepCopy() *SourceResolverStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepResources) DeepCopyInto(out *StepResources) {
+	*out = *in
+	if in.Prepare != nil {
+		in, out := &in.Prepare, &out.Prepare
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Detect != nil {
+		in, out := &in.Detect, &out.Detect
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Analyze != nil {
+		in, out := &in.Analyze, &out.Analyze
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Build != nil {
+		in, out := &in.Build, &out.Build
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rebase != nil {
+		in, out := &in.Rebase, &out.Rebase
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Completion != nil {
+		in, out := &in.Completion, &out.Completion
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepResources.
+func (in *StepResources) DeepCopy() *StepResources {
+	if in == nil {
+		return nil
+	}
+	out := new(StepResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StoreBuildpack) DeepCopyInto(out *StoreBuildpack) {
 	*out = *in
@@ -1518,3 +2358,24 @@ func (in *StoreImage) DeepCopy() *StoreImage {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeCache) DeepCopyInto(out *VolumeCache) {
+	*out = *in
+	if in.AccessModes != nil {
+		in, out := &in.AccessModes, &out.AccessModes
+		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeCache.
+func (in *VolumeCache) DeepCopy() *VolumeCache {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeCache)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,172 @@
+package artifact
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// artifactable is the duck type of any object that publishes a source artifact in its status
+type artifactable struct {
+	Status struct {
+		Artifact *v1alpha1.Artifact `json:"artifact,omitempty"`
+	} `json:"status"`
+}
+
+// defaultSyncTimeout bounds how long a resolve waits for the informer of an artifact kind,
+// a kind that is not served or not readable never syncs
+const defaultSyncTimeout = 10 * time.Second
+
+type Resolver struct {
+	// SyncTimeout bounds how long a resolve waits for a new informer to sync before it fails and is retried
+	SyncTimeout time.Duration
+
+	mapper  meta.RESTMapper
+	factory dynamicinformer.DynamicSharedInformerFactory
+	stopCh  <-chan struct{}
+
+	m         sync.Mutex
+	informers map[schema.GroupVersionResource]informers.GenericInformer
+	handlers  []cache.ResourceEventHandler
+}
+
+func NewResolver(dynamicClient dynamic.Interface, mapper meta.RESTMapper, resyncPeriod time.Duration, stopCh <-chan struct{}) *Resolver {
+	return &Resolver{
+		SyncTimeout: defaultSyncTimeout,
+		mapper:      mapper,
+		factory:     dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, resyncPeriod),
+		stopCh:      stopCh,
+		informers:   map[schema.GroupVersionResource]informers.GenericInformer{},
+	}
+}
+
+// AddEventHandler registers a handler on every informer the resolver starts, including those started later
+func (r *Resolver) AddEventHandler(handler cache.ResourceEventHandler) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.handlers = append(r.handlers, handler)
+	for _, informer := range r.informers {
+		informer.Informer().AddEventHandler(handler)
+	}
+}
+
+func (r *Resolver) Resolve(sourceResolver *v1alpha1.SourceResolver) (v1alpha1.ResolvedSourceConfig, error) {
+	if sourceResolver.Spec.Source.Artifact != nil {
+		return resolvedSourceConfig(*sourceResolver.Spec.Source.Artifact, sourceResolver.Spec.Source.SubPath), nil
+	}
+
+	ref := sourceResolver.Spec.Source.ArtifactRef
+
+	lister, err := r.lister(ref)
+	if err != nil {
+		return v1alpha1.ResolvedSourceConfig{}, err
+	}
+
+	obj, err := lister.ByNamespace(sourceResolver.Namespace).Get(ref.Name)
+	if err != nil {
+		return v1alpha1.ResolvedSourceConfig{}, errors.Wrapf(err, "cannot retrieve %s %s", ref.Kind, ref.Name)
+	}
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return v1alpha1.ResolvedSourceConfig{}, errors.Errorf("unexpected object type %T", obj)
+	}
+
+	var source artifactable
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &source)
+	if err != nil {
+		return v1alpha1.ResolvedSourceConfig{}, errors.Wrapf(err, "cannot read artifact from %s %s", ref.Kind, ref.Name)
+	}
+
+	if source.Status.Artifact == nil || source.Status.Artifact.URL == "" {
+		return v1alpha1.ResolvedSourceConfig{}, errors.Errorf("%s %s has no artifact available", ref.Kind, ref.Name)
+	}
+
+	return resolvedSourceConfig(*source.Status.Artifact, sourceResolver.Spec.Source.SubPath), nil
+}
+
+func (*Resolver) CanResolve(sourceResolver *v1alpha1.SourceResolver) bool {
+	return sourceResolver.IsArtifact()
+}
+
+func (r *Resolver) lister(ref *v1alpha1.ArtifactRef) (cache.GenericLister, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := r.mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot find resource for %s %s", ref.APIVersion, ref.Kind)
+	}
+
+	informer := r.informerFor(mapping.Resource)
+	if !r.waitForSync(informer.Informer()) {
+		return nil, errors.Errorf("timed out waiting for the informer of %s to sync", mapping.Resource)
+	}
+
+	return informer.Lister(), nil
+}
+
+// waitForSync waits at most SyncTimeout so that a kind that never syncs does not block the reconcile worker.
+// The informer keeps syncing in the background and a later resolve uses it once it has synced.
+func (r *Resolver) waitForSync(informer cache.SharedIndexInformer) bool {
+	if informer.HasSynced() {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.SyncTimeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-r.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return cache.WaitForCacheSync(ctx.Done(), informer.HasSynced)
+}
+
+func (r *Resolver) informerFor(gvr schema.GroupVersionResource) informers.GenericInformer {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	informer, ok := r.informers[gvr]
+	if ok {
+		return informer
+	}
+
+	informer = r.factory.ForResource(gvr)
+	for _, handler := range r.handlers {
+		informer.Informer().AddEventHandler(handler)
+	}
+	r.informers[gvr] = informer
+	r.factory.Start(r.stopCh)
+
+	return informer
+}
+
+func resolvedSourceConfig(artifact v1alpha1.Artifact, subPath string) v1alpha1.ResolvedSourceConfig {
+	return v1alpha1.ResolvedSourceConfig{
+		Artifact: &v1alpha1.ResolvedArtifactSource{
+			URL:      artifact.URL,
+			Revision: artifact.Revision,
+			Checksum: artifact.Checksum,
+			SubPath:  subPath,
+		},
+	}
+}
@@ -0,0 +1,210 @@
+package artifact_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/artifact"
+)
+
+func TestResolver(t *testing.T) {
+	spec.Run(t, "Artifact Resolver", testResolver)
+}
+
+func testResolver(t *testing.T, when spec.G, it spec.S) {
+	const namespace = "some-namespace"
+
+	var (
+		gitRepositoryGVK = schema.GroupVersionKind{Group: "source.toolkit.fluxcd.io", Version: "v1beta1", Kind: "GitRepository"}
+		stopCh           = make(chan struct{})
+		resolver         *artifact.Resolver
+	)
+
+	newGitRepository := func(name string, artifact map[string]interface{}) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gitRepositoryGVK)
+		u.SetNamespace(namespace)
+		u.SetName(name)
+		if artifact != nil {
+			require.NoError(t, unstructured.SetNestedMap(u.Object, artifact, "status", "artifact"))
+		}
+		return u
+	}
+
+	sourceResolver := func(name string) *v1alpha1.SourceResolver {
+		return &v1alpha1.SourceResolver{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-source-resolver",
+				Namespace: namespace,
+			},
+			Spec: v1alpha1.SourceResolverSpec{
+				Source: v1alpha1.SourceConfig{
+					ArtifactRef: &v1alpha1.ArtifactRef{
+						APIVersion: "source.toolkit.fluxcd.io/v1beta1",
+						Kind:       "GitRepository",
+						Name:       name,
+					},
+					SubPath: "some-sub-path",
+				},
+			},
+		}
+	}
+
+	it.Before(func() {
+		mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{gitRepositoryGVK.GroupVersion()})
+		mapper.Add(gitRepositoryGVK, meta.RESTScopeNamespace)
+
+		dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+			newGitRepository("ready-repo", map[string]interface{}{
+				"url":      "http://source-controller.flux-system/gitrepository/some-namespace/ready-repo/abcdef.tar.gz",
+				"revision": "main/abcdef",
+				"checksum": "4f1b1e5d8a2c",
+			}),
+			newGitRepository("pending-repo", nil),
+		)
+
+		resolver = artifact.NewResolver(dynamicClient, mapper, time.Hour, stopCh)
+	})
+
+	it.After(func() {
+		close(stopCh)
+	})
+
+	when("#Resolve", func() {
+		it("copies the artifact url, revision and checksum from the referenced object", func() {
+			resolvedSource, err := resolver.Resolve(sourceResolver("ready-repo"))
+			require.NoError(t, err)
+
+			require.Equal(t, v1alpha1.ResolvedSourceConfig{
+				Artifact: &v1alpha1.ResolvedArtifactSource{
+					URL:      "http://source-controller.flux-system/gitrepository/some-namespace/ready-repo/abcdef.tar.gz",
+					Revision: "main/abcdef",
+					Checksum: "4f1b1e5d8a2c",
+					SubPath:  "some-sub-path",
+				},
+			}, resolvedSource)
+		})
+
+		it("passes through an artifact source", func() {
+			resolvedSource, err := resolver.Resolve(&v1alpha1.SourceResolver{
+				Spec: v1alpha1.SourceResolverSpec{
+					Source: v1alpha1.SourceConfig{
+						Artifact: &v1alpha1.Artifact{
+							URL:      "https://example.com/source.tar.gz",
+							Checksum: "sha256:abcdef",
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			require.Equal(t, v1alpha1.ResolvedSourceConfig{
+				Artifact: &v1alpha1.ResolvedArtifactSource{
+					URL:      "https://example.com/source.tar.gz",
+					Checksum: "sha256:abcdef",
+				},
+			}, resolvedSource)
+		})
+
+		it("errors when the referenced object has no artifact", func() {
+			_, err := resolver.Resolve(sourceResolver("pending-repo"))
+			require.EqualError(t, err, "GitRepository pending-repo has no artifact available")
+		})
+
+		it("errors when the referenced object does not exist", func() {
+			_, err := resolver.Resolve(sourceResolver("missing-repo"))
+			require.Error(t, err)
+		})
+
+		it("errors when the referenced kind is unknown", func() {
+			sr := sourceResolver("ready-repo")
+			sr.Spec.Source.ArtifactRef.Kind = "HelmChart"
+
+			_, err := resolver.Resolve(sr)
+			require.Error(t, err)
+		})
+	})
+
+	when("the informer of the referenced kind never syncs", func() {
+		it("errors after the sync timeout instead of blocking", func() {
+			helmChartGVK := schema.GroupVersionKind{Group: "source.toolkit.fluxcd.io", Version: "v1beta1", Kind: "HelmChart"}
+			mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{helmChartGVK.GroupVersion()})
+			mapper.Add(helmChartGVK, meta.RESTScopeNamespace)
+
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+			dynamicClient.PrependReactor("list", "helmcharts", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, nil, k8serrors.NewForbidden(schema.GroupResource{Group: helmChartGVK.Group, Resource: "helmcharts"}, "", errors.New("rbac"))
+			})
+
+			unsyncedResolver := artifact.NewResolver(dynamicClient, mapper, time.Hour, stopCh)
+			unsyncedResolver.SyncTimeout = 100 * time.Millisecond
+
+			sr := sourceResolver("some-chart")
+			sr.Spec.Source.ArtifactRef.Kind = "HelmChart"
+
+			resolved := make(chan error)
+			go func() {
+				_, err := unsyncedResolver.Resolve(sr)
+				resolved <- err
+			}()
+
+			select {
+			case err := <-resolved:
+				require.EqualError(t, err, "timed out waiting for the informer of source.toolkit.fluxcd.io/v1beta1, Resource=helmcharts to sync")
+			case <-time.After(5 * time.Second):
+				t.Fatal("resolve blocked on an informer that never syncs")
+			}
+		})
+	})
+
+	when("#AddEventHandler", func() {
+		it("registers handlers on informers started for referenced kinds", func() {
+			added := make(chan string, 10)
+			resolver.AddEventHandler(cache.ResourceEventHandlerFuncs{
+				AddFunc: func(obj interface{}) {
+					added <- obj.(*unstructured.Unstructured).GetName()
+				},
+			})
+
+			_, err := resolver.Resolve(sourceResolver("ready-repo"))
+			require.NoError(t, err)
+
+			names := map[string]bool{}
+			for i := 0; i < 2; i++ {
+				select {
+				case name := <-added:
+					names[name] = true
+				case <-time.After(5 * time.Second):
+					t.Fatal("timed out waiting for informer events")
+				}
+			}
+			require.Equal(t, map[string]bool{"ready-repo": true, "pending-repo": true}, names)
+		})
+	})
+
+	when("#CanResolve", func() {
+		it("resolves artifact and artifactRef sources", func() {
+			require.True(t, resolver.CanResolve(sourceResolver("ready-repo")))
+			require.True(t, resolver.CanResolve(&v1alpha1.SourceResolver{
+				Spec: v1alpha1.SourceResolverSpec{Source: v1alpha1.SourceConfig{Artifact: &v1alpha1.Artifact{}}},
+			}))
+			require.False(t, resolver.CanResolve(&v1alpha1.SourceResolver{
+				Spec: v1alpha1.SourceResolverSpec{Source: v1alpha1.SourceConfig{Blob: &v1alpha1.Blob{}}},
+			}))
+		})
+	})
+}
@@ -0,0 +1,53 @@
+package blob
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func verifyChecksum(file io.ReadSeeker, checksum string) error {
+	h, expected, err := checksumHash(checksum)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(h, file)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Seek(0, 0)
+	if err != nil {
+		return err
+	}
+
+	actual := hex.EncodeToString(h.Sum(nil))
+	if actual != expected {
+		return errors.Errorf("checksum mismatch: expected %s, got %s", expected, actual)
+	}
+	return nil
+}
+
+func checksumHash(checksum string) (hash.Hash, string, error) {
+	algorithm := ""
+	value := checksum
+	if i := strings.Index(checksum, ":"); i != -1 {
+		algorithm, value = checksum[:i], checksum[i+1:]
+	}
+	value = strings.ToLower(value)
+
+	switch {
+	case algorithm == "sha256" || (algorithm == "" && len(value) == sha256.Size*2):
+		return sha256.New(), value, nil
+	case algorithm == "sha1" || (algorithm == "" && len(value) == sha1.Size*2):
+		return sha1.New(), value, nil
+	default:
+		return nil, "", errors.Errorf("unsupported checksum %q", checksum)
+	}
+}
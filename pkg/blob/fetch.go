@@ -20,6 +20,12 @@ type Fetcher struct {
 }
 
 func (f *Fetcher) Fetch(dir string, blobURL string) error {
+	return f.FetchWithChecksum(dir, blobURL, "")
+}
+
+// FetchWithChecksum fails if the downloaded blob does not match the provided checksum.
+// An empty checksum skips verification.
+func (f *Fetcher) FetchWithChecksum(dir string, blobURL string, checksum string) error {
 	u, err := url.Parse(blobURL)
 	if err != nil {
 		return err
@@ -32,6 +38,14 @@ func (f *Fetcher) Fetch(dir string, blobURL string) error {
 	}
 	defer os.RemoveAll(file.Name())
 
+	if checksum != "" {
+		err = verifyChecksum(file, checksum)
+		if err != nil {
+			return err
+		}
+		f.Logger.Printf("Verified checksum %s", checksum)
+	}
+
 	mediaType, err := classifyFile(file)
 	if err != nil {
 		return err
@@ -130,4 +130,36 @@ func testBlobFetcher(t *testing.T, when spec.G, it spec.S) {
 		err := fetcher.Fetch(dir, fmt.Sprintf("%s/%s", server.URL, "test.html"))
 		require.EqualError(t, err, "unexpected blob file type, must be one of .zip, .tar.gz, .tar, .jar")
 	})
+
+	when("#FetchWithChecksum", func() {
+		it("unpacks the blob when the sha256 checksum matches", func() {
+			err := fetcher.FetchWithChecksum(dir, fmt.Sprintf("%s/%s", server.URL, "test.tar.gz"), "903883bf76c5af28fba26dfd81bfaadeaaf980b9760ccc6a15a0424d723144e0")
+			require.NoError(t, err)
+
+			file, err := ioutil.ReadFile(filepath.Join(dir, "testdir", "testfile"))
+			require.NoError(t, err)
+			require.Equal(t, "test file contents", string(file))
+
+			require.Contains(t, output.String(), "Verified checksum")
+		})
+
+		it("supports prefixed sha1 checksums", func() {
+			err := fetcher.FetchWithChecksum(dir, fmt.Sprintf("%s/%s", server.URL, "test.tar.gz"), "sha1:08fcefacc470694647aba8ba44cbffb94edf225c")
+			require.NoError(t, err)
+		})
+
+		it("errors when the checksum does not match", func() {
+			err := fetcher.FetchWithChecksum(dir, fmt.Sprintf("%s/%s", server.URL, "test.tar.gz"), "sha256:0000000000000000000000000000000000000000000000000000000000000000")
+			require.EqualError(t, err, "checksum mismatch: expected 0000000000000000000000000000000000000000000000000000000000000000, got 903883bf76c5af28fba26dfd81bfaadeaaf980b9760ccc6a15a0424d723144e0")
+
+			files, err := ioutil.ReadDir(dir)
+			require.NoError(t, err)
+			require.Len(t, files, 0)
+		})
+
+		it("errors on an unsupported checksum", func() {
+			err := fetcher.FetchWithChecksum(dir, fmt.Sprintf("%s/%s", server.URL, "test.tar.gz"), "md5:abc")
+			require.EqualError(t, err, `unsupported checksum "md5:abc"`)
+		})
+	})
 }
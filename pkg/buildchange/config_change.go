@@ -20,10 +20,12 @@ type configChange struct {
 }
 
 type Config struct {
-	Env       []corev1.EnvVar             `json:"env,omitempty"`
-	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
-	Bindings  v1alpha1.Bindings           `json:"bindings,omitempty"`
-	Source    v1alpha1.SourceConfig       `json:"source,omitempty"`
+	Env           []corev1.EnvVar             `json:"env,omitempty"`
+	Resources     corev1.ResourceRequirements `json:"resources,omitempty"`
+	StepResources *v1alpha1.StepResources     `json:"stepResources,omitempty"`
+	Hooks         *v1alpha1.BuildHooks        `json:"hooks,omitempty"`
+	Bindings      v1alpha1.Bindings           `json:"bindings,omitempty"`
+	Source        v1alpha1.SourceConfig       `json:"source,omitempty"`
 }
 
 func (c configChange) Reason() v1alpha1.BuildReason { return v1alpha1.BuildReasonConfig }
@@ -42,6 +44,13 @@ func (c configChange) IsBuildRequired() (bool, error) {
 		c.new.Source.Git.Revision = ""
 	}
 
+	// Artifact url and checksum change along with the revision
+	// Consider them part of the COMMIT change as well
+	if c.old.Source.Artifact != nil && c.new.Source.Artifact != nil {
+		c.old.Source.Artifact = &v1alpha1.Artifact{}
+		c.new.Source.Artifact = &v1alpha1.Artifact{}
+	}
+
 	valid := !equality.Semantic.DeepEqual(c.old, c.new)
 
 	if c.old.Source.Git != nil {
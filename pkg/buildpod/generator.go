@@ -1,6 +1,7 @@
 package buildpod
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
@@ -8,6 +9,8 @@ import (
 	"github.com/google/go-containerregistry/pkg/authn"
 	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/label"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,6 +20,7 @@ import (
 	"github.com/pivotal/kpack/pkg/cnb"
 	"github.com/pivotal/kpack/pkg/registry"
 	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
+	"github.com/pivotal/kpack/pkg/tracing"
 )
 
 const (
@@ -45,7 +49,7 @@ type BuildPodable interface {
 	BuildPod(v1alpha1.BuildPodImages, []corev1.Secret, v1alpha1.BuildPodBuilderConfig) (*corev1.Pod, error)
 }
 
-func (g *Generator) Generate(build BuildPodable) (*v1.Pod, error) {
+func (g *Generator) Generate(ctx context.Context, build BuildPodable) (*v1.Pod, error) {
 	if err := g.buildAllowed(build); err != nil {
 		return nil, fmt.Errorf("build rejected: %w", err)
 	}
@@ -55,7 +59,9 @@ func (g *Generator) Generate(build BuildPodable) (*v1.Pod, error) {
 		return nil, err
 	}
 
+	_, span := tracing.StartSpan(ctx, "buildpod.fetchBuilderConfig", trace.WithAttributes(label.String("builder.image", build.BuilderSpec().Image)))
 	buildPodBuilderConfig, err := g.fetchBuilderConfig(build)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +141,11 @@ func (g *Generator) fetchBuilderConfig(build BuildPodable) (v1alpha1.BuildPodBui
 		return v1alpha1.BuildPodBuilderConfig{}, errors.Wrap(err, "unable to get builder metadata")
 	}
 
+	platformAPI, err := v1alpha1.NegotiatePlatformAPI(builderPlatformAPIs(metadata.Lifecycle))
+	if err != nil {
+		return v1alpha1.BuildPodBuilderConfig{}, err
+	}
+
 	uid, err := parseCNBID(image, cnbUserId)
 	if err != nil {
 		return v1alpha1.BuildPodBuilderConfig{}, err
@@ -146,14 +157,24 @@ func (g *Generator) fetchBuilderConfig(build BuildPodable) (v1alpha1.BuildPodBui
 	}
 
 	return v1alpha1.BuildPodBuilderConfig{
-		StackID:     stackId,
-		RunImage:    metadata.Stack.RunImage.Image,
-		PlatformAPI: metadata.Lifecycle.API.PlatformVersion,
-		Uid:         uid,
-		Gid:         gid,
+		StackID:          stackId,
+		RunImage:         metadata.Stack.RunImage.Image,
+		PlatformAPI:      platformAPI,
+		LifecycleVersion: metadata.Lifecycle.Version,
+		Uid:              uid,
+		Gid:              gid,
 	}, nil
 }
 
+// builderPlatformAPIs lists the platform APIs of the builder's lifecycle. Older lifecycles only declare a single version.
+func builderPlatformAPIs(lifecycle cnb.LifecycleMetadata) []string {
+	platformAPIs := append(append([]string{}, lifecycle.APIs.Platform.Supported...), lifecycle.APIs.Platform.Deprecated...)
+	if len(platformAPIs) == 0 && lifecycle.API.PlatformVersion != "" {
+		platformAPIs = append(platformAPIs, lifecycle.API.PlatformVersion)
+	}
+	return platformAPIs
+}
+
 func parseCNBID(image ggcrv1.Image, env string) (int64, error) {
 	v, err := imagehelpers.GetEnv(image, env)
 	if err != nil {
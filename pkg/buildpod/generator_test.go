@@ -1,6 +1,7 @@
 package buildpod_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -166,7 +167,7 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 				},
 			}
 
-			pod, err := generator.Generate(build)
+			pod, err := generator.Generate(context.TODO(), build)
 			require.NoError(t, err)
 			assert.NotNil(t, pod)
 
@@ -177,15 +178,93 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 					*dockerSecret,
 				},
 				BuildPodBuilderConfig: v1alpha1.BuildPodBuilderConfig{
-					StackID:     "some.stack.id",
-					RunImage:    "some-registry.io/run-image",
-					Uid:         1234,
-					Gid:         5678,
-					PlatformAPI: "0.5",
+					StackID:          "some.stack.id",
+					RunImage:         "some-registry.io/run-image",
+					Uid:              1234,
+					Gid:              5678,
+					PlatformAPI:      "0.5",
+					LifecycleVersion: "0.9.0",
 				},
 			}}, build.buildPodCalls)
 		})
 
+		when("negotiating the platform api", func() {
+			builderImage := "some/builder@sha256:7f4a3a1e05b84e5e5e3f2ba9b4e7b2b7a3d2d4e2b2a6a2b9a8f1c0d9e8f7a6b5"
+
+			generate := func(lifecycleMetadata string) ([]buildPodCall, error) {
+				secretRef := registry.SecretRef{
+					ServiceAccount:   serviceAccountName,
+					Namespace:        namespace,
+					ImagePullSecrets: builderPullSecrets,
+				}
+				keychain := &registryfakes.FakeKeychain{}
+				keychainFactory.AddKeychainForSecretRef(t, secretRef, keychain)
+
+				image, err := imagehelpers.SetStringLabel(randomImage(t), lifecycle.StackIDLabel, "some.stack.id")
+				require.NoError(t, err)
+
+				image, err = imagehelpers.SetStringLabel(image, cnb.BuilderMetadataLabel, fmt.Sprintf(`{"lifecycle": %s}`, lifecycleMetadata))
+				require.NoError(t, err)
+
+				image, err = imagehelpers.SetEnv(image, "CNB_USER_ID", "1234")
+				require.NoError(t, err)
+
+				image, err = imagehelpers.SetEnv(image, "CNB_GROUP_ID", "5678")
+				require.NoError(t, err)
+
+				imageFetcher.AddImage(builderImage, image, keychain)
+
+				generator := &buildpod.Generator{
+					K8sClient:       fakeK8sClient,
+					KeychainFactory: keychainFactory,
+					ImageFetcher:    imageFetcher,
+				}
+
+				build := &testBuildPodable{
+					serviceAccount: serviceAccountName,
+					namespace:      namespace,
+					buildBuilderSpec: v1alpha1.BuildBuilderSpec{
+						Image:            builderImage,
+						ImagePullSecrets: builderPullSecrets,
+					},
+				}
+
+				_, err = generator.Generate(context.TODO(), build)
+				return build.buildPodCalls, err
+			}
+
+			it("selects the highest platform api supported by kpack and the lifecycle", func() {
+				calls, err := generate(`{"version": "0.10.0", "apis": {"platform": {"deprecated": ["0.2"], "supported": ["0.3", "0.4", "0.7"]}}}`)
+				require.NoError(t, err)
+
+				require.Len(t, calls, 1)
+				assert.Equal(t, "0.4", calls[0].BuildPodBuilderConfig.PlatformAPI)
+			})
+
+			it("considers deprecated platform apis of the lifecycle", func() {
+				calls, err := generate(`{"version": "0.11.0", "apis": {"platform": {"deprecated": ["0.3"], "supported": ["0.6", "0.7"]}}}`)
+				require.NoError(t, err)
+
+				require.Len(t, calls, 1)
+				assert.Equal(t, "0.3", calls[0].BuildPodBuilderConfig.PlatformAPI)
+			})
+
+			it("uses the single platform api of older lifecycles", func() {
+				calls, err := generate(`{"version": "0.7.0", "api": {"buildpack": "0.2", "platform": "0.2"}}`)
+				require.NoError(t, err)
+
+				require.Len(t, calls, 1)
+				assert.Equal(t, "0.2", calls[0].BuildPodBuilderConfig.PlatformAPI)
+			})
+
+			it("returns an error when no platform api is supported by kpack", func() {
+				calls, err := generate(`{"version": "0.12.0", "apis": {"platform": {"supported": ["0.7", "0.8"]}}}`)
+				require.EqualError(t, err, "unsupported builder platform API versions: [0.7 0.8]")
+
+				require.Empty(t, calls)
+			})
+		})
+
 		it("rejects a build with a binding secret that is attached to a service account", func() {
 			buildPodConfig := v1alpha1.BuildPodImages{}
 			generator := &buildpod.Generator{
@@ -206,7 +285,7 @@ func testGenerator(t *testing.T, when spec.G, it spec.S) {
 				},
 			}
 
-			pod, err := generator.Generate(build)
+			pod, err := generator.Generate(context.TODO(), build)
 			require.EqualError(t, err, fmt.Sprintf("build rejected: binding %q uses forbidden secret %q", "naughty", dockerSecret.Name))
 			require.Nil(t, pod)
 		})
@@ -0,0 +1,265 @@
+package buildqueue
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	corev1lister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+)
+
+const (
+	// LowPriority builds are STACK and BUILDPACK rebuilds
+	LowPriority = iota
+	// HighPriority builds are COMMIT, CONFIG and TRIGGER builds
+	HighPriority
+)
+
+// Limits bounds the number of builds with a pod. A limit of zero is unlimited.
+type Limits struct {
+	Cluster   int `json:"cluster"`
+	Namespace int `json:"namespace"`
+}
+
+// Admission is the outcome of asking the queue for a build to create its pod
+type Admission struct {
+	Admitted bool
+	// Position is the 1-based position of a build waiting for the concurrency limits
+	Position int
+	// QuotaExceeded describes the BuildQuota a build is waiting for
+	QuotaExceeded string
+}
+
+// Queue admits builds to create their pod while the cluster-wide and per-namespace concurrency limits
+// and the BuildQuotas of their namespace allow it. Waiting builds are admitted by priority and then in the order they were created.
+type Queue struct {
+	BuildLister v1alpha1lister.BuildLister
+	PodLister   corev1lister.PodLister
+	// QuotaLister is optional, BuildQuotas are not enforced when it is not set
+	QuotaLister v1alpha1lister.BuildQuotaLister
+	Limits      Limits
+	// StepResources are the default resources of the build pod steps that count against the BuildQuotas of builds without a pod
+	StepResources v1alpha1.StepResources
+
+	// lock is held while admitting a build so a reservation is only dropped for a pod every later admission observes
+	lock sync.Mutex
+	// reserved are the builds admitted by this queue whose pod is not in the pod lister yet
+	reserved map[string]*v1alpha1.Build
+}
+
+// Admit reports whether the build may create its pod and otherwise why it has to wait
+func (q *Queue) Admit(build *v1alpha1.Build) (Admission, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	// builds only wait for the builds of other namespaces under a cluster-wide limit
+	namespace := build.Namespace
+	if q.Limits.Cluster > 0 {
+		namespace = metav1.NamespaceAll
+	}
+
+	pods, err := q.buildPods(namespace)
+	if err != nil {
+		return Admission{}, err
+	}
+	if _, ok := pods[buildKey(build)]; ok {
+		return Admission{Admitted: true}, nil
+	}
+
+	builds, err := q.BuildLister.Builds(namespace).List(labels.Everything())
+	if err != nil {
+		return Admission{}, err
+	}
+
+	quotas, err := q.quotas(namespace)
+	if err != nil {
+		return Admission{}, err
+	}
+
+	var (
+		running int
+		used    = map[string]*v1alpha1.BuildQuotaUsage{}
+		queued  []*v1alpha1.Build
+	)
+	usage := func(namespace string) *v1alpha1.BuildQuotaUsage {
+		if used[namespace] == nil {
+			used[namespace] = &v1alpha1.BuildQuotaUsage{}
+		}
+		return used[namespace]
+	}
+
+	for _, pod := range pods {
+		if active(pod) {
+			running++
+			usage(pod.Namespace).AddRequests(v1alpha1.PodRequests(pod))
+		}
+	}
+
+	for _, b := range builds {
+		if _, ok := pods[buildKey(b)]; !ok && !b.Finished() {
+			queued = append(queued, b)
+		}
+	}
+
+	sort.SliceStable(queued, func(i, j int) bool {
+		return before(queued[i], queued[j])
+	})
+
+	if q.reserved == nil {
+		q.reserved = map[string]*v1alpha1.Build{}
+	}
+
+	if _, ok := q.reserved[buildKey(build)]; ok {
+		return Admission{Admitted: true}, nil
+	}
+
+	for key, b := range q.reserved {
+		switch {
+		case pods[key] != nil:
+			delete(q.reserved, key)
+		case namespace == metav1.NamespaceAll || b.Namespace == namespace:
+			running++
+			usage(b.Namespace).AddRequests(b.PodRequests(q.StepResources))
+		}
+	}
+
+	position := 0
+	for _, b := range queued {
+		key := buildKey(b)
+		if _, ok := q.reserved[key]; ok {
+			continue
+		}
+		target := key == buildKey(build)
+		requests := b.PodRequests(q.StepResources)
+
+		if exceeded := quotaExceeded(quotas[b.Namespace], *usage(b.Namespace), requests); exceeded != "" {
+			if target {
+				return Admission{QuotaExceeded: exceeded}, nil
+			}
+			continue
+		}
+
+		if q.available(running, int(usage(b.Namespace).ConcurrentBuilds)) {
+			if target {
+				q.reserved[key] = b
+				return Admission{Admitted: true}, nil
+			}
+			running++
+			usage(b.Namespace).AddRequests(requests)
+			continue
+		}
+
+		position++
+		if target {
+			return Admission{Position: position}, nil
+		}
+	}
+
+	// the lister has not observed the build yet
+	return Admission{Position: position + 1}, nil
+}
+
+// Release frees the slot of an admitted build that will not get a pod because creating it failed or the build was deleted
+func (q *Queue) Release(build *v1alpha1.Build) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	delete(q.reserved, buildKey(build))
+}
+
+// buildPods are the pods of the builds in the namespace by the key of their build
+func (q *Queue) buildPods(namespace string) (map[string]*corev1.Pod, error) {
+	hasBuild, err := labels.NewRequirement(v1alpha1.BuildLabel, selection.Exists, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := q.PodLister.Pods(namespace).List(labels.NewSelector().Add(*hasBuild))
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make(map[string]*corev1.Pod, len(list))
+	for _, pod := range list {
+		pods[pod.Namespace+"/"+pod.Labels[v1alpha1.BuildLabel]] = pod
+	}
+	return pods, nil
+}
+
+// active pods have not finished running their build
+func active(pod *corev1.Pod) bool {
+	return pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
+
+// quotas are the BuildQuotas of each namespace
+func (q *Queue) quotas(namespace string) (map[string][]*v1alpha1.BuildQuota, error) {
+	quotas := map[string][]*v1alpha1.BuildQuota{}
+	if q.QuotaLister == nil {
+		return quotas, nil
+	}
+
+	list, err := q.QuotaLister.BuildQuotas(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	for _, quota := range list {
+		quotas[quota.Namespace] = append(quotas[quota.Namespace], quota)
+	}
+	return quotas, nil
+}
+
+func quotaExceeded(quotas []*v1alpha1.BuildQuota, used v1alpha1.BuildQuotaUsage, requests corev1.ResourceList) string {
+	for _, quota := range quotas {
+		if exceeded := quota.Exceeded(used, requests); exceeded != "" {
+			return exceeded
+		}
+	}
+	return ""
+}
+
+func (q *Queue) available(running, runningNamespace int) bool {
+	if q.Limits.Cluster > 0 && running >= q.Limits.Cluster {
+		return false
+	}
+	return q.Limits.Namespace <= 0 || runningNamespace < q.Limits.Namespace
+}
+
+// Priority of a build computed from its reasons. Only builds that rebuild unchanged source for new stacks or buildpacks
+// have a low priority, builds without a reason were created by a user.
+func Priority(build *v1alpha1.Build) int {
+	for _, reason := range strings.Split(build.BuildReason(), ",") {
+		switch reason {
+		case v1alpha1.BuildReasonStack, v1alpha1.BuildReasonBuildpack:
+		default:
+			return HighPriority
+		}
+	}
+	return LowPriority
+}
+
+func before(a, b *v1alpha1.Build) bool {
+	if pa, pb := Priority(a), Priority(b); pa != pb {
+		return pa > pb
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return buildKey(a) < buildKey(b)
+}
+
+func buildKey(build *v1alpha1.Build) string {
+	key, _ := cache.MetaNamespaceKeyFunc(build)
+	return key
+}
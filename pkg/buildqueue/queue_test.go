@@ -0,0 +1,419 @@
+package buildqueue
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1lister "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+)
+
+func TestQueue(t *testing.T) {
+	spec.Run(t, "Queue", testQueue)
+}
+
+func testQueue(t *testing.T, when spec.G, it spec.S) {
+	created := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	build := func(namespace, name, reason string, age time.Duration) *v1alpha1.Build {
+		return &v1alpha1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+				Annotations: map[string]string{
+					v1alpha1.BuildReasonAnnotation: reason,
+				},
+			},
+		}
+	}
+
+	running := func(b *v1alpha1.Build) *v1alpha1.Build {
+		b.Status.PodName = b.Name + "-build-pod"
+		return b
+	}
+
+	finished := func(b *v1alpha1.Build) *v1alpha1.Build {
+		b.Status.PodName = b.Name + "-build-pod"
+		b.Status.Conditions = corev1alpha1.Conditions{{Type: corev1alpha1.ConditionSucceeded, Status: "True"}}
+		return b
+	}
+
+	when("Priority", func() {
+		it("prioritizes commit, trigger and config builds over stack and buildpack rebuilds", func() {
+			assert.Equal(t, HighPriority, Priority(build("ns", "b", "COMMIT", 0)))
+			assert.Equal(t, HighPriority, Priority(build("ns", "b", "TRIGGER", 0)))
+			assert.Equal(t, HighPriority, Priority(build("ns", "b", "CONFIG", 0)))
+			assert.Equal(t, HighPriority, Priority(build("ns", "b", "STACK,COMMIT", 0)))
+			assert.Equal(t, HighPriority, Priority(build("ns", "b", "", 0)))
+			assert.Equal(t, LowPriority, Priority(build("ns", "b", "STACK", 0)))
+			assert.Equal(t, LowPriority, Priority(build("ns", "b", "BUILDPACK,STACK", 0)))
+		})
+	})
+
+	when("Admit", func() {
+		it("admits builds while the cluster limit is not reached", func() {
+			first := build("ns", "first", "STACK", 2*time.Minute)
+			second := build("ns", "second", "STACK", time.Minute)
+			queue := &Queue{
+				BuildLister: buildLister(t, running(build("ns", "running", "COMMIT", time.Hour)), finished(build("ns", "finished", "COMMIT", time.Hour)), first, second),
+				PodLister:   podLister(t, running(build("ns", "running", "COMMIT", time.Hour)), finished(build("ns", "finished", "COMMIT", time.Hour)), first, second),
+				Limits:      Limits{Cluster: 2},
+			}
+
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, first))
+			assert.Equal(t, Admission{Position: 1}, admit(t, queue, second))
+		})
+
+		it("admits builds that already have a pod", func() {
+			runningBuild := running(build("ns", "running", "STACK", time.Hour))
+			queue := &Queue{
+				BuildLister: buildLister(t, runningBuild, running(build("ns", "other", "COMMIT", time.Hour))),
+				PodLister:   podLister(t, runningBuild, running(build("ns", "other", "COMMIT", time.Hour))),
+				Limits:      Limits{Cluster: 1},
+			}
+
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, runningBuild))
+		})
+
+		it("admits commit and trigger builds before older stack and buildpack rebuilds", func() {
+			stack := build("ns", "stack", "STACK", time.Hour)
+			buildpack := build("ns", "buildpack", "BUILDPACK", time.Hour)
+			commit := build("ns", "commit", "COMMIT", time.Minute)
+			trigger := build("ns", "trigger", "TRIGGER", time.Second)
+			queue := &Queue{
+				BuildLister: buildLister(t, running(build("ns", "running", "COMMIT", time.Hour)), stack, buildpack, commit, trigger),
+				PodLister:   podLister(t, running(build("ns", "running", "COMMIT", time.Hour)), stack, buildpack, commit, trigger),
+				Limits:      Limits{Cluster: 1},
+			}
+
+			for b, expected := range map[*v1alpha1.Build]int{commit: 1, trigger: 2, buildpack: 3, stack: 4} {
+				assert.Equal(t, Admission{Position: expected}, admit(t, queue, b), b.Name)
+			}
+		})
+
+		it("does not let a namespace at its limit hold up builds of other namespaces", func() {
+			blocked := build("busy", "blocked", "COMMIT", time.Hour)
+			other := build("other", "other", "STACK", time.Minute)
+			queue := &Queue{
+				BuildLister: buildLister(t, running(build("busy", "running", "COMMIT", time.Hour)), blocked, other),
+				PodLister:   podLister(t, running(build("busy", "running", "COMMIT", time.Hour)), blocked, other),
+				Limits:      Limits{Cluster: 5, Namespace: 1},
+			}
+
+			assert.Equal(t, Admission{Position: 1}, admit(t, queue, blocked))
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, other))
+		})
+
+		it("counts admitted builds before the lister observes their pod", func() {
+			first := build("ns", "first", "COMMIT", 2*time.Minute)
+			second := build("ns", "second", "COMMIT", time.Minute)
+			queue := &Queue{
+				BuildLister: buildLister(t, first, second),
+				PodLister:   podLister(t, first, second),
+				Limits:      Limits{Cluster: 1},
+			}
+
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, first))
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, first))
+			assert.Equal(t, Admission{Position: 1}, admit(t, queue, second))
+		})
+
+		it("keeps counting an admitted build for an admission that has not observed its pod yet", func() {
+			first := build("ns", "first", "COMMIT", time.Minute)
+			second := build("ns", "second", "COMMIT", 3*time.Minute)
+			third := build("ns", "third", "COMMIT", 2*time.Minute)
+			queue := &Queue{
+				BuildLister: buildLister(t, first),
+				PodLister:   podLister(t),
+				Limits:      Limits{Cluster: 1},
+			}
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, first))
+
+			queue.BuildLister = buildLister(t, first, second, third)
+
+			// the third build observes the pod of the first build while the second is admitted from an older snapshot
+			var thirdAdmission Admission
+			thirdAdmitted := make(chan struct{})
+			queue.PodLister = &stalePodLister{
+				stale: podLister(t),
+				fresh: podLister(t, running(first.DeepCopy())),
+				during: func() {
+					go func() {
+						thirdAdmission = admit(t, queue, third)
+						close(thirdAdmitted)
+					}()
+					select {
+					case <-thirdAdmitted:
+					case <-time.After(100 * time.Millisecond):
+					}
+				},
+			}
+
+			assert.Equal(t, Admission{Position: 1}, admit(t, queue, second))
+			<-thirdAdmitted
+			assert.Equal(t, Admission{Position: 2}, thirdAdmission)
+		})
+
+		it("frees the slot of an admitted build once its pod finished", func() {
+			first := build("ns", "first", "COMMIT", 2*time.Minute)
+			second := build("ns", "second", "COMMIT", time.Minute)
+			queue := &Queue{
+				BuildLister: buildLister(t, first, second),
+				PodLister:   podLister(t, first, second),
+				Limits:      Limits{Cluster: 1},
+			}
+
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, first))
+
+			queue.BuildLister = buildLister(t, finished(first.DeepCopy()), second)
+			queue.PodLister = podLister(t, finished(first.DeepCopy()), second)
+
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, second))
+		})
+
+		it("counts the pods of builds that are not finished yet", func() {
+			first := build("ns", "first", "COMMIT", 2*time.Minute)
+			second := build("ns", "second", "COMMIT", time.Minute)
+			queue := &Queue{
+				BuildLister: buildLister(t, first, second),
+				PodLister:   podLister(t, running(first.DeepCopy())),
+				Limits:      Limits{Cluster: 1},
+			}
+
+			assert.Equal(t, Admission{Position: 1}, admit(t, queue, second))
+		})
+
+		it("frees the slot of an admitted build whose pod could not be created", func() {
+			first := build("ns", "first", "COMMIT", 2*time.Minute)
+			second := build("ns", "second", "COMMIT", time.Minute)
+			queue := &Queue{
+				BuildLister: buildLister(t, first, second),
+				PodLister:   podLister(t, first, second),
+				Limits:      Limits{Cluster: 1},
+			}
+
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, first))
+			assert.Equal(t, Admission{Position: 1}, admit(t, queue, second))
+
+			queue.Release(first)
+
+			// the first build is queued again ahead of the second
+			assert.Equal(t, Admission{Position: 1}, admit(t, queue, second))
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, first))
+		})
+
+		it("frees the slot of an admitted build that was deleted before it had a pod", func() {
+			deleted := build("ns", "deleted", "COMMIT", 2*time.Minute)
+			second := build("ns", "second", "COMMIT", time.Minute)
+			queue := &Queue{
+				BuildLister: buildLister(t, deleted, second),
+				PodLister:   podLister(t, deleted, second),
+				Limits:      Limits{Cluster: 1},
+			}
+
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, deleted))
+
+			queue.BuildLister = buildLister(t, second)
+			assert.Equal(t, Admission{Position: 1}, admit(t, queue, second))
+
+			queue.Release(deleted)
+
+			assert.Equal(t, Admission{Admitted: true}, admit(t, queue, second))
+		})
+
+		when("BuildQuotas", func() {
+			requesting := func(b *v1alpha1.Build, cpu, memory string) *v1alpha1.Build {
+				b.Spec.Resources = corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpu),
+						corev1.ResourceMemory: resource.MustParse(memory),
+					},
+				}
+				return b
+			}
+
+			quota := func(spec v1alpha1.BuildQuotaSpec) *v1alpha1.BuildQuota {
+				return &v1alpha1.BuildQuota{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "team-quota",
+						Namespace: "team",
+					},
+					Spec: spec,
+				}
+			}
+
+			concurrentBuilds := int64(1)
+			cpu := resource.MustParse("4")
+
+			it("holds builds over the concurrent builds of the quota", func() {
+				waiting := build("team", "waiting", "COMMIT", time.Minute)
+				queue := &Queue{
+					BuildLister: buildLister(t, running(build("team", "running", "COMMIT", time.Hour)), waiting),
+					PodLister:   podLister(t, running(build("team", "running", "COMMIT", time.Hour)), waiting),
+					QuotaLister: quotaLister(t, quota(v1alpha1.BuildQuotaSpec{ConcurrentBuilds: &concurrentBuilds})),
+				}
+
+				assert.Equal(t, Admission{
+					QuotaExceeded: "exceeded quota: team-quota, requested: concurrentBuilds=1, used: concurrentBuilds=1, limited: concurrentBuilds=1",
+				}, admit(t, queue, waiting))
+			})
+
+			it("holds builds over the cpu of the quota and admits smaller builds", func() {
+				large := requesting(build("team", "large", "COMMIT", time.Hour), "2", "1G")
+				small := requesting(build("team", "small", "STACK", time.Minute), "1", "1G")
+				queue := &Queue{
+					BuildLister: buildLister(t, running(requesting(build("team", "running", "COMMIT", time.Hour), "3", "1G")), large, small),
+					PodLister:   podLister(t, running(requesting(build("team", "running", "COMMIT", time.Hour), "3", "1G")), large, small),
+					QuotaLister: quotaLister(t, quota(v1alpha1.BuildQuotaSpec{CPU: &cpu})),
+				}
+
+				assert.Equal(t, Admission{
+					QuotaExceeded: "exceeded quota: team-quota, requested: cpu=2, used: cpu=3, limited: cpu=4",
+				}, admit(t, queue, large))
+				assert.Equal(t, Admission{Admitted: true}, admit(t, queue, small))
+			})
+
+			it("holds builds without a request for a resource limited by the quota", func() {
+				unbounded := build("team", "unbounded", "COMMIT", time.Minute)
+				queue := &Queue{
+					BuildLister: buildLister(t, unbounded),
+					PodLister:   podLister(t, unbounded),
+					QuotaLister: quotaLister(t, quota(v1alpha1.BuildQuotaSpec{CPU: &cpu})),
+				}
+
+				assert.Equal(t, Admission{
+					QuotaExceeded: "build pod must request cpu limited by quota: team-quota",
+				}, admit(t, queue, unbounded))
+			})
+
+			it("counts the default step resources of builds that do not set resources", func() {
+				defaulted := build("team", "defaulted", "COMMIT", time.Minute)
+				queue := &Queue{
+					BuildLister: buildLister(t, running(requesting(build("team", "running", "COMMIT", time.Hour), "3", "1G")), defaulted),
+					PodLister:   podLister(t, running(requesting(build("team", "running", "COMMIT", time.Hour), "3", "1G")), defaulted),
+					QuotaLister: quotaLister(t, quota(v1alpha1.BuildQuotaSpec{CPU: &cpu})),
+					StepResources: v1alpha1.StepResources{
+						Build: &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU: resource.MustParse("2"),
+							},
+						},
+					},
+				}
+
+				assert.Equal(t, Admission{
+					QuotaExceeded: "exceeded quota: team-quota, requested: cpu=2, used: cpu=3, limited: cpu=4",
+				}, admit(t, queue, defaulted))
+
+				queue.StepResources.Build.Requests[corev1.ResourceCPU] = resource.MustParse("1")
+
+				assert.Equal(t, Admission{Admitted: true}, admit(t, queue, defaulted))
+			})
+
+			it("does not apply quotas to other namespaces", func() {
+				other := build("other", "other", "COMMIT", time.Minute)
+				queue := &Queue{
+					BuildLister: buildLister(t, running(build("other", "running", "COMMIT", time.Hour)), other),
+					PodLister:   podLister(t, running(build("other", "running", "COMMIT", time.Hour)), other),
+					QuotaLister: quotaLister(t, quota(v1alpha1.BuildQuotaSpec{ConcurrentBuilds: &concurrentBuilds})),
+				}
+
+				assert.Equal(t, Admission{Admitted: true}, admit(t, queue, other))
+			})
+		})
+	})
+}
+
+func admit(t *testing.T, queue *Queue, build *v1alpha1.Build) Admission {
+	admission, err := queue.Admit(build)
+	require.NoError(t, err)
+	return admission
+}
+
+func buildLister(t *testing.T, builds ...*v1alpha1.Build) v1alpha1lister.BuildLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, build := range builds {
+		require.NoError(t, indexer.Add(build))
+	}
+	return v1alpha1lister.NewBuildLister(indexer)
+}
+
+// podLister has a pod for every build with a pod name, the pods of finished builds have succeeded
+func podLister(t *testing.T, builds ...*v1alpha1.Build) corev1lister.PodLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, build := range builds {
+		if build.Status.PodName == "" {
+			continue
+		}
+
+		phase := corev1.PodRunning
+		if build.Finished() {
+			phase = corev1.PodSucceeded
+		}
+		require.NoError(t, indexer.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      build.Status.PodName,
+				Namespace: build.Namespace,
+				Labels: map[string]string{
+					v1alpha1.BuildLabel: build.Name,
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:      "completion",
+						Resources: build.Spec.Resources,
+					},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}))
+	}
+	return corev1lister.NewPodLister(indexer)
+}
+
+// stalePodLister lists the stale pods once, running during while it does, and the fresh pods afterwards
+type stalePodLister struct {
+	corev1lister.PodLister
+	stale  corev1lister.PodLister
+	fresh  corev1lister.PodLister
+	during func()
+
+	lock   sync.Mutex
+	listed bool
+}
+
+func (l *stalePodLister) Pods(namespace string) corev1lister.PodNamespaceLister {
+	l.lock.Lock()
+	first := !l.listed
+	l.listed = true
+	l.lock.Unlock()
+
+	if first {
+		l.during()
+		return l.stale.Pods(namespace)
+	}
+	return l.fresh.Pods(namespace)
+}
+
+func quotaLister(t *testing.T, quotas ...*v1alpha1.BuildQuota) v1alpha1lister.BuildQuotaLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, quota := range quotas {
+		require.NoError(t, indexer.Add(quota))
+	}
+	return v1alpha1lister.NewBuildQuotaLister(indexer)
+}
@@ -0,0 +1,47 @@
+package buildquota
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+)
+
+// CacheStorageQuota checks the cacheSize of images against the cacheStorage of the BuildQuotas of their namespace
+type CacheStorageQuota struct {
+	QuotaLister v1alpha1lister.BuildQuotaLister
+	ImageLister v1alpha1lister.ImageLister
+}
+
+var _ v1alpha1.CacheStorageQuota = &CacheStorageQuota{}
+
+func (c *CacheStorageQuota) ExceededCacheStorage(image *v1alpha1.Image) (string, error) {
+	if image.Spec.CacheSize == nil {
+		return "", nil
+	}
+
+	quotas, err := c.QuotaLister.BuildQuotas(image.Namespace).List(labels.Everything())
+	if err != nil || len(quotas) == 0 {
+		return "", err
+	}
+
+	images, err := c.ImageLister.Images(image.Namespace).List(labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	// the cache of the image being admitted replaces its current cache
+	used := v1alpha1.BuildQuotaUsage{}
+	for _, other := range images {
+		if other.Name != image.Name {
+			used.AddImage(other)
+		}
+	}
+
+	for _, quota := range quotas {
+		if exceeded := quota.ExceededCacheStorage(used, *image.Spec.CacheSize); exceeded != "" {
+			return exceeded, nil
+		}
+	}
+	return "", nil
+}
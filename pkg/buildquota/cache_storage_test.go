@@ -0,0 +1,85 @@
+package buildquota
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+)
+
+func TestCacheStorageQuota(t *testing.T) {
+	spec.Run(t, "CacheStorageQuota", testCacheStorageQuota)
+}
+
+func testCacheStorageQuota(t *testing.T, when spec.G, it spec.S) {
+	image := func(namespace, name, cacheSize string) *v1alpha1.Image {
+		img := &v1alpha1.Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+		if cacheSize != "" {
+			size := resource.MustParse(cacheSize)
+			img.Spec.CacheSize = &size
+		}
+		return img
+	}
+
+	cacheStorage := resource.MustParse("5G")
+	quota := &v1alpha1.BuildQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-quota",
+			Namespace: "team",
+		},
+		Spec: v1alpha1.BuildQuotaSpec{
+			CacheStorage: &cacheStorage,
+		},
+	}
+
+	cacheStorageQuota := func(images ...*v1alpha1.Image) *CacheStorageQuota {
+		quotaIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		require.NoError(t, quotaIndexer.Add(quota))
+
+		imageIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		for _, img := range images {
+			require.NoError(t, imageIndexer.Add(img))
+		}
+
+		return &CacheStorageQuota{
+			QuotaLister: v1alpha1lister.NewBuildQuotaLister(quotaIndexer),
+			ImageLister: v1alpha1lister.NewImageLister(imageIndexer),
+		}
+	}
+
+	it("accepts caches that fit the quota", func() {
+		exceeded, err := cacheStorageQuota(image("team", "other", "2G")).ExceededCacheStorage(image("team", "new", "3G"))
+		require.NoError(t, err)
+		assert.Empty(t, exceeded)
+	})
+
+	it("rejects caches that exceed the quota with the caches of the other images", func() {
+		exceeded, err := cacheStorageQuota(image("team", "other", "3G")).ExceededCacheStorage(image("team", "new", "3G"))
+		require.NoError(t, err)
+		assert.Equal(t, "exceeded quota: team-quota, requested: cacheStorage=3G, used: cacheStorage=3G, limited: cacheStorage=5G", exceeded)
+	})
+
+	it("does not count the current cache of an image that is updated", func() {
+		exceeded, err := cacheStorageQuota(image("team", "existing", "3G")).ExceededCacheStorage(image("team", "existing", "5G"))
+		require.NoError(t, err)
+		assert.Empty(t, exceeded)
+	})
+
+	it("does not apply quotas of other namespaces", func() {
+		exceeded, err := cacheStorageQuota().ExceededCacheStorage(image("other", "new", "10G"))
+		require.NoError(t, err)
+		assert.Empty(t, exceeded)
+	})
+}
@@ -27,11 +27,13 @@ import (
 type KpackV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	BuildsGetter
+	BuildQuotasGetter
 	BuildersGetter
 	ClusterBuildersGetter
 	ClusterStacksGetter
 	ClusterStoresGetter
 	ImagesGetter
+	RolloutPoliciesGetter
 	SourceResolversGetter
 }
 
@@ -44,6 +46,10 @@ func (c *KpackV1alpha1Client) Builds(namespace string) BuildInterface {
 	return newBuilds(c, namespace)
 }
 
+func (c *KpackV1alpha1Client) BuildQuotas(namespace string) BuildQuotaInterface {
+	return newBuildQuotas(c, namespace)
+}
+
 func (c *KpackV1alpha1Client) Builders(namespace string) BuilderInterface {
 	return newBuilders(c, namespace)
 }
@@ -64,6 +70,10 @@ func (c *KpackV1alpha1Client) Images(namespace string) ImageInterface {
 	return newImages(c, namespace)
 }
 
+func (c *KpackV1alpha1Client) RolloutPolicies(namespace string) RolloutPolicyInterface {
+	return newRolloutPolicies(c, namespace)
+}
+
 func (c *KpackV1alpha1Client) SourceResolvers(namespace string) SourceResolverInterface {
 	return newSourceResolvers(c, namespace)
 }
@@ -32,6 +32,10 @@ func (c *FakeKpackV1alpha1) Builds(namespace string) v1alpha1.BuildInterface {
 	return &FakeBuilds{c, namespace}
 }
 
+func (c *FakeKpackV1alpha1) BuildQuotas(namespace string) v1alpha1.BuildQuotaInterface {
+	return &FakeBuildQuotas{c, namespace}
+}
+
 func (c *FakeKpackV1alpha1) Builders(namespace string) v1alpha1.BuilderInterface {
 	return &FakeBuilders{c, namespace}
 }
@@ -52,6 +56,10 @@ func (c *FakeKpackV1alpha1) Images(namespace string) v1alpha1.ImageInterface {
 	return &FakeImages{c, namespace}
 }
 
+func (c *FakeKpackV1alpha1) RolloutPolicies(namespace string) v1alpha1.RolloutPolicyInterface {
+	return &FakeRolloutPolicies{c, namespace}
+}
+
 func (c *FakeKpackV1alpha1) SourceResolvers(namespace string) v1alpha1.SourceResolverInterface {
 	return &FakeSourceResolvers{c, namespace}
 }
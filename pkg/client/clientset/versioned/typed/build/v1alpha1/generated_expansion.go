@@ -20,6 +20,8 @@ package v1alpha1
 
 type BuildExpansion interface{}
 
+type BuildQuotaExpansion interface{}
+
 type BuilderExpansion interface{}
 
 type ClusterBuilderExpansion interface{}
@@ -30,4 +32,6 @@ type ClusterStoreExpansion interface{}
 
 type ImageExpansion interface{}
 
+type RolloutPolicyExpansion interface{}
+
 type SourceResolverExpansion interface{}
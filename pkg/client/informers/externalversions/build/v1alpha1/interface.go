@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// Builds returns a BuildInformer.
 	Builds() BuildInformer
+	// BuildQuotas returns a BuildQuotaInformer.
+	BuildQuotas() BuildQuotaInformer
 	// Builders returns a BuilderInformer.
 	Builders() BuilderInformer
 	// ClusterBuilders returns a ClusterBuilderInformer.
@@ -36,6 +38,8 @@ type Interface interface {
 	ClusterStores() ClusterStoreInformer
 	// Images returns a ImageInformer.
 	Images() ImageInformer
+	// RolloutPolicies returns a RolloutPolicyInformer.
+	RolloutPolicies() RolloutPolicyInformer
 	// SourceResolvers returns a SourceResolverInformer.
 	SourceResolvers() SourceResolverInformer
 }
@@ -56,6 +60,11 @@ func (v *version) Builds() BuildInformer {
 	return &buildInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// BuildQuotas returns a BuildQuotaInformer.
+func (v *version) BuildQuotas() BuildQuotaInformer {
+	return &buildQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Builders returns a BuilderInformer.
 func (v *version) Builders() BuilderInformer {
 	return &builderInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -81,6 +90,11 @@ func (v *version) Images() ImageInformer {
 	return &imageInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RolloutPolicies returns a RolloutPolicyInformer.
+func (v *version) RolloutPolicies() RolloutPolicyInformer {
+	return &rolloutPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // SourceResolvers returns a SourceResolverInformer.
 func (v *version) SourceResolvers() SourceResolverInformer {
 	return &sourceResolverInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
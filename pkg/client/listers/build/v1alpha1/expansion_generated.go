@@ -26,6 +26,14 @@ type BuildListerExpansion interface{}
 // BuildNamespaceLister.
 type BuildNamespaceListerExpansion interface{}
 
+// BuildQuotaListerExpansion allows custom methods to be added to
+// BuildQuotaLister.
+type BuildQuotaListerExpansion interface{}
+
+// BuildQuotaNamespaceListerExpansion allows custom methods to be added to
+// BuildQuotaNamespaceLister.
+type BuildQuotaNamespaceListerExpansion interface{}
+
 // BuilderListerExpansion allows custom methods to be added to
 // BuilderLister.
 type BuilderListerExpansion interface{}
@@ -54,6 +62,14 @@ type ImageListerExpansion interface{}
 // ImageNamespaceLister.
 type ImageNamespaceListerExpansion interface{}
 
+// RolloutPolicyListerExpansion allows custom methods to be added to
+// RolloutPolicyLister.
+type RolloutPolicyListerExpansion interface{}
+
+// RolloutPolicyNamespaceListerExpansion allows custom methods to be added to
+// RolloutPolicyNamespaceLister.
+type RolloutPolicyNamespaceListerExpansion interface{}
+
 // SourceResolverListerExpansion allows custom methods to be added to
 // SourceResolverLister.
 type SourceResolverListerExpansion interface{}
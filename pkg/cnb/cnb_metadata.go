@@ -56,6 +56,7 @@ type BuiltImage struct {
 	Identifier        string
 	CompletedAt       time.Time
 	BuildpackMetadata []lifecycle.Buildpack
+	BOM               []lifecycle.BOMEntry
 	Stack             BuiltImageStack
 }
 
@@ -96,6 +97,7 @@ func readBuiltImage(appImage ggcrv1.Image, appImageId string) (BuiltImage, error
 		Identifier:        appImageId,
 		CompletedAt:       imageCreatedAt,
 		BuildpackMetadata: buildMetadata.Buildpacks,
+		BOM:               buildMetadata.BOM,
 		Stack: BuiltImageStack{
 			RunImage: baseImageRef.Context().String() + "@" + runImageRef.Identifier(),
 			ID:       stackId,
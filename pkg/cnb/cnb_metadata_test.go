@@ -51,7 +51,7 @@ func testMetadataRetriever(t *testing.T, when spec.G, it spec.S) {
 					keychainFactory.AddKeychainForSecretRef(t, appImageSecretRef, appImageKeychain)
 
 					appImage := randomImage(t)
-					appImage, _ = imagehelpers.SetStringLabel(appImage, "io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}]}`)
+					appImage, _ = imagehelpers.SetStringLabel(appImage, "io.buildpacks.build.metadata", `{"buildpacks": [{"id": "test.id", "version": "1.2.3"}], "bom": [{"name": "node", "version": "12.18.0", "metadata": {"arch": "x86_64"}, "buildpack": {"id": "test.id", "version": "1.2.3"}}]}`)
 					appImage, _ = imagehelpers.SetStringLabel(appImage, "io.buildpacks.lifecycle.metadata", `{
   "app": {
     "sha": "sha256:119f3f610dade1fdf5b4b2473aea0c6b1317497cf20691ab6d184a9b2fa5c409"
@@ -82,6 +82,12 @@ func testMetadataRetriever(t *testing.T, when spec.G, it spec.S) {
 					assert.Equal(t, "test.id", metadata[0].ID)
 					assert.Equal(t, "1.2.3", metadata[0].Version)
 
+					require.Len(t, result.BOM, 1)
+					assert.Equal(t, "node", result.BOM[0].Name)
+					assert.Equal(t, "12.18.0", result.BOM[0].Version)
+					assert.Equal(t, map[string]interface{}{"arch": "x86_64"}, result.BOM[0].Metadata)
+					assert.Equal(t, "test.id", result.BOM[0].Buildpack.ID)
+
 					createdAtTime, err := imagehelpers.GetCreatedAt(appImage)
 					assert.NoError(t, err)
 
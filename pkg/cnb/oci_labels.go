@@ -0,0 +1,72 @@
+package cnb
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+)
+
+const (
+	RevisionLabel = "org.opencontainers.image.revision"
+	SourceLabel   = "org.opencontainers.image.source"
+	CreatedLabel  = "org.opencontainers.image.created"
+)
+
+// WriteLabels records the labels AddLabels adds to the build metadata once the buildpacks have run
+func WriteLabels(labelsPath string, labels map[string]string) error {
+	file, err := os.Create(labelsPath)
+	if err != nil {
+		return errors.Wrap(err, "unable to write labels")
+	}
+	defer file.Close()
+
+	return toml.NewEncoder(file).Encode(labels)
+}
+
+// AddLabels adds the labels written by WriteLabels to the build metadata the exporter writes on the image as labels.
+// They replace labels with the same key provided by a buildpack.
+func AddLabels(layersDir, labelsPath string) error {
+	var labels map[string]string
+	if _, err := toml.DecodeFile(labelsPath, &labels); err != nil {
+		return errors.Wrap(err, "unable to read labels")
+	}
+
+	// decoded generically to keep the metadata written by newer lifecycles intact
+	metadataPath := filepath.Join(layersDir, "config", "metadata.toml")
+	var metadata map[string]interface{}
+	if _, err := toml.DecodeFile(metadataPath, &metadata); err != nil {
+		return errors.Wrap(err, "unable to read build metadata")
+	}
+
+	existing, _ := metadata["labels"].([]map[string]interface{})
+	merged := make([]map[string]interface{}, 0, len(existing)+len(labels))
+	for _, label := range existing {
+		if key, ok := label["key"].(string); ok {
+			if _, replaced := labels[key]; replaced {
+				continue
+			}
+		}
+		merged = append(merged, label)
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		merged = append(merged, map[string]interface{}{"key": key, "value": labels[key]})
+	}
+	metadata["labels"] = merged
+
+	file, err := os.Create(metadataPath)
+	if err != nil {
+		return errors.Wrap(err, "unable to write build metadata")
+	}
+	defer file.Close()
+
+	return toml.NewEncoder(file).Encode(metadata)
+}
@@ -0,0 +1,110 @@
+package cnb_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/buildpacks/lifecycle"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/cnb"
+)
+
+func TestOCILabels(t *testing.T) {
+	spec.Run(t, "OCILabels", testOCILabels)
+}
+
+func testOCILabels(t *testing.T, when spec.G, it spec.S) {
+	var (
+		layersDir  string
+		labelsPath string
+	)
+
+	it.Before(func() {
+		var err error
+		layersDir, err = ioutil.TempDir("", "layers")
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Join(layersDir, "config"), os.ModePerm))
+
+		labelsPath = filepath.Join(layersDir, "oci-labels.toml")
+	})
+
+	it.After(func() {
+		os.RemoveAll(layersDir)
+	})
+
+	when("#AddLabels", func() {
+		it("adds the written labels to the build metadata", func() {
+			writeMetadata(t, layersDir, `
+[[processes]]
+  type = "web"
+  command = "start"
+  direct = false
+  buildpack-id = "some/bp"
+
+[[labels]]
+  key = "some.buildpack.label"
+  value = "some-value"
+`)
+
+			require.NoError(t, cnb.WriteLabels(labelsPath, map[string]string{
+				cnb.SourceLabel:   "https://github.com/some/repo",
+				cnb.RevisionLabel: "some-sha",
+				cnb.CreatedLabel:  "2021-01-02T03:04:05Z",
+			}))
+
+			require.NoError(t, cnb.AddLabels(layersDir, labelsPath))
+
+			metadata := readMetadata(t, layersDir)
+			require.Equal(t, []lifecycle.Label{
+				{Key: "some.buildpack.label", Value: "some-value"},
+				{Key: cnb.CreatedLabel, Value: "2021-01-02T03:04:05Z"},
+				{Key: cnb.RevisionLabel, Value: "some-sha"},
+				{Key: cnb.SourceLabel, Value: "https://github.com/some/repo"},
+			}, metadata.Labels)
+			require.Len(t, metadata.Processes, 1)
+			require.Equal(t, "start", metadata.Processes[0].Command)
+		})
+
+		it("replaces buildpack labels with the same key", func() {
+			writeMetadata(t, layersDir, `
+[[labels]]
+  key = "org.opencontainers.image.source"
+  value = "from-buildpack"
+`)
+
+			require.NoError(t, cnb.WriteLabels(labelsPath, map[string]string{
+				cnb.SourceLabel: "https://github.com/some/repo",
+			}))
+
+			require.NoError(t, cnb.AddLabels(layersDir, labelsPath))
+
+			require.Equal(t, []lifecycle.Label{
+				{Key: cnb.SourceLabel, Value: "https://github.com/some/repo"},
+			}, readMetadata(t, layersDir).Labels)
+		})
+
+		it("errors when the buildpacks have not run", func() {
+			require.NoError(t, cnb.WriteLabels(labelsPath, map[string]string{
+				cnb.SourceLabel: "https://github.com/some/repo",
+			}))
+
+			require.Error(t, cnb.AddLabels(layersDir, labelsPath))
+		})
+	})
+}
+
+func writeMetadata(t *testing.T, layersDir, metadata string) {
+	require.NoError(t, ioutil.WriteFile(filepath.Join(layersDir, "config", "metadata.toml"), []byte(metadata), os.ModePerm))
+}
+
+func readMetadata(t *testing.T, layersDir string) lifecycle.BuildMetadata {
+	var metadata lifecycle.BuildMetadata
+	_, err := toml.DecodeFile(filepath.Join(layersDir, "config", "metadata.toml"), &metadata)
+	require.NoError(t, err)
+	return metadata
+}
@@ -0,0 +1,82 @@
+package cosign
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
+)
+
+const (
+	DSSEMediaType           types.MediaType = "application/vnd.dsse.envelope.v1+json"
+	InTotoPayloadType                       = "application/vnd.in-toto+json"
+	PredicateTypeAnnotation                 = "predicateType"
+
+	attestationTagSuffix = ".att"
+)
+
+// Envelope is a DSSE envelope holding a signed payload
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     string      `json:"payload"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+type Signature struct {
+	KeyID string `json:"keyid"`
+	Sig   string `json:"sig"`
+}
+
+// Attest signs the in-toto statement about a digest and pushes it to the sha256-<digest>.att tag of the repository
+func (s *ImageSigner) Attest(key *ecdsa.PrivateKey, repository name.Repository, digest string, statement []byte, predicateType string, keychain authn.Keychain) error {
+	envelope, err := SignEnvelope(key, InTotoPayloadType, statement)
+	if err != nil {
+		return err
+	}
+
+	attestationTag := AttestationTag(repository, digest)
+	s.Logger.Printf("Pushing %s attestation for '%s@%s' to '%s'\n", predicateType, repository.Name(), digest, attestationTag.Name())
+	return appendLayer(attestationTag, mutate.Addendum{
+		Layer: imagehelpers.NewBlobLayer(envelope, DSSEMediaType),
+		Annotations: map[string]string{
+			SignatureAnnotation:     "",
+			PredicateTypeAnnotation: predicateType,
+		},
+	}, keychain)
+}
+
+// SignEnvelope signs the payload with the DSSE pre-authentication encoding and returns the encoded envelope
+func SignEnvelope(key *ecdsa.PrivateKey, payloadType string, payload []byte) ([]byte, error) {
+	hash := sha256.Sum256(PAE(payloadType, payload))
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(Envelope{
+		PayloadType: payloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures: []Signature{
+			{Sig: base64.StdEncoding.EncodeToString(signature)},
+		},
+	})
+}
+
+// PAE is the DSSE pre-authentication encoding of a payload
+func PAE(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// AttestationTag returns the tag cosign stores the attestations of a digest at
+func AttestationTag(repository name.Repository, digest string) name.Tag {
+	return digestTag(repository, digest, attestationTagSuffix)
+}
@@ -0,0 +1,179 @@
+package cosign
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/buildpacks/lifecycle"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
+)
+
+const (
+	KeyFileName      = "cosign.key"
+	PasswordFileName = "cosign.password"
+
+	SimpleSigningMediaType types.MediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	SignatureAnnotation                    = "dev.cosignproject.cosign/signature"
+
+	signatureTagSuffix = ".sig"
+	signatureType      = "cosign container image signature"
+)
+
+type ImageSigner struct {
+	Logger *log.Logger
+}
+
+// Sign signs the digest in the export report with the key in the secret directory and pushes the
+// signature to the sha256-<digest>.sig tag of every repository the image was exported to
+func (s *ImageSigner) Sign(reportFilePath, secretDir string, keychain authn.Keychain) error {
+	var report lifecycle.ExportReport
+	_, err := toml.DecodeFile(reportFilePath, &report)
+	if err != nil {
+		return err
+	}
+
+	if len(report.Image.Tags) == 0 {
+		return errors.New("no tags found in export report")
+	}
+
+	key, err := LoadKey(secretDir)
+	if err != nil {
+		return err
+	}
+
+	repositories, err := Repositories(report.Image.Tags)
+	if err != nil {
+		return err
+	}
+
+	for _, repository := range repositories {
+		if err := s.sign(key, repository, report.Image.Digest, keychain); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Repositories returns the distinct repositories of the exported tags, signatures are stored once per repository
+func Repositories(tags []string) ([]name.Repository, error) {
+	var repositories []name.Repository
+	seen := map[string]bool{}
+	for _, tag := range tags {
+		ref, err := name.ParseReference(tag, name.WeakValidation)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[ref.Context().Name()] {
+			continue
+		}
+		seen[ref.Context().Name()] = true
+		repositories = append(repositories, ref.Context())
+	}
+	return repositories, nil
+}
+
+func (s *ImageSigner) sign(key *ecdsa.PrivateKey, repository name.Repository, digest string, keychain authn.Keychain) error {
+	payload, err := Payload(repository.Name(), digest)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		return err
+	}
+
+	signatureTag := SignatureTag(repository, digest)
+	s.Logger.Printf("Pushing signature for '%s@%s' to '%s'\n", repository.Name(), digest, signatureTag.Name())
+	return appendLayer(signatureTag, mutate.Addendum{
+		Layer: imagehelpers.NewBlobLayer(payload, SimpleSigningMediaType),
+		Annotations: map[string]string{
+			SignatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+		},
+	}, keychain)
+}
+
+// appendLayer pushes the layer to the tag next to the layers already pushed there
+func appendLayer(tag name.Tag, addendum mutate.Addendum, keychain authn.Keychain) error {
+	base, err := remote.Image(tag, remote.WithAuthFromKeychain(keychain))
+	if transportError, ok := err.(*transport.Error); ok && transportError.StatusCode == http.StatusNotFound {
+		base = mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	} else if err != nil {
+		return errors.Wrapf(err, "unable to fetch %s", tag.Name())
+	}
+
+	image, err := mutate.Append(base, addendum)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrapf(remote.Write(tag, image, remote.WithAuthFromKeychain(keychain)), "unable to push %s", tag.Name())
+}
+
+// SignatureTag returns the tag cosign stores the signatures of a digest at
+func SignatureTag(repository name.Repository, digest string) name.Tag {
+	return digestTag(repository, digest, signatureTagSuffix)
+}
+
+func digestTag(repository name.Repository, digest, suffix string) name.Tag {
+	return repository.Tag(strings.Replace(digest, ":", "-", 1) + suffix)
+}
+
+type simpleSigning struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]interface{} `json:"optional"`
+}
+
+// Payload returns the simple signing payload cosign signs for a digest
+func Payload(repository, digest string) ([]byte, error) {
+	var payload simpleSigning
+	payload.Critical.Identity.DockerReference = repository
+	payload.Critical.Image.DockerManifestDigest = digest
+	payload.Critical.Type = signatureType
+	return json.Marshal(payload)
+}
+
+// LoadKey reads the private key and its optional password from a mounted cosign secret
+func LoadKey(secretDir string) (*ecdsa.PrivateKey, error) {
+	keyPEM, err := ioutil.ReadFile(filepath.Join(secretDir, KeyFileName))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read cosign key")
+	}
+
+	password, err := ioutil.ReadFile(filepath.Join(secretDir, PasswordFileName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrap(err, "unable to read cosign password")
+	}
+
+	return LoadPrivateKey(keyPEM, password)
+}
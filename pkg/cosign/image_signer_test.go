@@ -0,0 +1,166 @@
+package cosign_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/buildpacks/lifecycle"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/cosign"
+)
+
+func TestImageSigner(t *testing.T) {
+	spec.Run(t, "ImageSigner", testImageSigner)
+}
+
+func testImageSigner(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server     *httptest.Server
+		repo       string
+		digest     string
+		reportPath string
+		secretDir  string
+		key        *ecdsa.PrivateKey
+		signer     = &cosign.ImageSigner{Logger: log.New(&bytes.Buffer{}, "", 0)}
+	)
+
+	it.Before(func() {
+		server = httptest.NewServer(registry.New(registry.Logger(log.New(ioutil.Discard, "", 0))))
+		u, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		repo = fmt.Sprintf("%s/some/app", u.Host)
+
+		image, err := random.Image(10, 1)
+		require.NoError(t, err)
+
+		ref, err := name.ParseReference(repo)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, image))
+
+		hash, err := image.Digest()
+		require.NoError(t, err)
+		digest = hash.String()
+
+		reportDir, err := ioutil.TempDir("", "report")
+		require.NoError(t, err)
+		reportPath = filepath.Join(reportDir, "report.toml")
+
+		file, err := os.Create(reportPath)
+		require.NoError(t, err)
+		defer file.Close()
+		require.NoError(t, toml.NewEncoder(file).Encode(lifecycle.ExportReport{
+			Image: lifecycle.ImageReport{
+				Tags:   []string{repo, repo + ":tag2"},
+				Digest: digest,
+			},
+		}))
+
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		secretDir, err = ioutil.TempDir("", "cosign")
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(secretDir, cosign.KeyFileName), encryptedKeyPEM(t, key, "some-password"), 0600))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(secretDir, cosign.PasswordFileName), []byte("some-password"), 0600))
+	})
+
+	it.After(func() {
+		server.Close()
+		require.NoError(t, os.RemoveAll(filepath.Dir(reportPath)))
+		require.NoError(t, os.RemoveAll(secretDir))
+	})
+
+	verifySignatures := func(count int) {
+		repository, err := name.NewRepository(repo)
+		require.NoError(t, err)
+
+		signatureTag := cosign.SignatureTag(repository, digest)
+		require.Equal(t, repo+":sha256-"+digest[len("sha256:"):]+".sig", signatureTag.Name())
+
+		signatures, err := remote.Image(signatureTag)
+		require.NoError(t, err)
+
+		manifest, err := signatures.Manifest()
+		require.NoError(t, err)
+		require.Len(t, manifest.Layers, count)
+
+		for _, descriptor := range manifest.Layers {
+			require.Equal(t, cosign.SimpleSigningMediaType, descriptor.MediaType)
+
+			layer, err := signatures.LayerByDigest(descriptor.Digest)
+			require.NoError(t, err)
+
+			rc, err := layer.Compressed()
+			require.NoError(t, err)
+			payload, err := ioutil.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+
+			var simpleSigning struct {
+				Critical struct {
+					Identity struct {
+						DockerReference string `json:"docker-reference"`
+					} `json:"identity"`
+					Image struct {
+						DockerManifestDigest string `json:"docker-manifest-digest"`
+					} `json:"image"`
+					Type string `json:"type"`
+				} `json:"critical"`
+			}
+			require.NoError(t, json.Unmarshal(payload, &simpleSigning))
+			require.Equal(t, repo, simpleSigning.Critical.Identity.DockerReference)
+			require.Equal(t, digest, simpleSigning.Critical.Image.DockerManifestDigest)
+			require.Equal(t, "cosign container image signature", simpleSigning.Critical.Type)
+
+			signature, err := base64.StdEncoding.DecodeString(descriptor.Annotations[cosign.SignatureAnnotation])
+			require.NoError(t, err)
+
+			hash := sha256.Sum256(payload)
+			require.True(t, ecdsa.VerifyASN1(&key.PublicKey, hash[:], signature))
+		}
+	}
+
+	when("#Sign", func() {
+		it("pushes a signature of the exported digest once per repository", func() {
+			require.NoError(t, signer.Sign(reportPath, secretDir, authn.DefaultKeychain))
+
+			verifySignatures(1)
+		})
+
+		it("appends to existing signatures", func() {
+			require.NoError(t, signer.Sign(reportPath, secretDir, authn.DefaultKeychain))
+			require.NoError(t, signer.Sign(reportPath, secretDir, authn.DefaultKeychain))
+
+			verifySignatures(2)
+		})
+
+		it("errors when the key is missing", func() {
+			require.NoError(t, os.Remove(filepath.Join(secretDir, cosign.KeyFileName)))
+
+			err := signer.Sign(reportPath, secretDir, authn.DefaultKeychain)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "unable to read cosign key")
+		})
+	})
+}
@@ -0,0 +1,112 @@
+package cosign
+
+import (
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	encryptedCosignKeyType   = "ENCRYPTED COSIGN PRIVATE KEY"
+	encryptedSigstoreKeyType = "ENCRYPTED SIGSTORE PRIVATE KEY"
+	pkcs8KeyType             = "PRIVATE KEY"
+	ecKeyType                = "EC PRIVATE KEY"
+)
+
+// LoadPrivateKey decodes a PEM encoded ECDSA private key.
+// Keys generated by cosign are encrypted with the password, unencrypted PKCS8 and SEC1 keys are supported as well.
+func LoadPrivateKey(keyPEM, password []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("cosign key is not PEM encoded")
+	}
+
+	switch block.Type {
+	case encryptedCosignKeyType, encryptedSigstoreKeyType:
+		der, err := decrypt(block.Bytes, password)
+		if err != nil {
+			return nil, err
+		}
+		return parsePKCS8(der)
+	case pkcs8KeyType:
+		return parsePKCS8(block.Bytes)
+	case ecKeyType:
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, errors.Errorf("unsupported cosign key type %q", block.Type)
+	}
+}
+
+func parsePKCS8(der []byte) (*ecdsa.PrivateKey, error) {
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.Errorf("unsupported cosign key algorithm %T", key)
+	}
+	return ecdsaKey, nil
+}
+
+// encryptedKey is the scrypt and nacl/secretbox envelope cosign wraps private keys in
+type encryptedKey struct {
+	KDF struct {
+		Name   string `json:"name"`
+		Params struct {
+			N int `json:"N"`
+			R int `json:"r"`
+			P int `json:"p"`
+		} `json:"params"`
+		Salt []byte `json:"salt"`
+	} `json:"kdf"`
+	Cipher struct {
+		Name  string `json:"name"`
+		Nonce []byte `json:"nonce"`
+	} `json:"cipher"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+const (
+	scryptKDF       = "scrypt"
+	secretboxCipher = "nacl/secretbox"
+)
+
+func decrypt(envelope, password []byte) ([]byte, error) {
+	var key encryptedKey
+	if err := json.Unmarshal(envelope, &key); err != nil {
+		return nil, errors.Wrap(err, "unable to decode encrypted cosign key")
+	}
+
+	if key.KDF.Name != scryptKDF || key.Cipher.Name != secretboxCipher {
+		return nil, errors.Errorf("unsupported cosign key encryption %s/%s", key.KDF.Name, key.Cipher.Name)
+	}
+
+	if len(key.Cipher.Nonce) != 24 {
+		return nil, errors.New("invalid cosign key nonce")
+	}
+
+	derived, err := scrypt.Key(password, key.KDF.Salt, key.KDF.Params.N, key.KDF.Params.R, key.KDF.Params.P, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nonce     [24]byte
+		secretKey [32]byte
+	)
+	copy(nonce[:], key.Cipher.Nonce)
+	copy(secretKey[:], derived)
+
+	plaintext, ok := secretbox.Open(nil, key.Ciphertext, &nonce, &secretKey)
+	if !ok {
+		return nil, errors.New("unable to decrypt cosign key with the provided password")
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,114 @@
+package cosign_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/pivotal/kpack/pkg/cosign"
+)
+
+func TestLoadPrivateKey(t *testing.T) {
+	spec.Run(t, "LoadPrivateKey", testLoadPrivateKey)
+}
+
+func testLoadPrivateKey(t *testing.T, when spec.G, it spec.S) {
+	var key *ecdsa.PrivateKey
+
+	it.Before(func() {
+		var err error
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+	})
+
+	it("loads encrypted cosign keys", func() {
+		loaded, err := cosign.LoadPrivateKey(encryptedKeyPEM(t, key, "some-password"), []byte("some-password"))
+		require.NoError(t, err)
+		require.Equal(t, key.D, loaded.D)
+	})
+
+	it("errors when the password is wrong", func() {
+		_, err := cosign.LoadPrivateKey(encryptedKeyPEM(t, key, "some-password"), []byte("other-password"))
+		require.EqualError(t, err, "unable to decrypt cosign key with the provided password")
+	})
+
+	it("loads unencrypted pkcs8 keys", func() {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+
+		loaded, err := cosign.LoadPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+		require.NoError(t, err)
+		require.Equal(t, key.D, loaded.D)
+	})
+
+	it("loads unencrypted ec keys", func() {
+		der, err := x509.MarshalECPrivateKey(key)
+		require.NoError(t, err)
+
+		loaded, err := cosign.LoadPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil)
+		require.NoError(t, err)
+		require.Equal(t, key.D, loaded.D)
+	})
+
+	it("errors on keys that are not ecdsa", func() {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+		require.NoError(t, err)
+
+		_, err = cosign.LoadPrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+		require.EqualError(t, err, "unsupported cosign key algorithm *rsa.PrivateKey")
+	})
+
+	it("errors on keys that are not PEM encoded", func() {
+		_, err := cosign.LoadPrivateKey([]byte("not-a-key"), nil)
+		require.EqualError(t, err, "cosign key is not PEM encoded")
+	})
+}
+
+// encryptedKeyPEM wraps the key the way `cosign generate-key-pair` does
+func encryptedKeyPEM(t *testing.T, key *ecdsa.PrivateKey, password string) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	salt := make([]byte, 32)
+	_, err = rand.Read(salt)
+	require.NoError(t, err)
+
+	var nonce [24]byte
+	_, err = rand.Read(nonce[:])
+	require.NoError(t, err)
+
+	const n, r, p = 1024, 8, 1
+	derived, err := scrypt.Key([]byte(password), salt, n, r, p, 32)
+	require.NoError(t, err)
+
+	var secretKey [32]byte
+	copy(secretKey[:], derived)
+
+	envelope, err := json.Marshal(map[string]interface{}{
+		"kdf": map[string]interface{}{
+			"name":   "scrypt",
+			"params": map[string]int{"N": n, "r": r, "p": p},
+			"salt":   salt,
+		},
+		"cipher": map[string]interface{}{
+			"name":  "nacl/secretbox",
+			"nonce": nonce[:],
+		},
+		"ciphertext": secretbox.Seal(nil, der, &nonce, &secretKey),
+	})
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED COSIGN PRIVATE KEY", Bytes: envelope})
+}
@@ -17,6 +17,7 @@ type DuckBuilder struct {
 }
 type DuckBuilderSpec struct {
 	ImagePullSecrets []v1.LocalObjectReference
+	Trusted          bool
 }
 
 func (b *DuckBuilder) Ready() bool {
@@ -28,6 +29,7 @@ func (b *DuckBuilder) BuildBuilderSpec() v1alpha1.BuildBuilderSpec {
 	return v1alpha1.BuildBuilderSpec{
 		Image:            b.Status.LatestImage,
 		ImagePullSecrets: b.Spec.ImagePullSecrets,
+		Trusted:          b.Spec.Trusted,
 	}
 }
 
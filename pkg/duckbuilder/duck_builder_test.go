@@ -81,6 +81,12 @@ func testDuckBuilder(t *testing.T, when spec.G, it spec.S) {
 		})
 	})
 
+	it("BuildBuilderSpec provides trust of the builder", func() {
+		duckBuilder.Spec.Trusted = true
+
+		require.True(t, duckBuilder.BuildBuilderSpec().Trusted)
+	})
+
 	it("BuildBuilderSpec provides latest image and pull secrets", func() {
 		require.Equal(t, v1alpha1.BuildBuilderSpec{
 			Image: "some/builder@sha256:12345678",
@@ -65,7 +65,10 @@ func convertBuilder(builder *v1alpha1.Builder) *DuckBuilder {
 	return &DuckBuilder{
 		TypeMeta:   builder.TypeMeta,
 		ObjectMeta: builder.ObjectMeta,
-		Status:     builder.Status,
+		Spec: DuckBuilderSpec{
+			Trusted: builder.Spec.Trusted,
+		},
+		Status: builder.Status,
 	}
 }
 
@@ -77,6 +80,9 @@ func convertClusterBuilder(builder *v1alpha1.ClusterBuilder) *DuckBuilder {
 	return &DuckBuilder{
 		TypeMeta:   builder.TypeMeta,
 		ObjectMeta: builder.ObjectMeta,
-		Status:     builder.Status,
+		Spec: DuckBuilderSpec{
+			Trusted: builder.Spec.Trusted,
+		},
+		Status: builder.Status,
 	}
 }
@@ -54,7 +54,11 @@ func testDuckBuilderInformer(t *testing.T, when spec.G, it spec.S) {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: clusterBuilderName,
 			},
-			Spec: v1alpha1.ClusterBuilderSpec{},
+			Spec: v1alpha1.ClusterBuilderSpec{
+				BuilderSpec: v1alpha1.BuilderSpec{
+					Trusted: true,
+				},
+			},
 			Status: v1alpha1.BuilderStatus{
 				BuilderMetadata: v1alpha1.BuildpackMetadataList{
 					{
@@ -100,6 +104,7 @@ func testDuckBuilderInformer(t *testing.T, when spec.G, it spec.S) {
 			require.Equal(t, builder.ObjectMeta, duckBuilder.ObjectMeta)
 			require.Equal(t, builder.Status, duckBuilder.Status)
 			require.Equal(t, []v1.LocalObjectReference(nil), duckBuilder.Spec.ImagePullSecrets)
+			require.False(t, duckBuilder.Spec.Trusted)
 		})
 
 		it("can return a builder of type ClusterBuilder", func() {
@@ -112,6 +117,7 @@ func testDuckBuilderInformer(t *testing.T, when spec.G, it spec.S) {
 			require.Equal(t, clusterBuilder.ObjectMeta, duckBuilder.ObjectMeta)
 			require.Equal(t, clusterBuilder.Status, duckBuilder.Status)
 			require.Equal(t, []v1.LocalObjectReference(nil), duckBuilder.Spec.ImagePullSecrets)
+			require.True(t, duckBuilder.Spec.Trusted)
 		})
 
 		it("returns a k8s not found error on missing builder", func() {
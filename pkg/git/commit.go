@@ -0,0 +1,46 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func gitCommit(commit *object.Commit) *v1alpha1.GitCommit {
+	timestamp := metav1.NewTime(commit.Committer.When)
+
+	return &v1alpha1.GitCommit{
+		Author:    signature(commit.Author),
+		Committer: signature(commit.Committer),
+		Timestamp: &timestamp,
+		Subject:   strings.TrimSpace(strings.SplitN(commit.Message, "\n", 2)[0]),
+	}
+}
+
+func signature(s object.Signature) string {
+	return fmt.Sprintf("%s <%s>", s.Name, s.Email)
+}
+
+// resolveCommit returns the commit a hash refers to, peeling annotated tags
+func resolveCommit(s storer.EncodedObjectStorer, hash plumbing.Hash) (*object.Commit, error) {
+	obj, err := object.GetObject(s, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch o := obj.(type) {
+	case *object.Commit:
+		return o, nil
+	case *object.Tag:
+		return o.Commit()
+	default:
+		return nil, errors.Errorf("%s is a %s not a commit", hash, obj.Type())
+	}
+}
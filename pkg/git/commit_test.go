@@ -0,0 +1,47 @@
+package git
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestGitCommit(t *testing.T) {
+	spec.Run(t, "TestGitCommit", testGitCommit)
+}
+
+func testGitCommit(t *testing.T, when spec.G, it spec.S) {
+	when("#gitCommit", func() {
+		it("reads the author, committer, commit time and subject", func() {
+			committedAt := time.Date(2020, 7, 14, 10, 30, 0, 0, time.UTC)
+
+			metadata := gitCommit(&object.Commit{
+				Author: object.Signature{
+					Name:  "Some Author",
+					Email: "author@example.com",
+					When:  committedAt.Add(-time.Hour),
+				},
+				Committer: object.Signature{
+					Name:  "Some Committer",
+					Email: "committer@example.com",
+					When:  committedAt,
+				},
+				Message: "Some subject line\n\nSome longer description\n",
+			})
+
+			timestamp := metav1.NewTime(committedAt)
+			require.Equal(t, &v1alpha1.GitCommit{
+				Author:    "Some Author <author@example.com>",
+				Committer: "Some Committer <committer@example.com>",
+				Timestamp: &timestamp,
+				Subject:   "Some subject line",
+			}, metadata)
+		})
+	})
+}
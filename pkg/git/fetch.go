@@ -1,6 +1,7 @@
 package git
 
 import (
+	"encoding/json"
 	"log"
 	"os"
 	"path"
@@ -17,6 +18,8 @@ import (
 type Fetcher struct {
 	Logger   *log.Logger
 	Keychain GitKeychain
+	// CommitMetadataPath is where metadata of the checked out commit is written as json when set
+	CommitMetadataPath string
 }
 
 func (f Fetcher) Fetch(dir, gitURL, gitRevision, metadataDir string) error {
@@ -66,6 +69,12 @@ func (f Fetcher) Fetch(dir, gitURL, gitRevision, metadataDir string) error {
 		return errors.Wrapf(err, "unable to checkout revision: %s", gitRevision)
 	}
 
+	if f.CommitMetadataPath != "" {
+		if err := f.writeCommitMetadata(repo, *hashes); err != nil {
+			return errors.Wrapf(err, "unable to write commit metadata for revision: %s", gitRevision)
+		}
+	}
+
 	projectMetadataFile, err := os.Create(path.Join(metadataDir, "project-metadata.toml"))
 	if err != nil {
 		return errors.Wrapf(err, "invalid metadata destination '%s/project-metadata.toml' for git repository: %s", metadataDir, gitURL)
@@ -91,6 +100,47 @@ func (f Fetcher) Fetch(dir, gitURL, gitRevision, metadataDir string) error {
 	return nil
 }
 
+func (f Fetcher) writeCommitMetadata(repo *git.Repository, hash plumbing.Hash) error {
+	commit, err := repo.CommitObject(hash)
+	if err != nil {
+		return err
+	}
+
+	metadata := gitCommit(commit)
+
+	refs, err := repo.References()
+	if err != nil {
+		return err
+	}
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+
+		switch {
+		case ref.Name().IsBranch() && metadata.Branch == "" && ref.Hash() == hash:
+			metadata.Branch = ref.Name().Short()
+		case ref.Name().IsTag() && metadata.Tag == "":
+			if tagged, err := resolveCommit(repo.Storer, ref.Hash()); err == nil && tagged.Hash == hash {
+				metadata.Tag = ref.Name().Short()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(f.CommitMetadataPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return json.NewEncoder(file).Encode(metadata)
+}
+
 type project struct {
 	Source source `toml:"source"`
 }
@@ -2,6 +2,7 @@ package git
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -15,6 +16,8 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/sclevine/spec"
 	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 )
 
 func TestGitCheckout(t *testing.T) {
@@ -87,6 +90,26 @@ func testGitCheckout(t *testing.T, when spec.G, it spec.S) {
 
 		it("fetches a revision", testFetch("https://github.com/git-fixtures/basic", "b029517f6300c2da0f4b651b8642506cd6aaf45d"))
 
+		it("writes metadata of the checked out commit", func() {
+			fetcher.CommitMetadataPath = path.Join(metadataDir, "commit.json")
+
+			err := fetcher.Fetch(testDir, "https://github.com/git-fixtures/basic", "branch", metadataDir)
+			require.NoError(t, err)
+
+			file, err := os.Open(fetcher.CommitMetadataPath)
+			require.NoError(t, err)
+			defer file.Close()
+
+			var commit v1alpha1.GitCommit
+			require.NoError(t, json.NewDecoder(file).Decode(&commit))
+
+			require.Equal(t, "branch", commit.Branch)
+			require.NotEmpty(t, commit.Author)
+			require.NotEmpty(t, commit.Committer)
+			require.NotEmpty(t, commit.Subject)
+			require.NotNil(t, commit.Timestamp)
+		})
+
 		it("returns invalid credentials to fetch error on authentication required", func() {
 			err := fetcher.Fetch(testDir, "http://github.com/pivotal/kpack-nonexistent-test-repo", "master", "")
 			require.EqualError(t, err, "invalid credentials to fetch git repository: http://github.com/pivotal/kpack-nonexistent-test-repo")
@@ -1,21 +1,27 @@
 package git
 
 import (
+	"fmt"
+	"regexp"
+
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/pkg/errors"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 )
 
 const defaultRemote = "origin"
 
+var commitShaRegex = regexp.MustCompile(`^[0-9a-f]{4,40}$`)
+
 type remoteGitResolver struct {
 }
 
-func (*remoteGitResolver) Resolve(auth transport.AuthMethod, sourceConfig v1alpha1.SourceConfig) (v1alpha1.ResolvedSourceConfig, error) {
+func (*remoteGitResolver) Resolve(auth transport.AuthMethod, sourceConfig v1alpha1.SourceConfig, previous *v1alpha1.ResolvedGitSource) (v1alpha1.ResolvedSourceConfig, error) {
 	repo := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
 		Name: defaultRemote,
 		URLs: []string{sourceConfig.Git.URL},
@@ -24,14 +30,7 @@ func (*remoteGitResolver) Resolve(auth transport.AuthMethod, sourceConfig v1alph
 		Auth: auth,
 	})
 	if err != nil {
-		return v1alpha1.ResolvedSourceConfig{
-			Git: &v1alpha1.ResolvedGitSource{
-				URL:      sourceConfig.Git.URL,
-				Revision: sourceConfig.Git.Revision, // maybe
-				Type:     v1alpha1.Unknown,
-				SubPath:  sourceConfig.SubPath,
-			},
-		}, nil
+		return v1alpha1.ResolvedSourceConfig{}, newResolveError(classifyRemoteError(err), err)
 	}
 
 	for _, ref := range references {
@@ -42,11 +41,17 @@ func (*remoteGitResolver) Resolve(auth transport.AuthMethod, sourceConfig v1alph
 					Revision: ref.Hash().String(),
 					Type:     sourceType(ref),
 					SubPath:  sourceConfig.SubPath,
+					Commit:   commitMetadata(auth, sourceConfig.Git.URL, ref, previous),
 				},
 			}, nil
 		}
 	}
 
+	if !commitShaRegex.MatchString(sourceConfig.Git.Revision) {
+		return v1alpha1.ResolvedSourceConfig{}, newResolveError(v1alpha1.SourceRevisionNotFound,
+			errors.Errorf("revision %s not found in %s", sourceConfig.Git.Revision, sourceConfig.Git.URL))
+	}
+
 	return v1alpha1.ResolvedSourceConfig{
 		Git: &v1alpha1.ResolvedGitSource{
 			URL:      sourceConfig.Git.URL,
@@ -57,6 +62,42 @@ func (*remoteGitResolver) Resolve(auth transport.AuthMethod, sourceConfig v1alph
 	}, nil
 }
 
+// commitMetadata shallow fetches the commit a ref points to unless it was already resolved.
+// Metadata is best effort and is retried on the next poll when it cannot be fetched.
+func commitMetadata(auth transport.AuthMethod, url string, ref *plumbing.Reference, previous *v1alpha1.ResolvedGitSource) *v1alpha1.GitCommit {
+	if previous != nil && previous.Revision == ref.Hash().String() && previous.Commit != nil {
+		return previous.Commit
+	}
+
+	storage := memory.NewStorage()
+	remote := git.NewRemote(storage, &config.RemoteConfig{
+		Name: defaultRemote,
+		URLs: []string{url},
+	})
+	err := remote.Fetch(&git.FetchOptions{
+		RefSpecs: []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%s", ref.Name(), ref.Name()))},
+		Auth:     auth,
+		Depth:    1,
+		Tags:     git.NoTags,
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return nil
+	}
+
+	commit, err := resolveCommit(storage, ref.Hash())
+	if err != nil {
+		return nil
+	}
+
+	metadata := gitCommit(commit)
+	if ref.Name().IsBranch() {
+		metadata.Branch = ref.Name().Short()
+	} else if ref.Name().IsTag() {
+		metadata.Tag = ref.Name().Short()
+	}
+	return metadata
+}
+
 func sourceType(reference *plumbing.Reference) v1alpha1.GitSourceKind {
 	switch {
 	case reference.Name().IsBranch():
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"fmt"
 	"testing"
 
 	fixtures "github.com/go-git/go-git-fixtures"
@@ -37,7 +38,7 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 						Revision: nonHEADCommit,
 					},
 					SubPath: "/foo/bar",
-				})
+				}, nil)
 				require.NoError(t, err)
 
 				assert.Equal(t, resolvedGitSource, v1alpha1.ResolvedSourceConfig{
@@ -63,9 +64,17 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 						Revision: "master",
 					},
 					SubPath: "/foo/bar",
-				})
+				}, nil)
 				require.NoError(t, err)
 
+				commit := resolvedGitSource.Git.Commit
+				require.NotNil(t, commit)
+				assert.Equal(t, "master", commit.Branch)
+				assert.NotEmpty(t, commit.Author)
+				assert.NotEmpty(t, commit.Subject)
+				assert.NotNil(t, commit.Timestamp)
+
+				resolvedGitSource.Git.Commit = nil
 				assert.Equal(t, resolvedGitSource, v1alpha1.ResolvedSourceConfig{
 					Git: &v1alpha1.ResolvedGitSource{
 						URL:      repo.URL,
@@ -75,6 +84,32 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 					},
 				})
 			})
+
+			it("reuses commit metadata when the branch has not moved", func() {
+				repo := fixtures.Basic().One()
+
+				gitResolver := &remoteGitResolver{}
+
+				previousCommit := &v1alpha1.GitCommit{
+					Branch:  "master",
+					Subject: "some-previously-resolved-subject",
+				}
+
+				resolvedGitSource, err := gitResolver.Resolve(anonymousAuth, v1alpha1.SourceConfig{
+					Git: &v1alpha1.Git{
+						URL:      repo.URL,
+						Revision: "master",
+					},
+				}, &v1alpha1.ResolvedGitSource{
+					URL:      repo.URL,
+					Revision: fixtureHEADMasterCommit,
+					Type:     v1alpha1.Branch,
+					Commit:   previousCommit,
+				})
+				require.NoError(t, err)
+
+				assert.Equal(t, previousCommit, resolvedGitSource.Git.Commit)
+			})
 		})
 
 		when("source is a tag", func() {
@@ -89,9 +124,13 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 						Revision: tag,
 					},
 					SubPath: "/foo/bar",
-				})
+				}, nil)
 				require.NoError(t, err)
 
+				require.NotNil(t, resolvedGitSource.Git.Commit)
+				assert.Equal(t, tag, resolvedGitSource.Git.Commit.Tag)
+
+				resolvedGitSource.Git.Commit = nil
 				assert.Equal(t, resolvedGitSource, v1alpha1.ResolvedSourceConfig{
 					Git: &v1alpha1.ResolvedGitSource{
 						URL:      repo.URL,
@@ -104,12 +143,12 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		when("authentication fails", func() {
-			it("returns an unknown type", func() {
+			it("returns an authentication failure", func() {
 				repo := fixtures.ByTag("tags").One()
 
 				gitResolver := &remoteGitResolver{}
 
-				resolvedGitSource, err := gitResolver.Resolve(&http.BasicAuth{
+				_, err := gitResolver.Resolve(&http.BasicAuth{
 					Username: "notgonna",
 					Password: "work",
 				}, v1alpha1.SourceConfig{
@@ -118,17 +157,32 @@ func testRemoteGitResolver(t *testing.T, when spec.G, it spec.S) {
 						Revision: tag,
 					},
 					SubPath: "/foo/bar",
-				})
-				require.NoError(t, err)
+				}, nil)
+				require.Error(t, err)
 
-				assert.Equal(t, resolvedGitSource, v1alpha1.ResolvedSourceConfig{
-					Git: &v1alpha1.ResolvedGitSource{
+				resolveErr, ok := err.(*resolveError)
+				require.True(t, ok)
+				assert.Equal(t, v1alpha1.SourceAuthenticationFailed, resolveErr.Reason())
+			})
+		})
+
+		when("the revision is not a branch, tag, or commit", func() {
+			it("returns a revision not found failure", func() {
+				repo := fixtures.Basic().One()
+
+				gitResolver := &remoteGitResolver{}
+
+				_, err := gitResolver.Resolve(anonymousAuth, v1alpha1.SourceConfig{
+					Git: &v1alpha1.Git{
 						URL:      repo.URL,
-						Revision: tag,
-						Type:     v1alpha1.Unknown,
-						SubPath:  "/foo/bar",
+						Revision: "no-such-branch",
 					},
-				})
+				}, nil)
+				require.EqualError(t, err, fmt.Sprintf("revision no-such-branch not found in %s", repo.URL))
+
+				resolveErr, ok := err.(*resolveError)
+				require.True(t, ok)
+				assert.Equal(t, v1alpha1.SourceRevisionNotFound, resolveErr.Reason())
 			})
 		})
 	})
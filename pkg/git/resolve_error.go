@@ -0,0 +1,45 @@
+package git
+
+import (
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+type resolveError struct {
+	reason string
+	err    error
+}
+
+func newResolveError(reason string, err error) error {
+	return &resolveError{reason: reason, err: err}
+}
+
+func (e *resolveError) Error() string {
+	return e.err.Error()
+}
+
+func (e *resolveError) Unwrap() error {
+	return e.err
+}
+
+// Reason classifies the failure for the SourceResolved condition
+func (e *resolveError) Reason() string {
+	return e.reason
+}
+
+func classifyRemoteError(err error) string {
+	switch {
+	case errors.Is(err, transport.ErrAuthenticationRequired),
+		errors.Is(err, transport.ErrAuthorizationFailed),
+		errors.Is(err, transport.ErrInvalidAuthMethod):
+		return v1alpha1.SourceAuthenticationFailed
+	case errors.Is(err, transport.ErrRepositoryNotFound):
+		return v1alpha1.SourceNotFound
+	case errors.Is(err, transport.ErrEmptyRemoteRepository):
+		return v1alpha1.SourceRevisionNotFound
+	default:
+		return v1alpha1.SourceNetworkError
+	}
+}
@@ -0,0 +1,46 @@
+package git
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestResolveError(t *testing.T) {
+	spec.Run(t, "TestResolveError", testResolveError)
+}
+
+func testResolveError(t *testing.T, when spec.G, it spec.S) {
+	when("#classifyRemoteError", func() {
+		it("classifies authentication failures", func() {
+			assert.Equal(t, v1alpha1.SourceAuthenticationFailed, classifyRemoteError(transport.ErrAuthenticationRequired))
+			assert.Equal(t, v1alpha1.SourceAuthenticationFailed, classifyRemoteError(transport.ErrAuthorizationFailed))
+			assert.Equal(t, v1alpha1.SourceAuthenticationFailed, classifyRemoteError(transport.ErrInvalidAuthMethod))
+		})
+
+		it("classifies missing repositories", func() {
+			assert.Equal(t, v1alpha1.SourceNotFound, classifyRemoteError(transport.ErrRepositoryNotFound))
+		})
+
+		it("classifies empty repositories as a missing revision", func() {
+			assert.Equal(t, v1alpha1.SourceRevisionNotFound, classifyRemoteError(transport.ErrEmptyRemoteRepository))
+		})
+
+		it("classifies everything else as a network failure", func() {
+			assert.Equal(t, v1alpha1.SourceNetworkError, classifyRemoteError(&net.DNSError{Err: "no such host", Name: "example.com"}))
+			assert.Equal(t, v1alpha1.SourceNetworkError, classifyRemoteError(errors.New("connection reset")))
+		})
+	})
+
+	it("exposes the underlying error message", func() {
+		err := newResolveError(v1alpha1.SourceNotFound, transport.ErrRepositoryNotFound)
+		assert.EqualError(t, err, "repository not found")
+		assert.True(t, errors.Is(err, transport.ErrRepositoryNotFound))
+	})
+}
@@ -0,0 +1,219 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sclient "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	"github.com/pivotal/kpack/pkg/registry"
+)
+
+const (
+	VolumeArchiveKind   = "volume"
+	RegistryArchiveKind = "registry"
+	S3ArchiveKind       = "s3"
+)
+
+// ErrNotArchived is returned by an Archive that holds no logs for a build
+var ErrNotArchived = errors.New("build logs not archived")
+
+// Archive stores the logs of finished builds so they outlive the build pod and the Build
+type Archive interface {
+	// Put stores the logs of the build and returns where they were stored
+	Put(build *v1alpha1.Build, logs []byte) (string, error)
+	// Get reads archived logs by their key, the Build may already be pruned
+	Get(key ArchiveKey) ([]byte, error)
+}
+
+// ArchiveKey locates the archived logs of a build by its image and build number, which outlive the Build.
+// Builds that do not belong to an image are located by their name.
+type ArchiveKey struct {
+	Namespace   string
+	Image       string
+	BuildNumber string
+	Build       string
+}
+
+// BuildArchiveKey is the key the logs of the build are archived with
+func BuildArchiveKey(build *v1alpha1.Build) ArchiveKey {
+	image, ok := build.Labels[v1alpha1.ImageLabel]
+	if !ok {
+		return ArchiveKey{Namespace: build.Namespace, Build: build.Name}
+	}
+
+	return ArchiveKey{
+		Namespace:   build.Namespace,
+		Image:       image,
+		BuildNumber: build.Labels[v1alpha1.BuildNumberLabel],
+	}
+}
+
+// path is the location of the logs relative to the root of a volume or bucket: <namespace>/<image>/<build-number>.log or <namespace>/<build>.log
+func (k ArchiveKey) path() string {
+	if k.Image == "" {
+		return path.Join(k.Namespace, fmt.Sprintf("%s.log", k.Build))
+	}
+	return path.Join(k.Namespace, k.Image, fmt.Sprintf("%s.log", k.BuildNumber))
+}
+
+// ArchiveConfig selects the sink build logs are archived to
+type ArchiveConfig struct {
+	Kind string
+	// Dir is the directory a volume archive writes to, usually the mount of a PersistentVolumeClaim
+	Dir               string
+	S3Endpoint        string
+	S3Bucket          string
+	S3Region          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+}
+
+// NewArchive returns the archive described by the config or nil if log archiving is disabled
+func NewArchive(config ArchiveConfig, keychainFactory registry.KeychainFactory, kpackClient versioned.Interface) (Archive, error) {
+	switch config.Kind {
+	case "":
+		return nil, nil
+	case VolumeArchiveKind:
+		if config.Dir == "" {
+			return nil, errors.New("a directory is required for the volume log archive")
+		}
+		return &VolumeArchive{Dir: config.Dir}, nil
+	case RegistryArchiveKind:
+		return &RegistryArchive{KeychainFactory: keychainFactory, KpackClient: kpackClient}, nil
+	case S3ArchiveKind:
+		if config.S3Endpoint == "" || config.S3Bucket == "" {
+			return nil, errors.New("an endpoint and bucket are required for the s3 log archive")
+		}
+		return &S3Archive{
+			Endpoint:        config.S3Endpoint,
+			Bucket:          config.S3Bucket,
+			Region:          config.S3Region,
+			AccessKeyID:     config.S3AccessKeyID,
+			SecretAccessKey: config.S3SecretAccessKey,
+		}, nil
+	default:
+		return nil, errors.Errorf("unknown log archive %s, must be one of %s, %s or %s", config.Kind, VolumeArchiveKind, RegistryArchiveKind, S3ArchiveKind)
+	}
+}
+
+const (
+	defaultArchiveQueueSize = 1000
+	archiveWorkers          = 2
+)
+
+// Archiver captures the logs of every step of finished builds and stores them in an Archive in the background,
+// recording where they were stored in the logArchive field of the build status
+type Archiver struct {
+	K8sClient   k8sclient.Interface
+	KpackClient versioned.Interface
+	Archive     Archive
+	Logger      *zap.SugaredLogger
+
+	queue   chan *v1alpha1.Build
+	lock    sync.Mutex
+	pending map[string]struct{}
+}
+
+func NewArchiver(k8sClient k8sclient.Interface, kpackClient versioned.Interface, archive Archive, logger *zap.SugaredLogger) *Archiver {
+	return &Archiver{
+		K8sClient:   k8sClient,
+		KpackClient: kpackClient,
+		Archive:     archive,
+		Logger:      logger,
+		queue:       make(chan *v1alpha1.Build, defaultArchiveQueueSize),
+		pending:     map[string]struct{}{},
+	}
+}
+
+// Enqueue queues the logs of a finished build for archiving unless they are already queued, builds are dropped when the queue is full
+func (a *Archiver) Enqueue(build *v1alpha1.Build) {
+	key := build.Namespace + "/" + build.Name
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if _, ok := a.pending[key]; ok {
+		return
+	}
+
+	select {
+	case a.queue <- build.DeepCopy():
+		a.pending[key] = struct{}{}
+	default:
+		a.Logger.Warnw("Dropping build log archiving, the archive queue is full", zap.String("build", build.Name))
+	}
+}
+
+// Run archives queued build logs until done is closed
+func (a *Archiver) Run(done <-chan struct{}) error {
+	for i := 0; i < archiveWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case build := <-a.queue:
+					if err := a.archive(build); err != nil {
+						a.Logger.Errorw("Error archiving build logs", zap.String("namespace", build.Namespace), zap.String("build", build.Name), zap.Error(err))
+					}
+					a.done(build)
+				}
+			}
+		}()
+	}
+
+	<-done
+	return nil
+}
+
+func (a *Archiver) done(build *v1alpha1.Build) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.pending, build.Namespace+"/"+build.Name)
+}
+
+func (a *Archiver) archive(build *v1alpha1.Build) error {
+	location, err := a.ArchiveLogs(build)
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := a.KpackClient.KpackV1alpha1().Builds(build.Namespace).Get(build.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if latest.Status.LogArchive == location {
+			return nil
+		}
+
+		latest.Status.LogArchive = location
+		_, err = a.KpackClient.KpackV1alpha1().Builds(build.Namespace).UpdateStatus(latest)
+		return err
+	})
+}
+
+// ArchiveLogs stores the logs of the build pod's steps and returns where they were stored
+func (a *Archiver) ArchiveLogs(build *v1alpha1.Build) (string, error) {
+	buf := &bytes.Buffer{}
+	err := NewBuildLogsClient(a.K8sClient).GetBuildLogs(context.Background(), buf, build.Namespace, build.Name)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to read logs of build %s", build.Name)
+	}
+
+	location, err := a.Archive.Put(build, buf.Bytes())
+	return location, errors.Wrapf(err, "unable to archive logs of build %s", build.Name)
+}
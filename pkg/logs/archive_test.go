@@ -0,0 +1,194 @@
+package logs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestArchive(t *testing.T) {
+	spec.Run(t, "Archive", testArchive)
+}
+
+func testArchive(t *testing.T, when spec.G, it spec.S) {
+	build := &v1alpha1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-build",
+			Namespace: "some-namespace",
+			Labels: map[string]string{
+				v1alpha1.ImageLabel:       "some-image",
+				v1alpha1.BuildNumberLabel: "3",
+			},
+		},
+		Spec: v1alpha1.BuildSpec{
+			Tags: []string{"some/image"},
+		},
+	}
+
+	when("#NewArchive", func() {
+		keychainFactory := &registryfakes.FakeKeychainFactory{}
+		kpackClient := fake.NewSimpleClientset()
+
+		it("returns nil when archiving is disabled", func() {
+			archive, err := NewArchive(ArchiveConfig{}, keychainFactory, kpackClient)
+			require.NoError(t, err)
+			require.Nil(t, archive)
+		})
+
+		it("returns the configured archive", func() {
+			archive, err := NewArchive(ArchiveConfig{Kind: VolumeArchiveKind, Dir: "/logs"}, keychainFactory, kpackClient)
+			require.NoError(t, err)
+			require.Equal(t, &VolumeArchive{Dir: "/logs"}, archive)
+
+			archive, err = NewArchive(ArchiveConfig{Kind: RegistryArchiveKind}, keychainFactory, kpackClient)
+			require.NoError(t, err)
+			require.Equal(t, &RegistryArchive{KeychainFactory: keychainFactory, KpackClient: kpackClient}, archive)
+
+			archive, err = NewArchive(ArchiveConfig{
+				Kind:              S3ArchiveKind,
+				S3Endpoint:        "http://minio:9000",
+				S3Bucket:          "build-logs",
+				S3AccessKeyID:     "some-access-key",
+				S3SecretAccessKey: "some-secret-key",
+			}, keychainFactory, kpackClient)
+			require.NoError(t, err)
+			require.Equal(t, &S3Archive{
+				Endpoint:        "http://minio:9000",
+				Bucket:          "build-logs",
+				AccessKeyID:     "some-access-key",
+				SecretAccessKey: "some-secret-key",
+			}, archive)
+		})
+
+		it("errors when the archive is incomplete or unknown", func() {
+			_, err := NewArchive(ArchiveConfig{Kind: VolumeArchiveKind}, keychainFactory, kpackClient)
+			require.EqualError(t, err, "a directory is required for the volume log archive")
+
+			_, err = NewArchive(ArchiveConfig{Kind: S3ArchiveKind, S3Endpoint: "http://minio:9000"}, keychainFactory, kpackClient)
+			require.EqualError(t, err, "an endpoint and bucket are required for the s3 log archive")
+
+			_, err = NewArchive(ArchiveConfig{Kind: "ftp"}, keychainFactory, kpackClient)
+			require.EqualError(t, err, "unknown log archive ftp, must be one of volume, registry or s3")
+		})
+	})
+
+	when("VolumeArchive", func() {
+		var archive *VolumeArchive
+
+		it.Before(func() {
+			dir, err := ioutil.TempDir("", "log-archive")
+			require.NoError(t, err)
+			archive = &VolumeArchive{Dir: dir}
+		})
+
+		it.After(func() {
+			require.NoError(t, os.RemoveAll(archive.Dir))
+		})
+
+		it("stores logs below the namespace and image directory", func() {
+			location, err := archive.Put(build, []byte("some logs"))
+			require.NoError(t, err)
+			require.Equal(t, filepath.Join(archive.Dir, "some-namespace", "some-image", "3.log"), location)
+
+			logs, err := archive.Get(ArchiveKey{Namespace: "some-namespace", Image: "some-image", BuildNumber: "3"})
+			require.NoError(t, err)
+			require.Equal(t, "some logs", string(logs))
+		})
+
+		it("stores logs of builds without an image by their name", func() {
+			build.Labels = nil
+
+			location, err := archive.Put(build, []byte("some logs"))
+			require.NoError(t, err)
+			require.Equal(t, filepath.Join(archive.Dir, "some-namespace", "some-build.log"), location)
+
+			logs, err := archive.Get(ArchiveKey{Namespace: "some-namespace", Build: "some-build"})
+			require.NoError(t, err)
+			require.Equal(t, "some logs", string(logs))
+		})
+
+		it("returns ErrNotArchived for builds without archived logs", func() {
+			_, err := archive.Get(BuildArchiveKey(build))
+			require.Equal(t, ErrNotArchived, err)
+		})
+	})
+
+	when("Archiver", func() {
+		var (
+			archive     *fakeArchive
+			kpackClient *fake.Clientset
+			archiver    *Archiver
+		)
+
+		it.Before(func() {
+			archive = &fakeArchive{archived: map[ArchiveKey][]byte{}}
+			kpackClient = fake.NewSimpleClientset(build)
+			archiver = NewArchiver(k8sfake.NewSimpleClientset(), kpackClient, archive, zap.NewNop().Sugar())
+		})
+
+		it("archives queued logs in the background and records their location in the build status", func() {
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				_ = archiver.Run(done)
+			}()
+
+			archiver.Enqueue(build)
+
+			require.Eventually(t, func() bool {
+				archived, err := kpackClient.KpackV1alpha1().Builds(build.Namespace).Get(build.Name, metav1.GetOptions{})
+				require.NoError(t, err)
+				return archived.Status.LogArchive == "some-namespace/some-image/3.log"
+			}, time.Second, time.Millisecond)
+			require.Contains(t, archive.archived, BuildArchiveKey(build))
+		})
+
+		it("queues the logs of a build once while they are pending", func() {
+			archiver.Enqueue(build)
+			archiver.Enqueue(build)
+
+			require.Len(t, archiver.queue, 1)
+		})
+
+		it("does not block when the queue is full", func() {
+			archiver.queue = make(chan *v1alpha1.Build)
+
+			archiver.Enqueue(build)
+
+			require.Empty(t, archiver.pending)
+		})
+	})
+}
+
+type fakeArchive struct {
+	archived map[ArchiveKey][]byte
+	err      error
+}
+
+func (f *fakeArchive) Put(build *v1alpha1.Build, logs []byte) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	f.archived[BuildArchiveKey(build)] = logs
+	return BuildArchiveKey(build).path(), nil
+}
+
+func (f *fakeArchive) Get(key ArchiveKey) ([]byte, error) {
+	logs, ok := f.archived[key]
+	if !ok {
+		return nil, ErrNotArchived
+	}
+	return logs, nil
+}
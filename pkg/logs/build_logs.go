@@ -5,20 +5,24 @@ import (
 	"context"
 	"fmt"
 	"io"
-	"log"
-	"strings"
+	"sort"
 
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
 	k8sclient "k8s.io/client-go/kubernetes"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
 )
 
 type BuildLogsClient struct {
-	k8sClient k8sclient.Interface
-	processed map[readyContainer]interface{}
+	k8sClient   k8sclient.Interface
+	kpackClient versioned.Interface
+	archive     Archive
+	processed   map[readyContainer]interface{}
 }
 
 func NewBuildLogsClient(k8sClient k8sclient.Interface) *BuildLogsClient {
@@ -28,44 +32,197 @@ func NewBuildLogsClient(k8sClient k8sclient.Interface) *BuildLogsClient {
 	}
 }
 
+// NewArchivedBuildLogsClient returns a BuildLogsClient that reads the logs of finished builds whose pod is gone from the archive
+func NewArchivedBuildLogsClient(k8sClient k8sclient.Interface, kpackClient versioned.Interface, archive Archive) *BuildLogsClient {
+	return &BuildLogsClient{
+		k8sClient:   k8sClient,
+		kpackClient: kpackClient,
+		archive:     archive,
+		processed:   make(map[readyContainer]interface{}),
+	}
+}
+
 func (c *BuildLogsClient) Tail(ctx context.Context, writer io.Writer, image, build, namespace string) error {
-	return c.tailPods(ctx, writer, namespace, metav1.ListOptions{
+	selector := fmt.Sprintf("%s=%s,%s=%s", v1alpha1.ImageLabel, image, v1alpha1.BuildNumberLabel, build)
+
+	builds, err := c.listBuilds(namespace, selector)
+	if err != nil {
+		return err
+	}
+
+	if !running(builds) {
+		key := ArchiveKey{Namespace: namespace, Image: image, BuildNumber: build}
+		if archived, err := c.writeArchivedLogs(writer, key, selector); err != nil || archived {
+			return err
+		}
+	}
+
+	return c.tailPods(ctx, &terminalWriter{writer: writer}, namespace, metav1.ListOptions{
 		Watch:         true,
-		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", v1alpha1.ImageLabel, image, v1alpha1.BuildNumberLabel, build),
+		LabelSelector: selector,
 	}, true, true)
 }
 
 func (c *BuildLogsClient) TailImage(ctx context.Context, writer io.Writer, image, namespace string) error {
-	return c.tailPods(ctx, writer, namespace, metav1.ListOptions{
+	return c.tailPods(ctx, &terminalWriter{writer: writer}, namespace, metav1.ListOptions{
 		Watch:         true,
 		LabelSelector: fmt.Sprintf("%s=%s", v1alpha1.ImageLabel, image),
 	}, false, true)
 }
 
 func (c *BuildLogsClient) GetImageLogs(ctx context.Context, writer io.Writer, image, namespace string) error {
-	return c.getPodLogs(ctx, writer, namespace, metav1.ListOptions{
+	selector := fmt.Sprintf("%s=%s", v1alpha1.ImageLabel, image)
+
+	builds, err := c.listBuilds(namespace, selector)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(builds, func(i, j int) bool {
+		return builds[i].CreationTimestamp.Before(&builds[j].CreationTimestamp)
+	})
+
+	for i := range builds {
+		if !builds[i].Finished() {
+			continue
+		}
+
+		_, err := c.writeArchivedLogs(writer, BuildArchiveKey(&builds[i]), fmt.Sprintf("%s=%s", v1alpha1.BuildLabel, builds[i].Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.getPodLogs(ctx, &terminalWriter{writer: writer}, namespace, metav1.ListOptions{
 		Watch:         false,
-		LabelSelector: fmt.Sprintf("%s=%s", v1alpha1.ImageLabel, image),
+		LabelSelector: selector,
+	}, false)
+}
+
+// GetBuildLogs writes the logs of every step of the build's pod that has started
+func (c *BuildLogsClient) GetBuildLogs(ctx context.Context, writer io.Writer, namespace string, buildName string) error {
+	return c.getPodLogs(ctx, &terminalWriter{writer: writer}, namespace, metav1.ListOptions{
+		Watch:         false,
+		LabelSelector: fmt.Sprintf("%s=%s", v1alpha1.BuildLabel, buildName),
 	}, false)
 }
 
 func (c *BuildLogsClient) TailBuildName(ctx context.Context, writer io.Writer, namespace string, buildName string) error {
-	return c.tailPods(ctx, writer, namespace, metav1.ListOptions{
+	selector := fmt.Sprintf("%s=%s", v1alpha1.BuildLabel, buildName)
+
+	build, err := c.getBuild(namespace, buildName)
+	if err != nil {
+		return err
+	}
+
+	if c.archive != nil && (build == nil || build.Finished()) {
+		key := ArchiveKey{Namespace: namespace, Build: buildName}
+		if build != nil {
+			key = BuildArchiveKey(build)
+		}
+
+		if archived, err := c.writeArchivedLogs(writer, key, selector); err != nil || archived {
+			return err
+		}
+	}
+
+	return c.tailPods(ctx, &terminalWriter{writer: writer}, namespace, metav1.ListOptions{
+		Watch:         true,
+		LabelSelector: selector,
+	}, true, true)
+}
+
+// TailBuildSteps writes each line logged by the steps of the build's pod with its step and timestamp until the pod finishes
+func (c *BuildLogsClient) TailBuildSteps(ctx context.Context, writer StepLogWriter, namespace string, buildName string) error {
+	return c.tailPods(ctx, &stepLogLineWriter{writer: writer}, namespace, metav1.ListOptions{
 		Watch:         true,
 		LabelSelector: fmt.Sprintf("%s=%s", v1alpha1.BuildLabel, buildName),
 	}, true, true)
 }
 
-func (c *BuildLogsClient) tailPods(ctx context.Context, writer io.Writer, namespace string, listOptions metav1.ListOptions, exitPodComplete bool, follow bool) error {
+// TailImageSteps writes each line logged by the steps of the image's build pods with its step and timestamp until the context is done
+func (c *BuildLogsClient) TailImageSteps(ctx context.Context, writer StepLogWriter, image, namespace string) error {
+	return c.tailPods(ctx, &stepLogLineWriter{writer: writer}, namespace, metav1.ListOptions{
+		Watch:         true,
+		LabelSelector: fmt.Sprintf("%s=%s", v1alpha1.ImageLabel, image),
+	}, false, true)
+}
+
+func (c *BuildLogsClient) listBuilds(namespace, selector string) ([]v1alpha1.Build, error) {
+	if c.archive == nil {
+		return nil, nil
+	}
+
+	builds, err := c.kpackClient.KpackV1alpha1().Builds(namespace).List(metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return builds.Items, nil
+}
+
+func (c *BuildLogsClient) getBuild(namespace, buildName string) (*v1alpha1.Build, error) {
+	if c.archive == nil {
+		return nil, nil
+	}
+
+	build, err := c.kpackClient.KpackV1alpha1().Builds(namespace).Get(buildName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return build, err
+}
+
+// running is true if any of the builds has not finished, its logs are read from the build pod
+func running(builds []v1alpha1.Build) bool {
+	for i := range builds {
+		if !builds[i].Finished() {
+			return true
+		}
+	}
+	return false
+}
+
+// writeArchivedLogs writes the archived logs of a build once no pod matches the selector, it does not require the Build to exist
+func (c *BuildLogsClient) writeArchivedLogs(writer io.Writer, key ArchiveKey, podSelector string) (bool, error) {
+	if c.archive == nil {
+		return false, nil
+	}
+
+	pods, err := c.k8sClient.CoreV1().Pods(key.Namespace).List(metav1.ListOptions{
+		LabelSelector: podSelector,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if len(pods.Items) > 0 {
+		return false, nil
+	}
+
+	logs, err := c.archive.Get(key)
+	if err == ErrNotArchived {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	_, err = writer.Write(logs)
+	return err == nil, err
+}
+
+func (c *BuildLogsClient) tailPods(ctx context.Context, writer stepWriter, namespace string, listOptions metav1.ListOptions, exitPodComplete bool, follow bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
 	readyContainers := make(chan readyContainer)
+	watchErr := make(chan error, 1)
 
 	go func() {
 		defer close(readyContainers)
 
-		err := c.watchReadyContainers(ctx, readyContainers, namespace, listOptions, exitPodComplete)
-		if err != nil {
-			log.Fatalf("error watching ready containers %s", err)
-		}
+		watchErr <- c.watchReadyContainers(ctx, readyContainers, namespace, listOptions, exitPodComplete)
 	}()
 
 	for container := range readyContainers {
@@ -75,10 +232,10 @@ func (c *BuildLogsClient) tailPods(ctx context.Context, writer io.Writer, namesp
 		}
 	}
 
-	return nil
+	return errors.Wrap(<-watchErr, "error watching ready containers")
 }
 
-func (c *BuildLogsClient) getPodLogs(ctx context.Context, writer io.Writer, namespace string, listOptions metav1.ListOptions, follow bool) error {
+func (c *BuildLogsClient) getPodLogs(ctx context.Context, writer stepWriter, namespace string, listOptions metav1.ListOptions, follow bool) error {
 	readyContainers, err := c.getContainers(namespace, listOptions)
 
 	if err != nil {
@@ -97,6 +254,7 @@ func (c *BuildLogsClient) getPodLogs(ctx context.Context, writer io.Writer, name
 
 type readyContainer struct {
 	podName       string
+	buildName     string
 	containerName string
 	namespace     string
 }
@@ -123,23 +281,11 @@ func (c *BuildLogsClient) watchReadyContainers(ctx context.Context, readyContain
 			switch r.Type {
 			case watch.Added, watch.Modified:
 
-				for _, c := range pod.Status.InitContainerStatuses {
-					if c.State.Waiting == nil {
-						readyContainers <- readyContainer{
-							podName:       pod.Name,
-							containerName: c.Name,
-							namespace:     pod.Namespace,
-						}
-					}
-				}
-
-				for _, c := range pod.Status.ContainerStatuses {
-					if c.State.Waiting == nil {
-						readyContainers <- readyContainer{
-							podName:       pod.Name,
-							containerName: c.Name,
-							namespace:     pod.Namespace,
-						}
+				for _, container := range podReadyContainers(pod) {
+					select {
+					case readyContainers <- container:
+					case <-ctx.Done():
+						return nil
 					}
 				}
 
@@ -159,49 +305,50 @@ func (c *BuildLogsClient) getContainers(namespace string, listOptions metav1.Lis
 		return nil, err
 	}
 
-	for _, pod := range pods.Items {
-		for _, c := range pod.Status.InitContainerStatuses {
-			if c.State.Waiting == nil {
-				readyContainers = append(readyContainers, readyContainer{
-					podName:       pod.Name,
-					containerName: c.Name,
-					namespace:     pod.Namespace,
-				})
-			}
-		}
+	for i := range pods.Items {
+		readyContainers = append(readyContainers, podReadyContainers(&pods.Items[i])...)
+	}
+	return readyContainers, nil
+}
 
-		for _, c := range pod.Status.ContainerStatuses {
+// podReadyContainers returns the containers of the pod that have started in the order they run
+func podReadyContainers(pod *corev1.Pod) []readyContainer {
+	var readyContainers []readyContainer
+	for _, statuses := range [][]corev1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, c := range statuses {
 			if c.State.Waiting == nil {
 				readyContainers = append(readyContainers, readyContainer{
 					podName:       pod.Name,
+					buildName:     pod.Labels[v1alpha1.BuildLabel],
 					containerName: c.Name,
 					namespace:     pod.Namespace,
 				})
 			}
 		}
 	}
-	return readyContainers, nil
+	return readyContainers
 }
 
 func finished(pod *corev1.Pod) bool {
 	return pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded
 }
 
-func (c *BuildLogsClient) streamLogsForContainer(ctx context.Context, writer io.Writer, readyContainer readyContainer, follow bool) error {
+func (c *BuildLogsClient) streamLogsForContainer(ctx context.Context, writer stepWriter, readyContainer readyContainer, follow bool) error {
 	if _, alreadyProcessed := c.processed[readyContainer]; alreadyProcessed {
 		return nil
 	}
 	c.processed[readyContainer] = nil
 
 	logReadCloser, err := c.k8sClient.CoreV1().Pods(readyContainer.namespace).GetLogs(readyContainer.podName, &corev1.PodLogOptions{
-		Container: readyContainer.containerName,
-		Follow:    follow}).Stream()
+		Container:  readyContainer.containerName,
+		Follow:     follow,
+		Timestamps: writer.timestamps()}).Stream()
 	if err != nil {
 		return err
 	}
 	defer logReadCloser.Close()
 
-	_, err = writer.Write([]byte(cyan(fmt.Sprintf("===> %s\n", strings.ToUpper(readyContainer.containerName)))))
+	err = writer.startStep(readyContainer)
 	if err != nil {
 		return err
 	}
@@ -221,7 +368,7 @@ func (c *BuildLogsClient) streamLogsForContainer(ctx context.Context, writer io.
 				return nil
 			}
 
-			_, err = writer.Write(line)
+			err = writer.writeLine(readyContainer, line)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,131 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+)
+
+func TestBuildLogsClient(t *testing.T) {
+	spec.Run(t, "Build Logs Client", testBuildLogsClient)
+}
+
+func testBuildLogsClient(t *testing.T, when spec.G, it spec.S) {
+	const namespace = "some-namespace"
+
+	finishedBuild := func(name, buildNumber string, created time.Time) *v1alpha1.Build {
+		return &v1alpha1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					v1alpha1.ImageLabel:       "some-image",
+					v1alpha1.BuildNumberLabel: buildNumber,
+				},
+			},
+			Status: v1alpha1.BuildStatus{
+				Status: corev1alpha1.Status{
+					Conditions: corev1alpha1.Conditions{
+						{Type: corev1alpha1.ConditionSucceeded, Status: corev1.ConditionTrue},
+					},
+				},
+			},
+		}
+	}
+
+	var (
+		now     = time.Now()
+		archive = &fakeArchive{archived: map[ArchiveKey][]byte{
+			{Namespace: namespace, Image: "some-image", BuildNumber: "1"}: []byte("build 1 logs\n"),
+			{Namespace: namespace, Image: "some-image", BuildNumber: "2"}: []byte("build 2 logs\n"),
+			{Namespace: namespace, Image: "some-image", BuildNumber: "3"}: []byte("build 3 logs\n"),
+			{Namespace: namespace, Build: "standalone-build"}:             []byte("standalone build logs\n"),
+		}}
+		kpackClient = fake.NewSimpleClientset(
+			finishedBuild("build-2", "2", now),
+			finishedBuild("build-1", "1", now.Add(-time.Hour)),
+		)
+		k8sClient = k8sfake.NewSimpleClientset()
+		out       = &bytes.Buffer{}
+	)
+
+	when("the build pod is gone", func() {
+		it("tails the archived logs of a build", func() {
+			err := NewArchivedBuildLogsClient(k8sClient, kpackClient, archive).Tail(context.Background(), out, "some-image", "2", namespace)
+			require.NoError(t, err)
+			require.Equal(t, "build 2 logs\n", out.String())
+		})
+
+		it("tails the archived logs of a pruned build", func() {
+			err := NewArchivedBuildLogsClient(k8sClient, kpackClient, archive).Tail(context.Background(), out, "some-image", "3", namespace)
+			require.NoError(t, err)
+			require.Equal(t, "build 3 logs\n", out.String())
+		})
+
+		it("tails the archived logs of a pruned build without an image by name", func() {
+			err := NewArchivedBuildLogsClient(k8sClient, kpackClient, archive).TailBuildName(context.Background(), out, namespace, "standalone-build")
+			require.NoError(t, err)
+			require.Equal(t, "standalone build logs\n", out.String())
+		})
+
+		it("tails the archived logs of a build by name", func() {
+			err := NewArchivedBuildLogsClient(k8sClient, kpackClient, archive).TailBuildName(context.Background(), out, namespace, "build-1")
+			require.NoError(t, err)
+			require.Equal(t, "build 1 logs\n", out.String())
+		})
+
+		it("gets the archived logs of every build of an image in order", func() {
+			err := NewArchivedBuildLogsClient(k8sClient, kpackClient, archive).GetImageLogs(context.Background(), out, "some-image", namespace)
+			require.NoError(t, err)
+			require.Equal(t, "build 1 logs\nbuild 2 logs\n", out.String())
+		})
+	})
+
+	when("the build pod exists", func() {
+		it("does not read the archive", func() {
+			k8sClient = k8sfake.NewSimpleClientset(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "build-1-build-pod",
+					Namespace: namespace,
+					Labels: map[string]string{
+						v1alpha1.BuildLabel: "build-1",
+					},
+				},
+			})
+
+			err := NewArchivedBuildLogsClient(k8sClient, kpackClient, archive).GetImageLogs(context.Background(), out, "some-image", namespace)
+			require.NoError(t, err)
+			require.Equal(t, "build 2 logs\n", out.String())
+		})
+	})
+
+	when("the build has not finished", func() {
+		it("does not read the archive", func() {
+			kpackClient = fake.NewSimpleClientset(&v1alpha1.Build{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "build-1",
+					Namespace: namespace,
+					Labels: map[string]string{
+						v1alpha1.ImageLabel: "some-image",
+					},
+				},
+			})
+
+			err := NewArchivedBuildLogsClient(k8sClient, kpackClient, archive).GetImageLogs(context.Background(), out, "some-image", namespace)
+			require.NoError(t, err)
+			require.Empty(t, out.String())
+		})
+	})
+}
@@ -0,0 +1,179 @@
+package logs
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sclient "k8s.io/client-go/kubernetes"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// CreatorPhases are the lifecycle phases the creator runs in order
+var CreatorPhases = []string{"detect", "analyze", "restore", "build", "export"}
+
+var creatorPhaseHeaders = map[string]string{
+	"===> DETECTING": "detect",
+	"===> ANALYZING": "analyze",
+	"===> RESTORING": "restore",
+	"===> BUILDING":  "build",
+	"===> EXPORTING": "export",
+}
+
+// CreatorPhase is a lifecycle phase the creator has started
+type CreatorPhase struct {
+	Name      string
+	StartedAt metav1.Time
+}
+
+// CreatorPhaseReader reads the phases from the logs of creator containers. Phases are cached per pod and
+// container restart, only the logs since the latest phase of a running creator are read again and the
+// logs of a terminated creator are not read again until the pod is forgotten.
+type CreatorPhaseReader struct {
+	K8sClient k8sclient.Interface
+
+	lock   sync.Mutex
+	cached map[types.UID]creatorPhases
+	// stream reads the logs of a pod, the K8sClient is used when it is not set
+	stream func(pod *corev1.Pod, options *corev1.PodLogOptions) (io.ReadCloser, error)
+}
+
+type creatorPhases struct {
+	restartCount int32
+	terminated   bool
+	phases       []CreatorPhase
+}
+
+// Phases returns the lifecycle phases the creator container of a build pod has started
+func (r *CreatorPhaseReader) Phases(pod *corev1.Pod) ([]CreatorPhase, error) {
+	creator := creatorStatus(pod)
+
+	r.lock.Lock()
+	cached, ok := r.cached[pod.UID]
+	r.lock.Unlock()
+	if !ok || cached.restartCount != creator.RestartCount {
+		cached = creatorPhases{restartCount: creator.RestartCount}
+	}
+	if cached.terminated {
+		return cached.phases, nil
+	}
+
+	options := &corev1.PodLogOptions{
+		Container:  v1alpha1.CreateContainerName,
+		Timestamps: true,
+	}
+	if latest := len(cached.phases) - 1; latest >= 0 && !cached.phases[latest].StartedAt.IsZero() {
+		// the time has second precision so the header of the latest phase is read again
+		since := cached.phases[latest].StartedAt
+		options.SinceTime = &since
+	}
+
+	logReadCloser, err := r.streamLogs(pod, options)
+	if err != nil {
+		return nil, err
+	}
+	defer logReadCloser.Close()
+
+	phases, err := parseCreatorPhases(logReadCloser)
+	if err != nil {
+		return nil, err
+	}
+
+	cached.phases = mergeCreatorPhases(cached.phases, phases)
+	cached.terminated = creator.State.Terminated != nil
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.cached == nil {
+		r.cached = map[types.UID]creatorPhases{}
+	}
+	r.cached[pod.UID] = cached
+	return cached.phases, nil
+}
+
+// Forget drops the cached phases of a pod that will not be read again
+func (r *CreatorPhaseReader) Forget(pod *corev1.Pod) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.cached, pod.UID)
+}
+
+func (r *CreatorPhaseReader) streamLogs(pod *corev1.Pod, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	if r.stream != nil {
+		return r.stream(pod, options)
+	}
+	return r.K8sClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, options).Stream()
+}
+
+func creatorStatus(pod *corev1.Pod) corev1.ContainerStatus {
+	for _, s := range pod.Status.InitContainerStatuses {
+		if s.Name == v1alpha1.CreateContainerName {
+			return s
+		}
+	}
+	return corev1.ContainerStatus{}
+}
+
+func mergeCreatorPhases(phases, read []CreatorPhase) []CreatorPhase {
+	merged := append([]CreatorPhase{}, phases...)
+	for _, phase := range read {
+		if !startedPhase(merged, phase.Name) {
+			merged = append(merged, phase)
+		}
+	}
+	return merged
+}
+
+func startedPhase(phases []CreatorPhase, name string) bool {
+	for _, phase := range phases {
+		if phase.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCreatorPhases finds the phase headers in creator logs. Lines are expected to be
+// prefixed with the timestamp kubernetes adds to logs, phases without one have no start time.
+// Headers are at the start of a line so only the first chunk of lines longer than the buffer is read.
+func parseCreatorPhases(reader io.Reader) ([]CreatorPhase, error) {
+	var phases []CreatorPhase
+	bufferedReader := bufio.NewReader(reader)
+	continued := false
+	for {
+		chunk, isPrefix, err := bufferedReader.ReadLine()
+		if err == io.EOF {
+			return phases, nil
+		} else if err != nil {
+			return phases, err
+		}
+
+		if !continued {
+			line := string(chunk)
+			for header, phase := range creatorPhaseHeaders {
+				if strings.Contains(line, header) {
+					phases = append(phases, CreatorPhase{
+						Name:      phase,
+						StartedAt: logTimestamp(line),
+					})
+				}
+			}
+		}
+		continued = isPrefix
+	}
+}
+
+func logTimestamp(line string) metav1.Time {
+	timestamp := strings.SplitN(line, " ", 2)[0]
+	t, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return metav1.Time{}
+	}
+	return metav1.NewTime(t)
+}
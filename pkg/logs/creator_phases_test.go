@@ -0,0 +1,178 @@
+package logs
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreatorPhases(t *testing.T) {
+	spec.Run(t, "Creator Phases", testCreatorPhases)
+}
+
+func testCreatorPhases(t *testing.T, when spec.G, it spec.S) {
+	at := func(timestamp string) metav1.Time {
+		parsed, err := time.Parse(time.RFC3339Nano, timestamp)
+		require.NoError(t, err)
+		return metav1.NewTime(parsed)
+	}
+
+	when("#parseCreatorPhases", func() {
+		it("returns the phases the creator has started", func() {
+			phases, err := parseCreatorPhases(strings.NewReader(`2020-06-01T10:00:00.000000001Z ===> DETECTING
+2020-06-01T10:00:01Z [detector] 6 of 15 buildpacks participating
+2020-06-01T10:00:02Z ===> ANALYZING
+2020-06-01T10:00:03Z [analyzer] Previous image with name "some/image" not found
+2020-06-01T10:00:04Z ===> RESTORING
+2020-06-01T10:00:05Z ===> BUILDING
+2020-06-01T10:00:06Z [builder] Building the app
+`))
+			require.NoError(t, err)
+
+			require.Equal(t, []CreatorPhase{
+				{Name: "detect", StartedAt: at("2020-06-01T10:00:00.000000001Z")},
+				{Name: "analyze", StartedAt: at("2020-06-01T10:00:02Z")},
+				{Name: "restore", StartedAt: at("2020-06-01T10:00:04Z")},
+				{Name: "build", StartedAt: at("2020-06-01T10:00:05Z")},
+			}, phases)
+		})
+
+		it("finds colored phase headers", func() {
+			phases, err := parseCreatorPhases(strings.NewReader("2020-06-01T10:00:00Z \x1b[36m===> DETECTING\x1b[0m\n2020-06-01T10:00:10Z \x1b[36m===> EXPORTING\x1b[0m\n"))
+			require.NoError(t, err)
+
+			require.Equal(t, []CreatorPhase{
+				{Name: "detect", StartedAt: at("2020-06-01T10:00:00Z")},
+				{Name: "export", StartedAt: at("2020-06-01T10:00:10Z")},
+			}, phases)
+		})
+
+		it("returns phases without a start time when lines have no timestamp", func() {
+			phases, err := parseCreatorPhases(strings.NewReader("===> DETECTING\n"))
+			require.NoError(t, err)
+
+			require.Equal(t, []CreatorPhase{{Name: "detect"}}, phases)
+		})
+
+		it("returns no phases before the creator logs", func() {
+			phases, err := parseCreatorPhases(strings.NewReader(""))
+			require.NoError(t, err)
+
+			require.Empty(t, phases)
+		})
+
+		it("finds phase headers after lines longer than the scanner limit", func() {
+			long := "2020-06-01T10:00:01Z [detector] " + strings.Repeat("x", 100*1024) + " ===> ANALYZING\n"
+			phases, err := parseCreatorPhases(strings.NewReader("2020-06-01T10:00:00Z ===> DETECTING\n" + long + "2020-06-01T10:00:02Z ===> ANALYZING\n"))
+			require.NoError(t, err)
+
+			require.Equal(t, []CreatorPhase{
+				{Name: "detect", StartedAt: at("2020-06-01T10:00:00Z")},
+				{Name: "analyze", StartedAt: at("2020-06-01T10:00:02Z")},
+			}, phases)
+		})
+	})
+
+	when("#Phases", func() {
+		var (
+			logs     string
+			requests []*corev1.PodLogOptions
+		)
+
+		reader := &CreatorPhaseReader{
+			stream: func(_ *corev1.Pod, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+				requests = append(requests, options)
+				return ioutil.NopCloser(strings.NewReader(logs)), nil
+			},
+		}
+
+		creatorPod := func(state corev1.ContainerState, restartCount int32) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-pod", Namespace: "some-namespace", UID: "some-uid"},
+				Status: corev1.PodStatus{
+					InitContainerStatuses: []corev1.ContainerStatus{
+						{Name: "prepare", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}},
+						{Name: "create", State: state, RestartCount: restartCount},
+					},
+				},
+			}
+		}
+		running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+		terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}
+
+		it.Before(func() {
+			requests = nil
+			reader.Forget(creatorPod(running, 0))
+		})
+
+		it("only reads the logs since the latest phase of a running creator", func() {
+			logs = "2020-06-01T10:00:00Z ===> DETECTING\n2020-06-01T10:00:02Z ===> ANALYZING\n"
+			phases, err := reader.Phases(creatorPod(running, 0))
+			require.NoError(t, err)
+			require.Len(t, phases, 2)
+
+			logs = "2020-06-01T10:00:02Z ===> ANALYZING\n2020-06-01T10:00:04Z ===> RESTORING\n"
+			phases, err = reader.Phases(creatorPod(running, 0))
+			require.NoError(t, err)
+
+			require.Equal(t, []CreatorPhase{
+				{Name: "detect", StartedAt: at("2020-06-01T10:00:00Z")},
+				{Name: "analyze", StartedAt: at("2020-06-01T10:00:02Z")},
+				{Name: "restore", StartedAt: at("2020-06-01T10:00:04Z")},
+			}, phases)
+			require.Len(t, requests, 2)
+			require.Nil(t, requests[0].SinceTime)
+			require.Equal(t, "create", requests[1].Container)
+			require.True(t, requests[1].Timestamps)
+			require.Equal(t, at("2020-06-01T10:00:02Z"), *requests[1].SinceTime)
+		})
+
+		it("does not read the logs of a terminated creator again", func() {
+			logs = "2020-06-01T10:00:00Z ===> DETECTING\n2020-06-01T10:00:10Z ===> EXPORTING\n"
+			_, err := reader.Phases(creatorPod(terminated, 0))
+			require.NoError(t, err)
+
+			phases, err := reader.Phases(creatorPod(terminated, 0))
+			require.NoError(t, err)
+
+			require.Len(t, requests, 1)
+			require.Equal(t, []CreatorPhase{
+				{Name: "detect", StartedAt: at("2020-06-01T10:00:00Z")},
+				{Name: "export", StartedAt: at("2020-06-01T10:00:10Z")},
+			}, phases)
+		})
+
+		it("reads the logs of a restarted creator from the start", func() {
+			logs = "2020-06-01T10:00:00Z ===> DETECTING\n2020-06-01T10:00:10Z ===> EXPORTING\n"
+			_, err := reader.Phases(creatorPod(terminated, 0))
+			require.NoError(t, err)
+
+			logs = "2020-06-01T10:01:00Z ===> DETECTING\n"
+			phases, err := reader.Phases(creatorPod(running, 1))
+			require.NoError(t, err)
+
+			require.Len(t, requests, 2)
+			require.Nil(t, requests[1].SinceTime)
+			require.Equal(t, []CreatorPhase{{Name: "detect", StartedAt: at("2020-06-01T10:01:00Z")}}, phases)
+		})
+
+		it("reads the logs again once the pod is forgotten", func() {
+			logs = "2020-06-01T10:00:00Z ===> DETECTING\n"
+			_, err := reader.Phases(creatorPod(terminated, 0))
+			require.NoError(t, err)
+
+			reader.Forget(creatorPod(terminated, 0))
+			_, err = reader.Phases(creatorPod(terminated, 0))
+			require.NoError(t, err)
+
+			require.Len(t, requests, 2)
+		})
+	})
+}
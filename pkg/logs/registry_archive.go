@@ -0,0 +1,118 @@
+package logs
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
+)
+
+const LogsMediaType types.MediaType = "application/vnd.kpack.build.logs.v1+text"
+
+// RegistryArchive stores build logs as an OCI artifact in the repository of the build's image
+type RegistryArchive struct {
+	KeychainFactory registry.KeychainFactory
+	// KpackClient locates the repository and service account of the Image, or of a Build without an Image, whose logs are read
+	KpackClient versioned.Interface
+}
+
+func (a *RegistryArchive) Put(build *v1alpha1.Build, logs []byte) (string, error) {
+	tag, keychain, err := a.tag(BuildArchiveKey(build), build.Tag(), build.ServiceAccount())
+	if err != nil {
+		return "", err
+	}
+
+	artifact, err := mutate.Append(mutate.MediaType(empty.Image, types.OCIManifestSchema1), mutate.Addendum{
+		Layer: imagehelpers.NewBlobLayer(logs, LogsMediaType),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return tag.Name(), errors.Wrapf(remote.Write(tag, artifact, remote.WithAuthFromKeychain(keychain)), "unable to push %s", tag.Name())
+}
+
+func (a *RegistryArchive) Get(key ArchiveKey) ([]byte, error) {
+	repository, serviceAccount, err := a.repository(key)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, keychain, err := a.tag(key, repository, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	artifact, err := remote.Image(tag, remote.WithAuthFromKeychain(keychain))
+	if transportError, ok := err.(*transport.Error); ok && transportError.StatusCode == http.StatusNotFound {
+		return nil, ErrNotArchived
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "unable to fetch %s", tag.Name())
+	}
+
+	layers, err := artifact.Layers()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(layers) != 1 {
+		return nil, errors.Errorf("%s is not a build logs artifact", tag.Name())
+	}
+
+	rc, err := layers[0].Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return ioutil.ReadAll(rc)
+}
+
+// repository is the image and service account of the Image the logs belong to, the archived logs of its pruned builds stay readable
+func (a *RegistryArchive) repository(key ArchiveKey) (string, string, error) {
+	if key.Image == "" {
+		build, err := a.KpackClient.KpackV1alpha1().Builds(key.Namespace).Get(key.Build, metav1.GetOptions{})
+		if err != nil {
+			return "", "", errors.Wrapf(err, "unable to locate the repository of build %s", key.Build)
+		}
+		return build.Tag(), build.ServiceAccount(), nil
+	}
+
+	image, err := a.KpackClient.KpackV1alpha1().Images(key.Namespace).Get(key.Image, metav1.GetOptions{})
+	if err != nil {
+		return "", "", errors.Wrapf(err, "unable to locate the repository of image %s", key.Image)
+	}
+	return image.Spec.Tag, image.Spec.ServiceAccount, nil
+}
+
+// tag is the <namespace>.<image>.<build-number>.logs or <namespace>.<build>.logs tag in the repository of the image
+func (a *RegistryArchive) tag(key ArchiveKey, repository, serviceAccount string) (name.Tag, authn.Keychain, error) {
+	ref, err := name.ParseReference(repository, name.WeakValidation)
+	if err != nil {
+		return name.Tag{}, nil, err
+	}
+
+	keychain, err := a.KeychainFactory.KeychainForSecretRef(registry.SecretRef{
+		ServiceAccount: serviceAccount,
+		Namespace:      key.Namespace,
+	})
+	if err != nil {
+		return name.Tag{}, nil, err
+	}
+
+	return ref.Context().Tag(strings.ReplaceAll(strings.TrimSuffix(key.path(), ".log"), "/", ".") + ".logs"), keychain, nil
+}
@@ -0,0 +1,103 @@
+package logs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	kpackregistry "github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/registry/registryfakes"
+)
+
+func TestRegistryArchive(t *testing.T) {
+	spec.Run(t, "Registry Archive", testRegistryArchive)
+}
+
+func testRegistryArchive(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server  *httptest.Server
+		repo    string
+		build   *v1alpha1.Build
+		archive *RegistryArchive
+	)
+
+	it.Before(func() {
+		server = httptest.NewServer(registry.New(registry.Logger(log.New(ioutil.Discard, "", 0))))
+		u, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		repo = fmt.Sprintf("%s/some/app", u.Host)
+
+		build = &v1alpha1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-build",
+				Namespace: "some-namespace",
+				Labels: map[string]string{
+					v1alpha1.ImageLabel:       "some-image",
+					v1alpha1.BuildNumberLabel: "3",
+				},
+			},
+			Spec: v1alpha1.BuildSpec{
+				Tags:           []string{repo + ":latest", repo + ":other"},
+				ServiceAccount: "some-service-account",
+			},
+		}
+
+		keychainFactory := &registryfakes.FakeKeychainFactory{}
+		keychainFactory.AddKeychainForSecretRef(t, kpackregistry.SecretRef{
+			ServiceAccount: "some-service-account",
+			Namespace:      "some-namespace",
+		}, &registryfakes.FakeKeychain{})
+
+		kpackClient := fake.NewSimpleClientset(&v1alpha1.Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-image",
+				Namespace: "some-namespace",
+			},
+			Spec: v1alpha1.ImageSpec{
+				Tag:            repo,
+				ServiceAccount: "some-service-account",
+			},
+		})
+
+		archive = &RegistryArchive{KeychainFactory: keychainFactory, KpackClient: kpackClient}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	it("pushes the logs to a tag next to the build's image", func() {
+		location, err := archive.Put(build, []byte("some logs"))
+		require.NoError(t, err)
+		require.Equal(t, repo+":some-namespace.some-image.3.logs", location)
+	})
+
+	it("reads the logs of a pruned build through its image", func() {
+		_, err := archive.Put(build, []byte("some logs"))
+		require.NoError(t, err)
+
+		logs, err := archive.Get(ArchiveKey{Namespace: "some-namespace", Image: "some-image", BuildNumber: "3"})
+		require.NoError(t, err)
+		require.Equal(t, "some logs", string(logs))
+	})
+
+	it("returns ErrNotArchived for builds without archived logs", func() {
+		_, err := archive.Get(BuildArchiveKey(build))
+		require.Equal(t, ErrNotArchived, err)
+	})
+
+	it("errors when the image is gone", func() {
+		_, err := archive.Get(ArchiveKey{Namespace: "some-namespace", Image: "other-image", BuildNumber: "1"})
+		require.EqualError(t, err, `unable to locate the repository of image other-image: images.kpack.io "other-image" not found`)
+	})
+}
@@ -0,0 +1,135 @@
+package logs
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+const defaultS3Region = "us-east-1"
+
+// S3Archive stores build logs as objects in a bucket of an S3 compatible store such as minio
+type S3Archive struct {
+	// Endpoint is the url of the store, objects are addressed path style as <endpoint>/<bucket>/<key>
+	Endpoint        string
+	Bucket          string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	Client          *http.Client
+}
+
+func (a *S3Archive) Put(build *v1alpha1.Build, logs []byte) (string, error) {
+	key := BuildArchiveKey(build).path()
+	resp, err := a.do(http.MethodPut, key, logs)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("unexpected status code %d uploading %s", resp.StatusCode, a.url(key))
+	}
+	return a.url(key), nil
+}
+
+func (a *S3Archive) Get(key ArchiveKey) ([]byte, error) {
+	resp, err := a.do(http.MethodGet, key.path(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return ioutil.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, ErrNotArchived
+	default:
+		return nil, errors.Errorf("unexpected status code %d downloading %s", resp.StatusCode, a.url(key.path()))
+	}
+}
+
+func (a *S3Archive) url(key string) string {
+	return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(a.Endpoint, "/"), a.Bucket, key)
+}
+
+func (a *S3Archive) do(method, key string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, a.url(key), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if a.AccessKeyID != "" {
+		a.sign(req, body)
+	}
+
+	client := a.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return client.Do(req)
+}
+
+// sign adds an AWS signature version 4 authorization header to the request
+func (a *S3Archive) sign(req *http.Request, body []byte) {
+	timestamp := time.Now().UTC()
+	date := timestamp.Format("20060102")
+	amzDate := timestamp.Format("20060102T150405Z")
+
+	region := a.Region
+	if region == "" {
+		region = defaultS3Region
+	}
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	req.Header.Set("x-amz-date", amzDate)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n", req.URL.Host, payloadHash, amzDate),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+a.SecretAccessKey), date)
+	for _, part := range []string{region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		a.AccessKeyID, scope, signedHeaders, hex.EncodeToString(hmacSHA256(key, stringToSign))))
+}
+
+func sha256Hex(b []byte) string {
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,117 @@
+package logs
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+func TestS3Archive(t *testing.T) {
+	spec.Run(t, "S3 Archive", testS3Archive)
+}
+
+func testS3Archive(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server   *httptest.Server
+		objects  map[string][]byte
+		requests []*http.Request
+		archive  *S3Archive
+
+		build = &v1alpha1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-build",
+				Namespace: "some-namespace",
+				Labels: map[string]string{
+					v1alpha1.ImageLabel:       "some-image",
+					v1alpha1.BuildNumberLabel: "3",
+				},
+			},
+		}
+	)
+
+	it.Before(func() {
+		objects = map[string][]byte{}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			switch r.Method {
+			case http.MethodPut:
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				objects[r.URL.Path] = body
+			case http.MethodGet:
+				body, ok := objects[r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write(body)
+			}
+		}))
+
+		archive = &S3Archive{
+			Endpoint:        server.URL,
+			Bucket:          "build-logs",
+			AccessKeyID:     "some-access-key",
+			SecretAccessKey: "some-secret-key",
+		}
+	})
+
+	it.After(func() {
+		server.Close()
+	})
+
+	it("stores the logs as an object below the namespace and image prefix", func() {
+		location, err := archive.Put(build, []byte("some logs"))
+		require.NoError(t, err)
+		require.Equal(t, server.URL+"/build-logs/some-namespace/some-image/3.log", location)
+		require.Equal(t, "some logs", string(objects["/build-logs/some-namespace/some-image/3.log"]))
+
+		logs, err := archive.Get(BuildArchiveKey(build))
+		require.NoError(t, err)
+		require.Equal(t, "some logs", string(logs))
+	})
+
+	it("signs requests with the access key", func() {
+		_, err := archive.Put(build, []byte("some logs"))
+		require.NoError(t, err)
+
+		require.Len(t, requests, 1)
+		require.Regexp(t, regexp.MustCompile(`^AWS4-HMAC-SHA256 Credential=some-access-key/\d{8}/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=[a-f0-9]{64}$`), requests[0].Header.Get("Authorization"))
+		require.Equal(t, sha256Hex([]byte("some logs")), requests[0].Header.Get("x-amz-content-sha256"))
+		require.Regexp(t, regexp.MustCompile(`^\d{8}T\d{6}Z$`), requests[0].Header.Get("x-amz-date"))
+	})
+
+	it("does not sign requests without an access key", func() {
+		archive.AccessKeyID = ""
+
+		_, err := archive.Put(build, []byte("some logs"))
+		require.NoError(t, err)
+
+		require.Len(t, requests, 1)
+		require.Empty(t, requests[0].Header.Get("Authorization"))
+	})
+
+	it("returns ErrNotArchived for builds without archived logs", func() {
+		_, err := archive.Get(BuildArchiveKey(build))
+		require.Equal(t, ErrNotArchived, err)
+	})
+
+	it("errors when the store rejects the upload", func() {
+		forbidden := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer forbidden.Close()
+		archive.Endpoint = forbidden.URL
+
+		_, err := archive.Put(build, []byte("some logs"))
+		require.EqualError(t, err, "unexpected status code 403 uploading "+archive.Endpoint+"/build-logs/some-namespace/some-image/3.log")
+	})
+}
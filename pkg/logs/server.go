@@ -0,0 +1,209 @@
+package logs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	k8sclient "k8s.io/client-go/kubernetes"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+const (
+	buildsResource = "builds"
+	imagesResource = "images"
+
+	// OffsetParam resumes a stream after the events a client already received
+	OffsetParam = "offset"
+)
+
+// Server streams the step logs of a Build or of every build of an Image as server-sent events.
+// Requests authenticate with a bearer token and must be allowed to get the Build or Image.
+type Server struct {
+	K8sClient k8sclient.Interface
+	Logger    *zap.SugaredLogger
+}
+
+// ServeHTTP serves GET /namespaces/<namespace>/builds/<name>/logs and GET /namespaces/<namespace>/images/<name>/logs
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	namespace, resource, name, ok := parseLogsPath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	offset, err := resumeOffset(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	user, ok, err := s.authenticate(r)
+	if err != nil {
+		s.Logger.Errorw("Error authenticating log request", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !ok {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	allowed, err := s.authorize(user, namespace, resource, name)
+	if err != nil {
+		s.Logger.Errorw("Error authorizing log request", zap.Error(err))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	} else if !allowed {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	events := &eventWriter{writer: w, flusher: flusher, offset: offset}
+	logsClient := NewBuildLogsClient(s.K8sClient)
+	if resource == buildsResource {
+		err = logsClient.TailBuildSteps(r.Context(), events, namespace, name)
+	} else {
+		err = logsClient.TailImageSteps(r.Context(), events, name, namespace)
+	}
+	if err != nil {
+		s.Logger.Errorw("Error streaming logs", zap.String("namespace", namespace), zap.String(resource, name), zap.Error(err))
+		events.writeEvent("error", err.Error())
+		return
+	}
+
+	events.writeEvent("end", "")
+}
+
+// parseLogsPath splits /namespaces/<namespace>/<builds|images>/<name>/logs
+func parseLogsPath(path string) (string, string, string, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != 5 || parts[0] != "namespaces" || parts[4] != "logs" {
+		return "", "", "", false
+	}
+
+	if parts[2] != buildsResource && parts[2] != imagesResource {
+		return "", "", "", false
+	}
+	return parts[1], parts[2], parts[3], true
+}
+
+// resumeOffset is the id of the first event to send, taken from the offset parameter or the Last-Event-ID an event source reconnects with
+func resumeOffset(r *http.Request) (int, error) {
+	if offset := r.URL.Query().Get(OffsetParam); offset != "" {
+		parsed, err := strconv.Atoi(offset)
+		if err != nil || parsed < 0 {
+			return 0, fmt.Errorf("invalid %s %q", OffsetParam, offset)
+		}
+		return parsed, nil
+	}
+
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		parsed, err := strconv.Atoi(lastEventID)
+		if err != nil || parsed < 0 {
+			return 0, fmt.Errorf("invalid Last-Event-ID %q", lastEventID)
+		}
+		return parsed + 1, nil
+	}
+	return 0, nil
+}
+
+func (s *Server) authenticate(r *http.Request) (authenticationv1.UserInfo, bool, error) {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		return authenticationv1.UserInfo{}, false, nil
+	}
+
+	review, err := s.K8sClient.AuthenticationV1().TokenReviews().Create(&authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: strings.TrimPrefix(authorization, "Bearer "),
+		},
+	})
+	if err != nil {
+		return authenticationv1.UserInfo{}, false, err
+	}
+
+	return review.Status.User, review.Status.Authenticated, nil
+}
+
+func (s *Server) authorize(user authenticationv1.UserInfo, namespace, resource, name string) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for key, value := range user.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+
+	review, err := s.K8sClient.AuthorizationV1().SubjectAccessReviews().Create(&authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      "get",
+				Group:     v1alpha1.SchemeGroupVersion.Group,
+				Resource:  resource,
+				Name:      name,
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return review.Status.Allowed, nil
+}
+
+// eventWriter writes each step log line as a log event whose id is its position in the stream
+type eventWriter struct {
+	writer  http.ResponseWriter
+	flusher http.Flusher
+	offset  int
+	next    int
+}
+
+func (e *eventWriter) WriteStepLog(line StepLogLine) error {
+	id := e.next
+	e.next++
+	if id < e.offset {
+		return nil
+	}
+
+	data, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(e.writer, "id: %d\nevent: log\ndata: %s\n\n", id, data); err != nil {
+		return err
+	}
+	e.flusher.Flush()
+	return nil
+}
+
+func (e *eventWriter) writeEvent(event, data string) {
+	_, _ = fmt.Fprintf(e.writer, "event: %s\ndata: %s\n\n", event, strings.ReplaceAll(data, "\n", " "))
+	e.flusher.Flush()
+}
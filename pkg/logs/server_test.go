@@ -0,0 +1,165 @@
+package logs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+)
+
+func TestServer(t *testing.T) {
+	spec.Run(t, "Server", testServer)
+}
+
+func testServer(t *testing.T, when spec.G, it spec.S) {
+	var (
+		k8sClient     = k8sfake.NewSimpleClientset()
+		server        = &Server{K8sClient: k8sClient, Logger: zap.NewNop().Sugar()}
+		accessReviews []authorizationv1.SubjectAccessReviewSpec
+		allowed       = true
+	)
+
+	k8sClient.PrependReactor("create", "tokenreviews", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		review := action.(clientgotesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token != "some-token" {
+			return true, review, nil
+		}
+
+		review.Status = authenticationv1.TokenReviewStatus{
+			Authenticated: true,
+			User: authenticationv1.UserInfo{
+				Username: "some-user",
+				UID:      "some-uid",
+				Groups:   []string{"some-group"},
+				Extra:    map[string]authenticationv1.ExtraValue{"some-key": {"some-value"}},
+			},
+		}
+		return true, review, nil
+	})
+
+	k8sClient.PrependReactor("create", "subjectaccessreviews", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		review := action.(clientgotesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		accessReviews = append(accessReviews, review.Spec)
+		review.Status.Allowed = allowed
+		return true, review, nil
+	})
+
+	serve := func(method, target, token string) *httptest.ResponseRecorder {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		req := httptest.NewRequest(method, target, nil).WithContext(ctx)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	it("streams build logs as server-sent events to users allowed to get the build", func() {
+		rec := serve(http.MethodGet, "/namespaces/some-namespace/builds/some-build/logs", "some-token")
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+
+		require.Equal(t, []authorizationv1.SubjectAccessReviewSpec{
+			{
+				User:   "some-user",
+				UID:    "some-uid",
+				Groups: []string{"some-group"},
+				Extra:  map[string]authorizationv1.ExtraValue{"some-key": {"some-value"}},
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: "some-namespace",
+					Verb:      "get",
+					Group:     "kpack.io",
+					Resource:  "builds",
+					Name:      "some-build",
+				},
+			},
+		}, accessReviews)
+	})
+
+	it("checks access to the image when streaming image logs", func() {
+		rec := serve(http.MethodGet, "/namespaces/some-namespace/images/some-image/logs", "some-token")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		require.Len(t, accessReviews, 1)
+		require.Equal(t, "images", accessReviews[0].ResourceAttributes.Resource)
+		require.Equal(t, "some-image", accessReviews[0].ResourceAttributes.Name)
+	})
+
+	it("rejects requests without a valid bearer token", func() {
+		rec := serve(http.MethodGet, "/namespaces/some-namespace/builds/some-build/logs", "")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+		require.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+
+		rec = serve(http.MethodGet, "/namespaces/some-namespace/builds/some-build/logs", "other-token")
+		require.Equal(t, http.StatusUnauthorized, rec.Code)
+		require.Empty(t, accessReviews)
+	})
+
+	it("forbids users not allowed to get the build", func() {
+		allowed = false
+
+		rec := serve(http.MethodGet, "/namespaces/some-namespace/builds/some-build/logs", "some-token")
+		require.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	it("returns not found for unknown paths", func() {
+		require.Equal(t, http.StatusNotFound, serve(http.MethodGet, "/namespaces/some-namespace/pods/some-pod/logs", "some-token").Code)
+		require.Equal(t, http.StatusNotFound, serve(http.MethodGet, "/namespaces/some-namespace/builds/some-build", "some-token").Code)
+	})
+
+	it("rejects other methods and invalid offsets", func() {
+		require.Equal(t, http.StatusMethodNotAllowed, serve(http.MethodPost, "/namespaces/some-namespace/builds/some-build/logs", "some-token").Code)
+		require.Equal(t, http.StatusBadRequest, serve(http.MethodGet, "/namespaces/some-namespace/builds/some-build/logs?offset=-1", "some-token").Code)
+	})
+
+	when("#resumeOffset", func() {
+		it("resumes at the offset parameter", func() {
+			offset, err := resumeOffset(httptest.NewRequest(http.MethodGet, "/?offset=12", nil))
+			require.NoError(t, err)
+			require.Equal(t, 12, offset)
+		})
+
+		it("resumes after the last event id of a reconnecting event source", func() {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Last-Event-ID", "12")
+
+			offset, err := resumeOffset(req)
+			require.NoError(t, err)
+			require.Equal(t, 13, offset)
+		})
+	})
+
+	when("eventWriter", func() {
+		it("writes log events numbered from the start of the stream and skips events before the offset", func() {
+			rec := httptest.NewRecorder()
+			events := &eventWriter{writer: rec, flusher: rec, offset: 1}
+
+			require.NoError(t, events.WriteStepLog(StepLogLine{Build: "some-build", Step: "prepare", Timestamp: "2020-06-01T10:00:00Z", Line: "skipped"}))
+			require.NoError(t, events.WriteStepLog(StepLogLine{Build: "some-build", Step: "build", Timestamp: "2020-06-01T10:00:01Z", Line: "Building the app"}))
+			events.writeEvent("end", "")
+
+			require.Equal(t, `id: 1
+event: log
+data: {"build":"some-build","step":"build","timestamp":"2020-06-01T10:00:01Z","line":"Building the app"}
+
+event: end
+data: 
+
+`, rec.Body.String())
+		})
+	})
+}
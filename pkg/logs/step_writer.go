@@ -0,0 +1,75 @@
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// stepWriter receives the logs of each build pod step as they are streamed
+type stepWriter interface {
+	startStep(container readyContainer) error
+	writeLine(container readyContainer, line []byte) error
+	timestamps() bool
+}
+
+// terminalWriter writes the raw logs of each step below a colored step header
+type terminalWriter struct {
+	writer io.Writer
+}
+
+func (w *terminalWriter) startStep(container readyContainer) error {
+	_, err := w.writer.Write([]byte(cyan(fmt.Sprintf("===> %s\n", strings.ToUpper(container.containerName)))))
+	return err
+}
+
+func (w *terminalWriter) writeLine(_ readyContainer, line []byte) error {
+	_, err := w.writer.Write(line)
+	return err
+}
+
+func (w *terminalWriter) timestamps() bool {
+	return false
+}
+
+// StepLogLine is a line logged by a step of a build pod
+type StepLogLine struct {
+	Build     string `json:"build"`
+	Step      string `json:"step"`
+	Timestamp string `json:"timestamp"`
+	Line      string `json:"line"`
+}
+
+type StepLogWriter interface {
+	WriteStepLog(line StepLogLine) error
+}
+
+// stepLogLineWriter splits the timestamp kubernetes prefixes each line with from the logged line
+type stepLogLineWriter struct {
+	writer StepLogWriter
+}
+
+func (w *stepLogLineWriter) startStep(readyContainer) error {
+	return nil
+}
+
+func (w *stepLogLineWriter) writeLine(container readyContainer, line []byte) error {
+	line = bytes.TrimSuffix(line, []byte("\n"))
+
+	timestamp := ""
+	if i := bytes.IndexByte(line, ' '); i != -1 {
+		timestamp, line = string(line[:i]), line[i+1:]
+	}
+
+	return w.writer.WriteStepLog(StepLogLine{
+		Build:     container.buildName,
+		Step:      container.containerName,
+		Timestamp: timestamp,
+		Line:      string(line),
+	})
+}
+
+func (w *stepLogLineWriter) timestamps() bool {
+	return true
+}
@@ -0,0 +1,61 @@
+package logs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStepWriter(t *testing.T) {
+	spec.Run(t, "Step Writer", testStepWriter)
+}
+
+func testStepWriter(t *testing.T, when spec.G, it spec.S) {
+	container := readyContainer{
+		podName:       "some-build-build-pod",
+		buildName:     "some-build",
+		containerName: "build",
+		namespace:     "some-namespace",
+	}
+
+	when("terminalWriter", func() {
+		it("writes the raw lines below a step header", func() {
+			out := &bytes.Buffer{}
+			writer := &terminalWriter{writer: out}
+
+			require.NoError(t, writer.startStep(container))
+			require.NoError(t, writer.writeLine(container, []byte("Building the app\n")))
+
+			require.Equal(t, cyan("===> BUILD\n")+"Building the app\n", out.String())
+			require.False(t, writer.timestamps())
+		})
+	})
+
+	when("stepLogLineWriter", func() {
+		it("splits the timestamp from each line", func() {
+			lines := &recordingStepLogWriter{}
+			writer := &stepLogLineWriter{writer: lines}
+
+			require.NoError(t, writer.startStep(container))
+			require.NoError(t, writer.writeLine(container, []byte("2020-06-01T10:00:00.000000001Z Building the app\n")))
+			require.NoError(t, writer.writeLine(container, []byte("2020-06-01T10:00:01Z \n")))
+
+			require.Equal(t, []StepLogLine{
+				{Build: "some-build", Step: "build", Timestamp: "2020-06-01T10:00:00.000000001Z", Line: "Building the app"},
+				{Build: "some-build", Step: "build", Timestamp: "2020-06-01T10:00:01Z", Line: ""},
+			}, lines.lines)
+			require.True(t, writer.timestamps())
+		})
+	})
+}
+
+type recordingStepLogWriter struct {
+	lines []StepLogLine
+}
+
+func (r *recordingStepLogWriter) WriteStepLog(line StepLogLine) error {
+	r.lines = append(r.lines, line)
+	return nil
+}
@@ -0,0 +1,31 @@
+package logs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+// VolumeArchive stores build logs as files below a directory, usually the mount of a PersistentVolumeClaim
+type VolumeArchive struct {
+	Dir string
+}
+
+func (a *VolumeArchive) Put(build *v1alpha1.Build, logs []byte) (string, error) {
+	file := filepath.Join(a.Dir, filepath.FromSlash(BuildArchiveKey(build).path()))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return "", err
+	}
+
+	return file, ioutil.WriteFile(file, logs, 0644)
+}
+
+func (a *VolumeArchive) Get(key ArchiveKey) ([]byte, error) {
+	logs, err := ioutil.ReadFile(filepath.Join(a.Dir, filepath.FromSlash(key.path())))
+	if os.IsNotExist(err) {
+		return nil, ErrNotArchived
+	}
+	return logs, err
+}
@@ -0,0 +1,217 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"knative.dev/pkg/metrics"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+const (
+	BuildStarted   = "started"
+	BuildSucceeded = "succeeded"
+	BuildFailed    = "failed"
+
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+var (
+	namespaceKey  = tag.MustNewKey("namespace")
+	reasonKey     = tag.MustNewKey("reason")
+	builderKey    = tag.MustNewKey("builder")
+	resultKey     = tag.MustNewKey("result")
+	stepKey       = tag.MustNewKey("step")
+	sourceTypeKey = tag.MustNewKey("source_type")
+	operationKey  = tag.MustNewKey("operation")
+	kindKey       = tag.MustNewKey("kind")
+
+	buildCountM = stats.Int64(
+		"build_count",
+		"Number of builds started, succeeded and failed",
+		stats.UnitDimensionless)
+	buildStepDurationM = stats.Float64(
+		"build_step_duration_seconds",
+		"Duration of each step of finished builds",
+		stats.UnitSeconds)
+	pendingBuildsM = stats.Int64(
+		"pending_builds",
+		"Number of builds waiting for their first step to start",
+		stats.UnitDimensionless)
+	sourceResolveLatencyM = stats.Float64(
+		"source_resolve_latency_seconds",
+		"Latency of polling the source of a SourceResolver",
+		stats.UnitSeconds)
+	sourceResolveErrorCountM = stats.Int64(
+		"source_resolve_error_count",
+		"Number of failures polling the source of a SourceResolver",
+		stats.UnitDimensionless)
+	registryRequestLatencyM = stats.Float64(
+		"registry_request_latency_seconds",
+		"Latency of the registry calls made by the registry client",
+		stats.UnitSeconds)
+	builderCreateDurationM = stats.Float64(
+		"builder_create_duration_seconds",
+		"Duration of creating a Builder or ClusterBuilder image",
+		stats.UnitSeconds)
+
+	durationBuckets = metrics.Buckets125(0.1, 10000)
+	latencyBuckets  = metrics.Buckets125(0.01, 100)
+)
+
+// Views are the kpack metrics served on the observability endpoint configured by config-observability
+var Views = []*view.View{
+	{
+		Description: buildCountM.Description(),
+		Measure:     buildCountM,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{namespaceKey, reasonKey, builderKey, resultKey},
+	},
+	{
+		Description: buildStepDurationM.Description(),
+		Measure:     buildStepDurationM,
+		Aggregation: view.Distribution(durationBuckets...),
+		TagKeys:     []tag.Key{namespaceKey, stepKey},
+	},
+	{
+		Description: pendingBuildsM.Description(),
+		Measure:     pendingBuildsM,
+		Aggregation: view.LastValue(),
+		TagKeys:     []tag.Key{namespaceKey},
+	},
+	{
+		Description: sourceResolveLatencyM.Description(),
+		Measure:     sourceResolveLatencyM,
+		Aggregation: view.Distribution(latencyBuckets...),
+		TagKeys:     []tag.Key{sourceTypeKey},
+	},
+	{
+		Description: sourceResolveErrorCountM.Description(),
+		Measure:     sourceResolveErrorCountM,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{namespaceKey, sourceTypeKey},
+	},
+	{
+		Name:        "registry_request_count",
+		Description: "Number of registry calls made by the registry client",
+		Measure:     registryRequestLatencyM,
+		Aggregation: view.Count(),
+		TagKeys:     []tag.Key{operationKey, resultKey},
+	},
+	{
+		Description: registryRequestLatencyM.Description(),
+		Measure:     registryRequestLatencyM,
+		Aggregation: view.Distribution(latencyBuckets...),
+		TagKeys:     []tag.Key{operationKey},
+	},
+	{
+		Description: builderCreateDurationM.Description(),
+		Measure:     builderCreateDurationM,
+		Aggregation: view.Distribution(durationBuckets...),
+		TagKeys:     []tag.Key{kindKey, resultKey},
+	},
+}
+
+// RegisterViews registers the kpack views with the exporter
+func RegisterViews() error {
+	return view.Register(Views...)
+}
+
+// RecordBuild counts a build that started, succeeded or failed. The step durations of finished builds are recorded.
+func RecordBuild(build *v1alpha1.Build, result string) {
+	ctx, err := tag.New(context.Background(),
+		tag.Insert(namespaceKey, build.Namespace),
+		tag.Insert(reasonKey, build.BuildReason()),
+		tag.Insert(builderKey, builderRepository(build.Spec.Builder.Image)),
+		tag.Insert(resultKey, result))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, buildCountM.M(1))
+
+	if result == BuildStarted {
+		return
+	}
+
+	for _, step := range build.Status.Steps {
+		if step.Duration == nil {
+			continue
+		}
+
+		ctx, err := tag.New(context.Background(), tag.Insert(namespaceKey, build.Namespace), tag.Insert(stepKey, step.Name))
+		if err != nil {
+			continue
+		}
+		metrics.Record(ctx, buildStepDurationM.M(step.Duration.Seconds()))
+	}
+}
+
+// RecordPendingBuilds reports the number of builds waiting to start in a namespace
+func RecordPendingBuilds(namespace string, pending int) {
+	ctx, err := tag.New(context.Background(), tag.Insert(namespaceKey, namespace))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, pendingBuildsM.M(int64(pending)))
+}
+
+// RecordSourceResolve records the latency of polling a source and counts the failures
+func RecordSourceResolve(namespace, sourceType string, latency time.Duration, resolveErr error) {
+	ctx, err := tag.New(context.Background(), tag.Insert(sourceTypeKey, sourceType))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, sourceResolveLatencyM.M(latency.Seconds()))
+
+	if resolveErr == nil {
+		return
+	}
+
+	ctx, err = tag.New(ctx, tag.Insert(namespaceKey, namespace))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, sourceResolveErrorCountM.M(1))
+}
+
+// RecordRegistryRequest records a registry call made by the registry client
+func RecordRegistryRequest(operation string, latency time.Duration, requestErr error) {
+	ctx, err := tag.New(context.Background(), tag.Insert(operationKey, operation), tag.Insert(resultKey, result(requestErr)))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, registryRequestLatencyM.M(latency.Seconds()))
+}
+
+// RecordBuilderCreate records the duration of creating a Builder or ClusterBuilder image
+func RecordBuilderCreate(kind string, duration time.Duration, createErr error) {
+	ctx, err := tag.New(context.Background(), tag.Insert(kindKey, kind), tag.Insert(resultKey, result(createErr)))
+	if err != nil {
+		return
+	}
+	metrics.Record(ctx, builderCreateDurationM.M(duration.Seconds()))
+}
+
+func result(err error) string {
+	if err != nil {
+		return resultError
+	}
+	return resultSuccess
+}
+
+// builderRepository drops the tag or digest from the builder image to keep the builder tag's cardinality low
+func builderRepository(image string) string {
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
@@ -0,0 +1,158 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/metrics"
+	"knative.dev/pkg/metrics/metricstest"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+)
+
+func TestMetrics(t *testing.T) {
+	spec.Run(t, "Metrics", testMetrics)
+}
+
+func testMetrics(t *testing.T, when spec.G, it spec.S) {
+	it.Before(func() {
+		metrics.InitForTesting()
+		view.Unregister(Views...)
+		require.NoError(t, RegisterViews())
+	})
+
+	it.After(func() {
+		view.Unregister(Views...)
+	})
+
+	build := &v1alpha1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-build",
+			Namespace: "some-namespace",
+			Annotations: map[string]string{
+				v1alpha1.BuildReasonAnnotation: "COMMIT,STACK",
+			},
+		},
+		Spec: v1alpha1.BuildSpec{
+			Builder: v1alpha1.BuildBuilderSpec{
+				Image: "some.registry.io:5000/builder:tag@sha256:1234",
+			},
+		},
+		Status: v1alpha1.BuildStatus{
+			Steps: []v1alpha1.BuildStep{
+				{Name: "prepare", Duration: &metav1.Duration{Duration: 2 * time.Second}},
+				{Name: "build", Duration: &metav1.Duration{Duration: 3 * time.Minute}},
+				{Name: "export"},
+			},
+		},
+	}
+
+	when("RecordBuild", func() {
+		it("counts builds by namespace, reason, builder and result", func() {
+			RecordBuild(build, BuildStarted)
+			RecordBuild(build, BuildStarted)
+
+			metricstest.CheckCountData(t, "build_count", map[string]string{
+				"namespace": "some-namespace",
+				"reason":    "COMMIT,STACK",
+				"builder":   "some.registry.io:5000/builder",
+				"result":    "started",
+			}, 2)
+			metricstest.CheckStatsNotReported(t, "build_step_duration_seconds")
+		})
+
+		it("records the duration of each step of a finished build", func() {
+			RecordBuild(build, BuildSucceeded)
+
+			metricstest.CheckCountData(t, "build_count", map[string]string{
+				"namespace": "some-namespace",
+				"reason":    "COMMIT,STACK",
+				"builder":   "some.registry.io:5000/builder",
+				"result":    "succeeded",
+			}, 1)
+			metricstest.CheckStatsReported(t, "build_step_duration_seconds")
+		})
+	})
+
+	it("records source resolve latency and errors", func() {
+		RecordSourceResolve("some-namespace", "git", time.Second, errors.New("some error"))
+
+		metricstest.CheckDistributionData(t, "source_resolve_latency_seconds", map[string]string{"source_type": "git"}, 1, 1, 1)
+		metricstest.CheckCountData(t, "source_resolve_error_count", map[string]string{"namespace": "some-namespace", "source_type": "git"}, 1)
+	})
+
+	it("does not count successful source resolves as errors", func() {
+		RecordSourceResolve("some-namespace", "blob", time.Second, nil)
+
+		metricstest.CheckStatsReported(t, "source_resolve_latency_seconds")
+		metricstest.CheckStatsNotReported(t, "source_resolve_error_count")
+	})
+
+	it("records registry request counts and latency", func() {
+		RecordRegistryRequest("fetch", 2*time.Second, nil)
+
+		metricstest.CheckCountData(t, "registry_request_count", map[string]string{"operation": "fetch", "result": "success"}, 1)
+		metricstest.CheckDistributionData(t, "registry_request_latency_seconds", map[string]string{"operation": "fetch"}, 1, 2, 2)
+	})
+
+	it("records the builder create duration", func() {
+		RecordBuilderCreate("ClusterBuilder", time.Minute, errors.New("some error"))
+
+		metricstest.CheckDistributionData(t, "builder_create_duration_seconds", map[string]string{"kind": "ClusterBuilder", "result": "error"}, 1, 60, 60)
+	})
+
+	when("PendingBuildsReporter", func() {
+		pendingBuild := func(name string) *v1alpha1.Build {
+			return &v1alpha1.Build{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "some-namespace",
+				},
+			}
+		}
+
+		finishedBuild := pendingBuild("finished")
+		finishedBuild.Status.Conditions = corev1alpha1.Conditions{{Type: corev1alpha1.ConditionSucceeded, Status: "True"}}
+
+		runningBuild := pendingBuild("running")
+		runningBuild.Status.Timing = &v1alpha1.BuildTiming{Queued: &metav1.Duration{Duration: time.Second}}
+
+		it("reports the builds that have not started", func() {
+			reporter := &PendingBuildsReporter{
+				BuildLister: buildLister(t, pendingBuild("pending-1"), pendingBuild("pending-2"), finishedBuild, runningBuild),
+			}
+
+			require.NoError(t, reporter.report())
+
+			metricstest.CheckLastValueData(t, "pending_builds", map[string]string{"namespace": "some-namespace"}, 2)
+		})
+
+		it("reports zero once a namespace has no pending builds", func() {
+			reporter := &PendingBuildsReporter{
+				BuildLister: buildLister(t, pendingBuild("pending-1")),
+			}
+			require.NoError(t, reporter.report())
+
+			reporter.BuildLister = buildLister(t, finishedBuild)
+			require.NoError(t, reporter.report())
+
+			metricstest.CheckLastValueData(t, "pending_builds", map[string]string{"namespace": "some-namespace"}, 0)
+		})
+	})
+}
+
+func buildLister(t *testing.T, builds ...*v1alpha1.Build) v1alpha1lister.BuildLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, build := range builds {
+		require.NoError(t, indexer.Add(build))
+	}
+	return v1alpha1lister.NewBuildLister(indexer)
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+)
+
+// PendingBuildsReporter periodically reports the number of builds in each namespace that have not started a step
+type PendingBuildsReporter struct {
+	BuildLister v1alpha1lister.BuildLister
+	Interval    time.Duration
+
+	reported map[string]bool
+}
+
+// Run reports the pending builds every interval until done is closed
+func (r *PendingBuildsReporter) Run(done <-chan struct{}) error {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.report(); err != nil {
+			return err
+		}
+
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *PendingBuildsReporter) report() error {
+	builds, err := r.BuildLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	pending := map[string]int{}
+	for _, build := range builds {
+		if !build.Finished() && build.Status.Timing == nil {
+			pending[build.Namespace]++
+		}
+	}
+
+	// namespaces without pending builds are reported as zero once they were reported
+	for namespace := range r.reported {
+		if _, ok := pending[namespace]; !ok {
+			pending[namespace] = 0
+		}
+	}
+
+	if r.reported == nil {
+		r.reported = map[string]bool{}
+	}
+	for namespace, count := range pending {
+		RecordPendingBuilds(namespace, count)
+		r.reported[namespace] = true
+	}
+	return nil
+}
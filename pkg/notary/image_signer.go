@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/theupdateframework/notary/cryptoservice"
 	"github.com/theupdateframework/notary/storage"
 	"github.com/theupdateframework/notary/trustmanager"
+	"github.com/theupdateframework/notary/trustpinning"
 	"github.com/theupdateframework/notary/tuf/data"
 	"github.com/theupdateframework/notary/tuf/signed"
 )
@@ -28,11 +30,28 @@ type ImageFetcher interface {
 }
 
 type RepositoryFactory interface {
-	GetRepository(url string, gun data.GUN, remoteStore storage.RemoteStore, cryptoService signed.CryptoService) (Repository, error)
+	GetRepository(url string, gun data.GUN, remoteStore storage.RemoteStore, cryptoService signed.CryptoService, trustPinning trustpinning.TrustPinConfig) (Repository, error)
 }
 
 type Repository interface {
-	PublishTarget(target *client.Target) error
+	PublishTarget(target *client.Target, role data.RoleName) ([]data.RoleName, error)
+}
+
+// SigningOptions selects the tags to sign, the role signing them and how the trust data is verified
+type SigningOptions struct {
+	// Role is the delegation role that signs, the roles the private key can sign for are used when empty
+	Role data.RoleName
+	// Tags are patterns matching the tags to sign, every tag is signed when empty
+	Tags []string
+	// RootKeyIDs pin the root keys of the trust data, the root is trusted on first use when empty
+	RootKeyIDs []string
+}
+
+// SignedTarget is a tag published to the trust data and the roles that signed it
+type SignedTarget struct {
+	Name   string
+	Digest string
+	Roles  []string
 }
 
 type ImageSigner struct {
@@ -41,16 +60,21 @@ type ImageSigner struct {
 	Factory RepositoryFactory
 }
 
-func (s *ImageSigner) Sign(url, notarySecretDir, reportFilePath string, keychain authn.Keychain) error {
+func (s *ImageSigner) Sign(url, notarySecretDir, reportFilePath string, options SigningOptions, keychain authn.Keychain) ([]SignedTarget, error) {
 	var report lifecycle.ExportReport
 	_, err := toml.DecodeFile(reportFilePath, &report)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	gun, targets, err := s.makeGUNAndTargets(report, keychain)
+	gun, targets, err := s.makeGUNAndTargets(report, options.Tags, keychain)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if len(targets) == 0 {
+		s.Logger.Println("No tags match the notary tags, skipping signing")
+		return nil, nil
 	}
 
 	remoteStore, err := storage.NewNotaryServerStore(
@@ -62,39 +86,68 @@ func (s *ImageSigner) Sign(url, notarySecretDir, reportFilePath string, keychain
 		},
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	cryptoService, err := s.makeCryptoService(notarySecretDir)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	var signedTargets []SignedTarget
 	for _, target := range targets {
-		repo, err := s.Factory.GetRepository(url, gun, remoteStore, cryptoService)
+		repo, err := s.Factory.GetRepository(url, gun, remoteStore, cryptoService, trustPinning(gun, options.RootKeyIDs))
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		err = repo.PublishTarget(target)
+		roles, err := repo.PublishTarget(target, options.Role)
 		if err != nil {
-			return err
+			return nil, err
+		}
+
+		signedTarget := SignedTarget{
+			Name:   target.Name,
+			Digest: report.Image.Digest,
 		}
+		for _, role := range roles {
+			s.Logger.Printf("Signed tag '%s' with role '%s'\n", target.Name, role)
+			signedTarget.Roles = append(signedTarget.Roles, role.String())
+		}
+		signedTargets = append(signedTargets, signedTarget)
 	}
 
-	return nil
+	return signedTargets, nil
 }
 
-func (s *ImageSigner) makeGUNAndTargets(report lifecycle.ExportReport, keychain authn.Keychain) (data.GUN, []*client.Target, error) {
+// trustPinning verifies the root of the trust data against the pinned root keys instead of trusting it on first use
+func trustPinning(gun data.GUN, rootKeyIDs []string) trustpinning.TrustPinConfig {
+	if len(rootKeyIDs) == 0 {
+		return trustpinning.TrustPinConfig{}
+	}
+
+	return trustpinning.TrustPinConfig{
+		Certs:       map[string][]string{gun.String(): rootKeyIDs},
+		DisableTOFU: true,
+	}
+}
+
+func (s *ImageSigner) makeGUNAndTargets(report lifecycle.ExportReport, tagPatterns []string, keychain authn.Keychain) (data.GUN, []*client.Target, error) {
 	gun := data.GUN("")
 	var targets []*client.Target
 	for _, tag := range report.Image.Tags {
-		s.Logger.Printf("Signing tag '%s'\n", tag)
 		ref, err := name.ParseReference(tag, name.WeakValidation)
 		if err != nil {
 			return "", nil, err
 		}
 
+		if !matchesAny(tagPatterns, ref.Identifier()) {
+			s.Logger.Printf("Skipping tag '%s'\n", tag)
+			continue
+		}
+
+		s.Logger.Printf("Signing tag '%s'\n", tag)
+
 		s.Logger.Printf("Pulling image '%s'\n", ref.Context().Name()+"@"+report.Image.Digest)
 		image, _, err := s.Client.Fetch(keychain, ref.Context().Name()+"@"+report.Image.Digest)
 		if err != nil {
@@ -130,6 +183,19 @@ func (s *ImageSigner) makeGUNAndTargets(report lifecycle.ExportReport, keychain
 	return gun, targets, nil
 }
 
+func matchesAny(patterns []string, tag string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, tag); matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *ImageSigner) makeCryptoService(notarySecretDir string) (*cryptoservice.CryptoService, error) {
 	cryptoStore := storage.NewMemoryStore(nil)
 
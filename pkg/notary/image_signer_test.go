@@ -15,6 +15,7 @@ import (
 	"github.com/theupdateframework/notary"
 	notaryclient "github.com/theupdateframework/notary/client"
 	"github.com/theupdateframework/notary/storage"
+	"github.com/theupdateframework/notary/trustpinning"
 	"github.com/theupdateframework/notary/tuf/data"
 	"github.com/theupdateframework/notary/tuf/signed"
 
@@ -63,13 +64,14 @@ func testImageSigner(t *testing.T, when spec.G, it spec.S) {
 			notaryDir := filepath.Join("testdata", "notary")
 			reportPath := filepath.Join("testdata", "report.toml")
 
-			err := signer.Sign("https://example.com/notary", notaryDir, reportPath, keychain)
+			signedTargets, err := signer.Sign("https://example.com/notary", notaryDir, reportPath, SigningOptions{}, keychain)
 			require.NoError(t, err)
 
 			require.Len(t, factory.Calls, 2)
 			for i := range factory.Calls {
 				require.Equal(t, "https://example.com/notary", factory.Calls[i].URL)
 				require.Equal(t, data.GUN("example-registry.io/test"), factory.Calls[i].GUN)
+				require.Equal(t, trustpinning.TrustPinConfig{}, factory.Calls[i].TrustPinning)
 			}
 
 			require.Len(t, factory.FakeRepository.PublishedTargets, 2)
@@ -81,13 +83,93 @@ func testImageSigner(t *testing.T, when spec.G, it spec.S) {
 			require.Equal(t, int64(264), factory.FakeRepository.PublishedTargets[0].Length)
 
 			require.Equal(t, "other-tag", factory.FakeRepository.PublishedTargets[1].Name)
+
+			require.Equal(t, []SignedTarget{
+				{
+					Name:   "latest",
+					Digest: "sha256:a15790640a6690aa1730c38cf0a440e2aa44aaca9b0e8931a9f2b0d7cc90fd65",
+					Roles:  []string{"targets"},
+				},
+				{
+					Name:   "other-tag",
+					Digest: "sha256:a15790640a6690aa1730c38cf0a440e2aa44aaca9b0e8931a9f2b0d7cc90fd65",
+					Roles:  []string{"targets"},
+				},
+			}, signedTargets)
+		})
+
+		it("signs with the requested delegation role", func() {
+			factory.Reset()
+
+			notaryDir := filepath.Join("testdata", "notary")
+			reportPath := filepath.Join("testdata", "report.toml")
+
+			signedTargets, err := signer.Sign("https://example.com/notary", notaryDir, reportPath, SigningOptions{
+				Role: "targets/releases",
+			}, keychain)
+			require.NoError(t, err)
+
+			require.Equal(t, []data.RoleName{"targets/releases", "targets/releases"}, factory.FakeRepository.PublishedRoles)
+			require.Len(t, signedTargets, 2)
+			require.Equal(t, []string{"targets/releases"}, signedTargets[0].Roles)
+		})
+
+		it("only signs the tags matching the tag patterns", func() {
+			factory.Reset()
+
+			notaryDir := filepath.Join("testdata", "notary")
+			reportPath := filepath.Join("testdata", "report.toml")
+
+			signedTargets, err := signer.Sign("https://example.com/notary", notaryDir, reportPath, SigningOptions{
+				Tags: []string{"other-*"},
+			}, keychain)
+			require.NoError(t, err)
+
+			require.Len(t, factory.FakeRepository.PublishedTargets, 1)
+			require.Equal(t, "other-tag", factory.FakeRepository.PublishedTargets[0].Name)
+			require.Len(t, signedTargets, 1)
+			require.Equal(t, "other-tag", signedTargets[0].Name)
+		})
+
+		it("does not sign when no tag matches the tag patterns", func() {
+			factory.Reset()
+
+			notaryDir := filepath.Join("testdata", "notary")
+			reportPath := filepath.Join("testdata", "report.toml")
+
+			signedTargets, err := signer.Sign("https://example.com/notary", notaryDir, reportPath, SigningOptions{
+				Tags: []string{"v*"},
+			}, keychain)
+			require.NoError(t, err)
+
+			require.Empty(t, factory.Calls)
+			require.Empty(t, signedTargets)
+		})
+
+		it("pins the root keys of the trust data", func() {
+			factory.Reset()
+
+			notaryDir := filepath.Join("testdata", "notary")
+			reportPath := filepath.Join("testdata", "report.toml")
+
+			rootKeyID := "9f9d2c8a0e6e1b4d3c2a1f0e9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a3f2e1d0c"
+			_, err := signer.Sign("https://example.com/notary", notaryDir, reportPath, SigningOptions{
+				RootKeyIDs: []string{rootKeyID},
+			}, keychain)
+			require.NoError(t, err)
+
+			require.Len(t, factory.Calls, 2)
+			require.Equal(t, trustpinning.TrustPinConfig{
+				Certs:       map[string][]string{"example-registry.io/test": {rootKeyID}},
+				DisableTOFU: true,
+			}, factory.Calls[0].TrustPinning)
 		})
 
 		it("validates the GUN is uniform for all tags", func() {
 			notaryDir := filepath.Join("testdata", "notary")
 			reportPath := filepath.Join("testdata", "report-multiple-gun.toml")
 
-			err := signer.Sign("https://example.com/notary", notaryDir, reportPath, keychain)
+			_, err := signer.Sign("https://example.com/notary", notaryDir, reportPath, SigningOptions{}, keychain)
 			require.EqualError(t, err, "signing to multiple registries is not supported")
 		})
 
@@ -95,15 +177,16 @@ func testImageSigner(t *testing.T, when spec.G, it spec.S) {
 			notaryDir := filepath.Join("testdata", "notary-no-key")
 			reportPath := filepath.Join("testdata", "report.toml")
 
-			err := signer.Sign("https://example.com/notary", notaryDir, reportPath, keychain)
+			_, err := signer.Sign("https://example.com/notary", notaryDir, reportPath, SigningOptions{}, keychain)
 			require.EqualError(t, err, "failed to find private key")
 		})
 	})
 }
 
 type FakeRepositoryFactoryCall struct {
-	URL string
-	GUN data.GUN
+	URL          string
+	GUN          data.GUN
+	TrustPinning trustpinning.TrustPinConfig
 }
 
 type FakeRepositoryFactory struct {
@@ -116,22 +199,28 @@ func (f *FakeRepositoryFactory) Reset() {
 	f.FakeRepository = nil
 }
 
-func (f *FakeRepositoryFactory) GetRepository(url string, gun data.GUN, _ storage.RemoteStore, _ signed.CryptoService) (Repository, error) {
+func (f *FakeRepositoryFactory) GetRepository(url string, gun data.GUN, _ storage.RemoteStore, _ signed.CryptoService, trustPinning trustpinning.TrustPinConfig) (Repository, error) {
 	if f.FakeRepository == nil {
 		f.FakeRepository = &FakeRepository{}
 	}
 	f.Calls = append(f.Calls, FakeRepositoryFactoryCall{
-		URL: url,
-		GUN: gun,
+		URL:          url,
+		GUN:          gun,
+		TrustPinning: trustPinning,
 	})
 	return f.FakeRepository, nil
 }
 
 type FakeRepository struct {
 	PublishedTargets []*notaryclient.Target
+	PublishedRoles   []data.RoleName
 }
 
-func (f *FakeRepository) PublishTarget(target *notaryclient.Target) error {
+func (f *FakeRepository) PublishTarget(target *notaryclient.Target, role data.RoleName) ([]data.RoleName, error) {
 	f.PublishedTargets = append(f.PublishedTargets, target)
-	return nil
+	f.PublishedRoles = append(f.PublishedRoles, role)
+	if role == "" {
+		role = data.CanonicalTargetsRole
+	}
+	return []data.RoleName{role}, nil
 }
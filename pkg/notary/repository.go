@@ -16,7 +16,7 @@ import (
 type RemoteRepositoryFactory struct {
 }
 
-func (r *RemoteRepositoryFactory) GetRepository(url string, gun data.GUN, remoteStore storage.RemoteStore, cryptoService signed.CryptoService) (Repository, error) {
+func (r *RemoteRepositoryFactory) GetRepository(url string, gun data.GUN, remoteStore storage.RemoteStore, cryptoService signed.CryptoService, trustPinning trustpinning.TrustPinConfig) (Repository, error) {
 	changeListDir, err := ioutil.TempDir("", "")
 	if err != nil {
 		return nil, err
@@ -32,7 +32,7 @@ func (r *RemoteRepositoryFactory) GetRepository(url string, gun data.GUN, remote
 		url,
 		remoteStore,
 		storage.NewMemoryStore(nil),
-		trustpinning.TrustPinConfig{},
+		trustPinning,
 		cryptoService,
 		changeList,
 	)
@@ -47,39 +47,43 @@ type RemoteRepository struct {
 	repo client.Repository
 }
 
-func (r *RemoteRepository) PublishTarget(target *client.Target) error {
-	roles, err := r.getRoles(target)
+// PublishTarget signs the target with the requested delegation role, or with every role the signing key can sign
+// for when no role is requested, and returns the roles that signed it
+func (r *RemoteRepository) PublishTarget(target *client.Target, role data.RoleName) ([]data.RoleName, error) {
+	delegationRoles, err := r.repo.GetDelegationRoles()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = r.repo.AddTarget(target, roles...)
+	roles, err := signingRoles(delegationRoles, r.repo.GetCryptoService().ListAllKeys(), target.Name, role)
 	if err != nil {
-		return err
-
+		return nil, err
 	}
 
-	return r.repo.Publish()
-}
-
-func (r *RemoteRepository) getRoles(target *client.Target) ([]data.RoleName, error) {
-	delegationRoles, err := r.repo.GetDelegationRoles()
+	err = r.repo.AddTarget(target, roles...)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(delegationRoles) == 0 {
+	return roles, r.repo.Publish()
+}
+
+func signingRoles(delegationRoles []data.Role, keys map[string]data.RoleName, targetName string, role data.RoleName) ([]data.RoleName, error) {
+	if role == data.CanonicalTargetsRole || (role == "" && len(delegationRoles) == 0) {
 		return []data.RoleName{data.CanonicalTargetsRole}, nil
 	}
 
-	keys := r.repo.GetCryptoService().ListAllKeys()
 	if len(keys) != 1 {
 		return nil, errors.Errorf("expected exactly one signing key but got %d", len(keys))
 	}
 
 	var roles []data.RoleName
 	for _, delegationRole := range delegationRoles {
-		if path.Dir(delegationRole.Name.String()) != data.CanonicalTargetsRole.String() || !delegationRole.CheckPaths(target.Name) {
+		if role != "" && delegationRole.Name != role {
+			continue
+		}
+
+		if path.Dir(delegationRole.Name.String()) != data.CanonicalTargetsRole.String() || !delegationRole.CheckPaths(targetName) {
 			continue
 		}
 
@@ -91,8 +95,10 @@ func (r *RemoteRepository) getRoles(target *client.Target) ([]data.RoleName, err
 		}
 	}
 
-	if len(roles) == 0 {
-		return []data.RoleName{}, errors.New("no delegation roles found")
+	if len(roles) == 0 && role != "" {
+		return nil, errors.Errorf("delegation role %s cannot sign %s with the private key", role, targetName)
+	} else if len(roles) == 0 {
+		return nil, errors.New("no delegation roles found")
 	}
 
 	return roles, nil
@@ -0,0 +1,84 @@
+package notary
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+	"github.com/theupdateframework/notary/tuf/data"
+)
+
+func TestRepository(t *testing.T) {
+	spec.Run(t, "Test Repository", testRepository)
+}
+
+func testRepository(t *testing.T, when spec.G, it spec.S) {
+	when("#signingRoles", func() {
+		var (
+			keys = map[string]data.RoleName{"some-key-id": data.CanonicalTargetsRole}
+
+			delegationRoles = []data.Role{
+				{
+					RootRole: data.RootRole{KeyIDs: []string{"some-key-id"}},
+					Name:     "targets/releases",
+					Paths:    []string{""},
+				},
+				{
+					RootRole: data.RootRole{KeyIDs: []string{"some-key-id"}},
+					Name:     "targets/ci",
+					Paths:    []string{"ci-"},
+				},
+				{
+					RootRole: data.RootRole{KeyIDs: []string{"other-key-id"}},
+					Name:     "targets/others",
+					Paths:    []string{""},
+				},
+			}
+		)
+
+		it("signs with the targets role when there are no delegations", func() {
+			roles, err := signingRoles(nil, keys, "latest", "")
+			require.NoError(t, err)
+			require.Equal(t, []data.RoleName{data.CanonicalTargetsRole}, roles)
+		})
+
+		it("signs with the targets role when it is requested", func() {
+			roles, err := signingRoles(delegationRoles, keys, "latest", data.CanonicalTargetsRole)
+			require.NoError(t, err)
+			require.Equal(t, []data.RoleName{data.CanonicalTargetsRole}, roles)
+		})
+
+		it("signs with every delegation role the key can sign the target for", func() {
+			roles, err := signingRoles(delegationRoles, keys, "ci-123", "")
+			require.NoError(t, err)
+			require.Equal(t, []data.RoleName{"targets/releases", "targets/ci"}, roles)
+		})
+
+		it("signs with the requested delegation role", func() {
+			roles, err := signingRoles(delegationRoles, keys, "ci-123", "targets/ci")
+			require.NoError(t, err)
+			require.Equal(t, []data.RoleName{"targets/ci"}, roles)
+		})
+
+		it("errors when the requested delegation role cannot sign the target", func() {
+			_, err := signingRoles(delegationRoles, keys, "latest", "targets/ci")
+			require.EqualError(t, err, "delegation role targets/ci cannot sign latest with the private key")
+
+			_, err = signingRoles(delegationRoles, keys, "latest", "targets/others")
+			require.EqualError(t, err, "delegation role targets/others cannot sign latest with the private key")
+		})
+
+		it("errors when no delegation role can sign the target", func() {
+			_, err := signingRoles(delegationRoles, keys, "latest", "")
+			require.NoError(t, err)
+
+			_, err = signingRoles(delegationRoles[1:], keys, "latest", "")
+			require.EqualError(t, err, "no delegation roles found")
+		})
+
+		it("requires exactly one signing key for delegation roles", func() {
+			_, err := signingRoles(delegationRoles, map[string]data.RoleName{}, "latest", "")
+			require.EqualError(t, err, "expected exactly one signing key but got 0")
+		})
+	})
+}
@@ -0,0 +1,88 @@
+package notification
+
+import (
+	"io/ioutil"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	BuildStarted   = "started"
+	BuildSucceeded = "succeeded"
+	BuildFailed    = "failed"
+	BuildRebased   = "rebased"
+)
+
+var eventTypes = []string{BuildStarted, BuildSucceeded, BuildFailed, BuildRebased}
+
+// Config lists the http sinks build lifecycle notifications are delivered to
+type Config struct {
+	Sinks []SinkConfig `json:"sinks,omitempty"`
+}
+
+type SinkConfig struct {
+	URL string `json:"url"`
+	// Events limits the notifications delivered to the sink, every event is delivered if empty
+	Events []string `json:"events,omitempty"`
+	// HMACKeyFile is a file holding the key deliveries are signed with, deliveries are unsigned if empty
+	HMACKeyFile string `json:"hmacKeyFile,omitempty"`
+}
+
+// Sink is a configured sink with its signing key read
+type Sink struct {
+	URL     string
+	Events  []string
+	HMACKey []byte
+}
+
+func (s Sink) accepts(eventType string) bool {
+	if len(s.Events) == 0 {
+		return true
+	}
+
+	for _, e := range s.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadSinks validates the config and reads the signing key of each sink
+func (c Config) ReadSinks() ([]Sink, error) {
+	sinks := make([]Sink, 0, len(c.Sinks))
+	for i, s := range c.Sinks {
+		u, err := url.Parse(s.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, errors.Errorf("sinks[%d]: invalid url %q", i, s.URL)
+		}
+
+		for _, e := range s.Events {
+			if !validEventType(e) {
+				return nil, errors.Errorf("sinks[%d]: unknown event %q, must be one of %s", i, e, strings.Join(eventTypes, ", "))
+			}
+		}
+
+		sink := Sink{URL: s.URL, Events: s.Events}
+		if s.HMACKeyFile != "" {
+			key, err := ioutil.ReadFile(s.HMACKeyFile)
+			if err != nil {
+				return nil, errors.Wrapf(err, "sinks[%d]: unable to read hmac key", i)
+			}
+			sink.HMACKey = []byte(strings.TrimSpace(string(key)))
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+func validEventType(eventType string) bool {
+	for _, e := range eventTypes {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
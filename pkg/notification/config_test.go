@@ -0,0 +1,62 @@
+package notification
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig(t *testing.T) {
+	spec.Run(t, "Config", testConfig)
+}
+
+func testConfig(t *testing.T, when spec.G, it spec.S) {
+	var dir string
+
+	it.Before(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "notification-config")
+		require.NoError(t, err)
+	})
+
+	it.After(func() {
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	it("reads the hmac key of each sink", func() {
+		keyFile := filepath.Join(dir, "key")
+		require.NoError(t, ioutil.WriteFile(keyFile, []byte("some-key\n"), 0600))
+
+		sinks, err := Config{
+			Sinks: []SinkConfig{
+				{URL: "https://some.sink.io/hook", HMACKeyFile: keyFile, Events: []string{BuildFailed}},
+				{URL: "http://other.sink.io"},
+			},
+		}.ReadSinks()
+		require.NoError(t, err)
+
+		require.Equal(t, []Sink{
+			{URL: "https://some.sink.io/hook", Events: []string{BuildFailed}, HMACKey: []byte("some-key")},
+			{URL: "http://other.sink.io"},
+		}, sinks)
+	})
+
+	it("errors on an invalid url", func() {
+		_, err := Config{Sinks: []SinkConfig{{URL: "not-a-url"}}}.ReadSinks()
+		require.EqualError(t, err, `sinks[0]: invalid url "not-a-url"`)
+	})
+
+	it("errors on an unknown event", func() {
+		_, err := Config{Sinks: []SinkConfig{{URL: "https://some.sink.io", Events: []string{"deleted"}}}}.ReadSinks()
+		require.EqualError(t, err, `sinks[0]: unknown event "deleted", must be one of started, succeeded, failed, rebased`)
+	})
+
+	it("errors when the hmac key cannot be read", func() {
+		_, err := Config{Sinks: []SinkConfig{{URL: "https://some.sink.io", HMACKeyFile: filepath.Join(dir, "missing")}}}.ReadSinks()
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,112 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+const (
+	cloudEventsSpecVersion = "1.0"
+	eventTypePrefix        = "io.kpack.build."
+)
+
+// BuildEvent is the data of the cloudevent delivered for a build lifecycle transition
+type BuildEvent struct {
+	Namespace   string          `json:"namespace"`
+	Image       string          `json:"image"`
+	Build       string          `json:"build"`
+	BuildNumber string          `json:"buildNumber"`
+	Reasons     []string        `json:"reasons,omitempty"`
+	Changes     json.RawMessage `json:"changes,omitempty"`
+	LatestImage string          `json:"latestImage,omitempty"`
+	Digest      string          `json:"digest,omitempty"`
+	Revision    string          `json:"revision,omitempty"`
+	Message     string          `json:"message,omitempty"`
+}
+
+// cloudEvent is a binary mode cloudevent, the attributes are sent as ce- headers and the data as the body
+type cloudEvent struct {
+	ID      string
+	Source  string
+	Type    string
+	Subject string
+	Time    time.Time
+	Data    []byte
+}
+
+func newCloudEvent(eventType string, build *v1alpha1.Build) (cloudEvent, error) {
+	data, err := json.Marshal(newBuildEvent(build))
+	if err != nil {
+		return cloudEvent{}, err
+	}
+
+	id := string(build.UID)
+	if id == "" {
+		id = fmt.Sprintf("%s/%s", build.Namespace, build.Name)
+	}
+
+	return cloudEvent{
+		ID:      fmt.Sprintf("%s-%s", id, eventType),
+		Source:  fmt.Sprintf("/apis/%s/namespaces/%s/builds/%s", v1alpha1.SchemeGroupVersion.String(), build.Namespace, build.Name),
+		Type:    eventTypePrefix + eventType,
+		Subject: build.Labels[v1alpha1.ImageLabel],
+		Time:    time.Now().UTC(),
+		Data:    data,
+	}, nil
+}
+
+func newBuildEvent(build *v1alpha1.Build) BuildEvent {
+	event := BuildEvent{
+		Namespace:   build.Namespace,
+		Image:       build.Labels[v1alpha1.ImageLabel],
+		Build:       build.Name,
+		BuildNumber: build.Labels[v1alpha1.BuildNumberLabel],
+		LatestImage: build.Status.LatestImage,
+		Revision:    revision(build),
+	}
+
+	if reasons := build.Annotations[v1alpha1.BuildReasonAnnotation]; reasons != "" {
+		event.Reasons = strings.Split(reasons, ",")
+	}
+
+	if changes := build.Annotations[v1alpha1.BuildChangesAnnotation]; json.Valid([]byte(changes)) {
+		event.Changes = json.RawMessage(changes)
+	}
+
+	if i := strings.LastIndex(build.Status.LatestImage, "@"); i >= 0 {
+		event.Digest = build.Status.LatestImage[i+1:]
+	}
+
+	if condition := build.Status.GetCondition(corev1alpha1.ConditionSucceeded); condition != nil {
+		event.Message = condition.Message
+	}
+	return event
+}
+
+// revision is the source revision that was built: the git commit, the digest of a registry source,
+// the revision or checksum of an artifact and the url of a blob which is only addressed by its location
+func revision(build *v1alpha1.Build) string {
+	source := build.Spec.Source
+	switch {
+	case source.Git != nil:
+		return source.Git.Revision
+	case source.Registry != nil:
+		if i := strings.LastIndex(source.Registry.Image, "@"); i >= 0 {
+			return source.Registry.Image[i+1:]
+		}
+		return source.Registry.Image
+	case source.Artifact != nil:
+		if source.Artifact.Revision != "" {
+			return source.Artifact.Revision
+		}
+		return source.Artifact.Checksum
+	case source.Blob != nil:
+		return source.Blob.URL
+	}
+	return ""
+}
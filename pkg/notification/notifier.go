@@ -0,0 +1,160 @@
+package notification
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+)
+
+const (
+	// SignatureHeader holds the hex encoded hmac-sha256 of the body of a signed delivery as sha256=<signature>
+	SignatureHeader = "X-Kpack-Signature"
+
+	defaultMaxAttempts = 5
+	defaultBackoff     = time.Second
+	defaultQueueSize   = 1000
+	deliveryWorkers    = 2
+)
+
+// Notifier delivers build lifecycle cloudevents to http sinks in the background, retrying failed deliveries with exponential backoff
+type Notifier struct {
+	Sinks       []Sink
+	Client      *http.Client
+	Logger      *zap.SugaredLogger
+	MaxAttempts int
+	Backoff     time.Duration
+
+	queue chan delivery
+}
+
+type delivery struct {
+	sink  Sink
+	event cloudEvent
+}
+
+func NewNotifier(config Config, logger *zap.SugaredLogger) (*Notifier, error) {
+	sinks, err := config.ReadSinks()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Notifier{
+		Sinks:       sinks,
+		Client:      &http.Client{Timeout: 10 * time.Second},
+		Logger:      logger,
+		MaxAttempts: defaultMaxAttempts,
+		Backoff:     defaultBackoff,
+		queue:       make(chan delivery, defaultQueueSize),
+	}, nil
+}
+
+// Notify queues the event for every sink that accepts it, events are dropped when the queue is full
+func (n *Notifier) Notify(eventType string, build *v1alpha1.Build) {
+	event, err := newCloudEvent(eventType, build)
+	if err != nil {
+		n.Logger.Errorw("Error creating build notification", zap.String("build", build.Name), zap.Error(err))
+		return
+	}
+
+	for _, sink := range n.Sinks {
+		if !sink.accepts(eventType) {
+			continue
+		}
+
+		select {
+		case n.queue <- delivery{sink: sink, event: event}:
+		default:
+			n.Logger.Warnw("Dropping build notification, the delivery queue is full", zap.String("build", build.Name), zap.String("sink", sink.URL))
+		}
+	}
+}
+
+// Run delivers queued events until done is closed
+func (n *Notifier) Run(done <-chan struct{}) error {
+	for i := 0; i < deliveryWorkers; i++ {
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case d := <-n.queue:
+					if err := n.deliver(done, d); err != nil {
+						n.Logger.Errorw("Error delivering build notification", zap.String("id", d.event.ID), zap.String("sink", d.sink.URL), zap.Error(err))
+					}
+				}
+			}
+		}()
+	}
+
+	<-done
+	return nil
+}
+
+func (n *Notifier) deliver(done <-chan struct{}, d delivery) error {
+	backoff := n.Backoff
+	var err error
+	for attempt := 1; attempt <= n.MaxAttempts; attempt++ {
+		if err = n.post(d); err == nil {
+			return nil
+		}
+
+		if attempt == n.MaxAttempts {
+			break
+		}
+
+		select {
+		case <-done:
+			return err
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+	return errors.Wrapf(err, "giving up after %d attempts", n.MaxAttempts)
+}
+
+func (n *Notifier) post(d delivery) error {
+	req, err := http.NewRequest(http.MethodPost, d.sink.URL, bytes.NewReader(d.event.Data))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("ce-specversion", cloudEventsSpecVersion)
+	req.Header.Set("ce-id", d.event.ID)
+	req.Header.Set("ce-source", d.event.Source)
+	req.Header.Set("ce-type", d.event.Type)
+	req.Header.Set("ce-time", d.event.Time.Format(time.RFC3339))
+	if d.event.Subject != "" {
+		req.Header.Set("ce-subject", d.event.Subject)
+	}
+
+	if len(d.sink.HMACKey) > 0 {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(d.sink.HMACKey, d.event.Data))
+	}
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign is the hex encoded hmac-sha256 of a delivery body that sinks can compare against the signature header
+func Sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
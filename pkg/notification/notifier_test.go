@@ -0,0 +1,248 @@
+package notification
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+)
+
+func TestNotifier(t *testing.T) {
+	spec.Run(t, "Notifier", testNotifier)
+}
+
+type receivedEvent struct {
+	header http.Header
+	body   []byte
+}
+
+func testNotifier(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server    *httptest.Server
+		mu        sync.Mutex
+		received  []receivedEvent
+		times     []string
+		failFirst int
+		attempts  int
+		done      chan struct{}
+		notifier  *Notifier
+
+		build = &v1alpha1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "some-image-build-2",
+				Namespace: "some-namespace",
+				UID:       "some-uid",
+				Labels: map[string]string{
+					v1alpha1.ImageLabel:       "some-image",
+					v1alpha1.BuildNumberLabel: "2",
+				},
+				Annotations: map[string]string{
+					v1alpha1.BuildReasonAnnotation:  "COMMIT,STACK",
+					v1alpha1.BuildChangesAnnotation: `[{"reason":"COMMIT","old":"abc","new":"def"}]`,
+				},
+			},
+			Spec: v1alpha1.BuildSpec{
+				Source: v1alpha1.SourceConfig{
+					Git: &v1alpha1.Git{
+						URL:      "https://github.com/some/repo",
+						Revision: "def",
+					},
+				},
+			},
+			Status: v1alpha1.BuildStatus{
+				LatestImage: "some.registry.io/some-image@sha256:1234",
+				Status: corev1alpha1.Status{
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionSucceeded,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+			},
+		}
+	)
+
+	it.Before(func() {
+		received = nil
+		times = nil
+		attempts = 0
+		failFirst = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+			attempts++
+			times = append(times, r.Header.Get("ce-time"))
+			if attempts <= failFirst {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			received = append(received, receivedEvent{header: r.Header, body: body})
+		}))
+
+		done = make(chan struct{})
+	})
+
+	it.After(func() {
+		close(done)
+		server.Close()
+	})
+
+	start := func(sinks ...Sink) {
+		notifier = &Notifier{
+			Sinks:       sinks,
+			Client:      server.Client(),
+			Logger:      zap.NewNop().Sugar(),
+			MaxAttempts: 3,
+			Backoff:     time.Millisecond,
+			queue:       make(chan delivery, 10),
+		}
+		go func() {
+			_ = notifier.Run(done)
+		}()
+	}
+
+	receivedEvents := func() []receivedEvent {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedEvent{}, received...)
+	}
+
+	it("delivers a binary mode cloudevent with the build details", func() {
+		start(Sink{URL: server.URL})
+
+		notifier.Notify(BuildSucceeded, build)
+
+		require.Eventually(t, func() bool { return len(receivedEvents()) == 1 }, time.Second, time.Millisecond)
+		event := receivedEvents()[0]
+
+		assert.Equal(t, "1.0", event.header.Get("ce-specversion"))
+		assert.Equal(t, "some-uid-succeeded", event.header.Get("ce-id"))
+		assert.Equal(t, "io.kpack.build.succeeded", event.header.Get("ce-type"))
+		assert.Equal(t, "/apis/kpack.io/v1alpha1/namespaces/some-namespace/builds/some-image-build-2", event.header.Get("ce-source"))
+		assert.Equal(t, "some-image", event.header.Get("ce-subject"))
+		assert.NotEmpty(t, event.header.Get("ce-time"))
+		assert.Equal(t, "application/json", event.header.Get("Content-Type"))
+		assert.Empty(t, event.header.Get(SignatureHeader))
+
+		var data BuildEvent
+		require.NoError(t, json.Unmarshal(event.body, &data))
+		assert.Equal(t, BuildEvent{
+			Namespace:   "some-namespace",
+			Image:       "some-image",
+			Build:       "some-image-build-2",
+			BuildNumber: "2",
+			Reasons:     []string{"COMMIT", "STACK"},
+			Changes:     json.RawMessage(`[{"reason":"COMMIT","old":"abc","new":"def"}]`),
+			LatestImage: "some.registry.io/some-image@sha256:1234",
+			Digest:      "sha256:1234",
+			Revision:    "def",
+		}, data)
+	})
+
+	it("signs deliveries to sinks with an hmac key", func() {
+		start(Sink{URL: server.URL, HMACKey: []byte("some-key")})
+
+		notifier.Notify(BuildStarted, build)
+
+		require.Eventually(t, func() bool { return len(receivedEvents()) == 1 }, time.Second, time.Millisecond)
+		event := receivedEvents()[0]
+
+		assert.Equal(t, "sha256="+Sign([]byte("some-key"), event.body), event.header.Get(SignatureHeader))
+	})
+
+	it("only delivers the events a sink accepts", func() {
+		start(Sink{URL: server.URL, Events: []string{BuildFailed}})
+
+		notifier.Notify(BuildSucceeded, build)
+		notifier.Notify(BuildFailed, build)
+
+		require.Eventually(t, func() bool { return len(receivedEvents()) == 1 }, time.Second, time.Millisecond)
+		assert.Equal(t, "io.kpack.build.failed", receivedEvents()[0].header.Get("ce-type"))
+	})
+
+	it("retries failed deliveries", func() {
+		failFirst = 2
+		start(Sink{URL: server.URL})
+
+		notifier.Notify(BuildRebased, build)
+
+		require.Eventually(t, func() bool { return len(receivedEvents()) == 1 }, time.Second, time.Millisecond)
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, 3, attempts)
+	})
+
+	it("gives up after the max attempts", func() {
+		failFirst = 10
+		start(Sink{URL: server.URL})
+
+		err := notifier.deliver(done, delivery{sink: Sink{URL: server.URL}, event: cloudEvent{ID: "some-id"}})
+		require.EqualError(t, err, "giving up after 3 attempts: unexpected status code 503")
+	})
+
+	it("sends the time the event was created with every attempt", func() {
+		failFirst = 10
+		start(Sink{URL: server.URL})
+
+		created := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+		_ = notifier.deliver(done, delivery{sink: Sink{URL: server.URL}, event: cloudEvent{ID: "some-id", Time: created}})
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, []string{"2021-03-04T05:06:07Z", "2021-03-04T05:06:07Z", "2021-03-04T05:06:07Z"}, times)
+	})
+
+	when("the build source is not git", func() {
+		for _, tc := range []struct {
+			name     string
+			source   v1alpha1.SourceConfig
+			revision string
+		}{
+			{
+				name:     "registry",
+				source:   v1alpha1.SourceConfig{Registry: &v1alpha1.Registry{Image: "some.registry.io/source@sha256:abcd"}},
+				revision: "sha256:abcd",
+			},
+			{
+				name:     "artifact",
+				source:   v1alpha1.SourceConfig{Artifact: &v1alpha1.Artifact{URL: "https://some.host/source.tgz", Revision: "main/1234", Checksum: "sha256:abcd"}},
+				revision: "main/1234",
+			},
+			{
+				name:     "artifact without a revision",
+				source:   v1alpha1.SourceConfig{Artifact: &v1alpha1.Artifact{URL: "https://some.host/source.tgz", Checksum: "sha256:abcd"}},
+				revision: "sha256:abcd",
+			},
+			{
+				name:     "blob",
+				source:   v1alpha1.SourceConfig{Blob: &v1alpha1.Blob{URL: "https://some.host/source.zip"}},
+				revision: "https://some.host/source.zip",
+			},
+		} {
+			tc := tc
+			it("sets the revision of a "+tc.name+" source", func() {
+				b := build.DeepCopy()
+				b.Spec.Source = tc.source
+
+				assert.Equal(t, tc.revision, newBuildEvent(b).Revision)
+			})
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package provenance
+
+import (
+	"log"
+
+	"github.com/BurntSushi/toml"
+	"github.com/buildpacks/lifecycle"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/cosign"
+	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
+)
+
+type Attester struct {
+	Logger *log.Logger
+}
+
+// Attest generates the provenance of the image described by the export report, signs it with the key in
+// the cosign secret directory and pushes it as an attestation to every repository the image was exported to
+func (a *Attester) Attest(reportFilePath, secretDir string, config Config, keychain authn.Keychain) error {
+	var report lifecycle.ExportReport
+	_, err := toml.DecodeFile(reportFilePath, &report)
+	if err != nil {
+		return err
+	}
+
+	repositories, err := cosign.Repositories(report.Image.Tags)
+	if err != nil {
+		return err
+	}
+
+	if len(repositories) == 0 {
+		return errors.New("no tags found in export report")
+	}
+
+	image, err := remote.Image(repositories[0].Digest(report.Image.Digest), remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return errors.Wrapf(err, "unable to fetch %s@%s", repositories[0].Name(), report.Image.Digest)
+	}
+
+	var buildMetadata lifecycle.BuildMetadata
+	if err := imagehelpers.GetLabel(image, lifecycle.BuildMetadataLabel, &buildMetadata); err != nil {
+		return err
+	}
+
+	key, err := cosign.LoadKey(secretDir)
+	if err != nil {
+		return err
+	}
+
+	signer := cosign.ImageSigner{Logger: a.Logger}
+	for _, repository := range repositories {
+		statement, err := Statement(repository.Name(), report.Image.Digest, buildMetadata.Buildpacks, config)
+		if err != nil {
+			return err
+		}
+
+		if err := signer.Attest(key, repository, report.Image.Digest, statement, PredicateType, keychain); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package provenance_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/buildpacks/lifecycle"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/cosign"
+	"github.com/pivotal/kpack/pkg/provenance"
+	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
+)
+
+func TestAttester(t *testing.T) {
+	spec.Run(t, "Attester", testAttester)
+}
+
+func testAttester(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server     *httptest.Server
+		repo       string
+		digest     string
+		reportPath string
+		secretDir  string
+		key        *ecdsa.PrivateKey
+		attester   = &provenance.Attester{Logger: log.New(&bytes.Buffer{}, "", 0)}
+
+		startedOn  = time.Date(2020, 7, 14, 10, 30, 0, 0, time.UTC)
+		finishedOn = time.Date(2020, 7, 14, 10, 35, 0, 0, time.UTC)
+	)
+
+	it.Before(func() {
+		server = httptest.NewServer(registry.New(registry.Logger(log.New(ioutil.Discard, "", 0))))
+		u, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		repo = fmt.Sprintf("%s/some/app", u.Host)
+
+		image, err := random.Image(10, 1)
+		require.NoError(t, err)
+		image, err = imagehelpers.SetStringLabel(image, lifecycle.BuildMetadataLabel, `{"buildpacks": [{"id": "io.buildpacks.node", "version": "1.0.0"}]}`)
+		require.NoError(t, err)
+
+		ref, err := name.ParseReference(repo)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, image))
+
+		hash, err := image.Digest()
+		require.NoError(t, err)
+		digest = hash.String()
+
+		reportDir, err := ioutil.TempDir("", "report")
+		require.NoError(t, err)
+		reportPath = filepath.Join(reportDir, "report.toml")
+
+		file, err := os.Create(reportPath)
+		require.NoError(t, err)
+		defer file.Close()
+		require.NoError(t, toml.NewEncoder(file).Encode(lifecycle.ExportReport{
+			Image: lifecycle.ImageReport{
+				Tags:   []string{repo},
+				Digest: digest,
+			},
+		}))
+
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		require.NoError(t, err)
+
+		secretDir, err = ioutil.TempDir("", "cosign")
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(secretDir, cosign.KeyFileName), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+	})
+
+	it.After(func() {
+		server.Close()
+		require.NoError(t, os.RemoveAll(filepath.Dir(reportPath)))
+		require.NoError(t, os.RemoveAll(secretDir))
+	})
+
+	when("#Attest", func() {
+		it("pushes a signed provenance attestation referencing the image digest", func() {
+			err := attester.Attest(reportPath, secretDir, provenance.Config{
+				Build:            "some-namespace/some-build",
+				BuilderImage:     "some/builder@sha256:e7b1e2ffac8fab4af4ccb1bcd3e5de8de7a3d0cf6b2ba0ebc6bd6eafd1b68a6d",
+				LifecycleVersion: "0.9.1",
+				SourceURL:        "https://github.com/some/app",
+				SourceDigest:     "sha1:d2b6e2f6e6b1d36d0e1e3c3f6e0a7c6b7a5f4e3d",
+				EnvNames:         []string{"BP_JAVA_VERSION"},
+				BindingNames:     []string{"some-binding"},
+				StartedOn:        startedOn,
+				FinishedOn:       finishedOn,
+			}, authn.DefaultKeychain)
+			require.NoError(t, err)
+
+			repository, err := name.NewRepository(repo)
+			require.NoError(t, err)
+
+			attestationTag := cosign.AttestationTag(repository, digest)
+			require.Equal(t, repo+":sha256-"+digest[len("sha256:"):]+".att", attestationTag.Name())
+
+			attestations, err := remote.Image(attestationTag)
+			require.NoError(t, err)
+
+			manifest, err := attestations.Manifest()
+			require.NoError(t, err)
+			require.Len(t, manifest.Layers, 1)
+			require.Equal(t, cosign.DSSEMediaType, manifest.Layers[0].MediaType)
+			require.Equal(t, provenance.PredicateType, manifest.Layers[0].Annotations[cosign.PredicateTypeAnnotation])
+
+			layer, err := attestations.LayerByDigest(manifest.Layers[0].Digest)
+			require.NoError(t, err)
+			rc, err := layer.Compressed()
+			require.NoError(t, err)
+			defer rc.Close()
+
+			var envelope cosign.Envelope
+			require.NoError(t, json.NewDecoder(rc).Decode(&envelope))
+			require.Equal(t, cosign.InTotoPayloadType, envelope.PayloadType)
+			require.Len(t, envelope.Signatures, 1)
+
+			payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+			require.NoError(t, err)
+			signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+			require.NoError(t, err)
+
+			hash := sha256.Sum256(cosign.PAE(envelope.PayloadType, payload))
+			require.True(t, ecdsa.VerifyASN1(&key.PublicKey, hash[:], signature))
+
+			require.JSONEq(t, fmt.Sprintf(`{
+  "_type": "https://in-toto.io/Statement/v0.1",
+  "predicateType": "https://slsa.dev/provenance/v0.2",
+  "subject": [{"name": %q, "digest": {"sha256": %q}}],
+  "predicate": {
+    "builder": {"id": "https://github.com/pivotal/kpack"},
+    "buildType": "https://kpack.io/Build@v1alpha1",
+    "invocation": {
+      "configSource": {"uri": "https://github.com/some/app", "digest": {"sha1": "d2b6e2f6e6b1d36d0e1e3c3f6e0a7c6b7a5f4e3d"}},
+      "parameters": {"env": ["BP_JAVA_VERSION"], "bindings": ["some-binding"]},
+      "environment": {"build": "some-namespace/some-build"}
+    },
+    "buildConfig": {
+      "builderImage": "some/builder@sha256:e7b1e2ffac8fab4af4ccb1bcd3e5de8de7a3d0cf6b2ba0ebc6bd6eafd1b68a6d",
+      "lifecycleVersion": "0.9.1",
+      "buildpacks": [{"id": "io.buildpacks.node", "version": "1.0.0"}]
+    },
+    "metadata": {
+      "buildStartedOn": "2020-07-14T10:30:00Z",
+      "buildFinishedOn": "2020-07-14T10:35:00Z",
+      "completeness": {"parameters": true, "environment": false, "materials": false},
+      "reproducible": false
+    },
+    "materials": [
+      {"uri": "https://github.com/some/app", "digest": {"sha1": "d2b6e2f6e6b1d36d0e1e3c3f6e0a7c6b7a5f4e3d"}},
+      {"uri": "some/builder", "digest": {"sha256": "e7b1e2ffac8fab4af4ccb1bcd3e5de8de7a3d0cf6b2ba0ebc6bd6eafd1b68a6d"}}
+    ]
+  }
+}`, repo, digest[len("sha256:"):]), string(payload))
+		})
+	})
+}
@@ -0,0 +1,190 @@
+package provenance
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/buildpacks/lifecycle"
+)
+
+const (
+	StatementType = "https://in-toto.io/Statement/v0.1"
+	PredicateType = "https://slsa.dev/provenance/v0.2"
+
+	BuilderID = "https://github.com/pivotal/kpack"
+	BuildType = "https://kpack.io/Build@v1alpha1"
+)
+
+// Config is the build data the controller passes to the completion step
+type Config struct {
+	// Build is the namespaced name of the build
+	Build            string
+	BuilderImage     string
+	LifecycleVersion string
+	SourceURL        string
+	// SourceDigest is the algorithm prefixed digest of the resolved source
+	SourceDigest string
+	EnvNames     []string
+	BindingNames []string
+	StartedOn    time.Time
+	FinishedOn   time.Time
+}
+
+type statement struct {
+	Type          string    `json:"_type"`
+	PredicateType string    `json:"predicateType"`
+	Subject       []subject `json:"subject"`
+	Predicate     predicate `json:"predicate"`
+}
+
+type subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type predicate struct {
+	Builder     builder     `json:"builder"`
+	BuildType   string      `json:"buildType"`
+	Invocation  invocation  `json:"invocation"`
+	BuildConfig buildConfig `json:"buildConfig"`
+	Metadata    metadata    `json:"metadata"`
+	Materials   []material  `json:"materials"`
+}
+
+type builder struct {
+	ID string `json:"id"`
+}
+
+type invocation struct {
+	ConfigSource material          `json:"configSource"`
+	Parameters   parameters        `json:"parameters"`
+	Environment  map[string]string `json:"environment"`
+}
+
+type parameters struct {
+	Env      []string `json:"env"`
+	Bindings []string `json:"bindings"`
+}
+
+type buildConfig struct {
+	BuilderImage     string      `json:"builderImage"`
+	LifecycleVersion string      `json:"lifecycleVersion"`
+	Buildpacks       []buildpack `json:"buildpacks"`
+}
+
+type buildpack struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+type metadata struct {
+	BuildStartedOn  string       `json:"buildStartedOn,omitempty"`
+	BuildFinishedOn string       `json:"buildFinishedOn,omitempty"`
+	Completeness    completeness `json:"completeness"`
+	Reproducible    bool         `json:"reproducible"`
+}
+
+type completeness struct {
+	Parameters  bool `json:"parameters"`
+	Environment bool `json:"environment"`
+	Materials   bool `json:"materials"`
+}
+
+type material struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+// Statement returns the SLSA provenance of the image digest as an in-toto statement
+func Statement(repository, digest string, buildpacks []lifecycle.Buildpack, config Config) ([]byte, error) {
+	source := material{
+		URI:    config.SourceURL,
+		Digest: digestSet(config.SourceDigest),
+	}
+
+	builderRepository, builderDigest := splitDigest(config.BuilderImage)
+
+	return json.Marshal(statement{
+		Type:          StatementType,
+		PredicateType: PredicateType,
+		Subject: []subject{
+			{
+				Name:   repository,
+				Digest: digestSet(digest),
+			},
+		},
+		Predicate: predicate{
+			Builder:   builder{ID: BuilderID},
+			BuildType: BuildType,
+			Invocation: invocation{
+				ConfigSource: source,
+				Parameters: parameters{
+					Env:      nonNil(config.EnvNames),
+					Bindings: nonNil(config.BindingNames),
+				},
+				Environment: map[string]string{
+					"build": config.Build,
+				},
+			},
+			BuildConfig: buildConfig{
+				BuilderImage:     config.BuilderImage,
+				LifecycleVersion: config.LifecycleVersion,
+				Buildpacks:       buildpackList(buildpacks),
+			},
+			Metadata: metadata{
+				BuildStartedOn:  formatTime(config.StartedOn),
+				BuildFinishedOn: formatTime(config.FinishedOn),
+				Completeness: completeness{
+					Parameters: true,
+				},
+			},
+			Materials: []material{
+				source,
+				{
+					URI:    builderRepository,
+					Digest: digestSet(builderDigest),
+				},
+			},
+		},
+	})
+}
+
+func buildpackList(buildpacks []lifecycle.Buildpack) []buildpack {
+	list := make([]buildpack, 0, len(buildpacks))
+	for _, bp := range buildpacks {
+		list = append(list, buildpack{ID: bp.ID, Version: bp.Version})
+	}
+	return list
+}
+
+// digestSet converts an algorithm prefixed digest such as sha256:abc into an in-toto digest set
+func digestSet(digest string) map[string]string {
+	i := strings.Index(digest, ":")
+	if i == -1 {
+		return nil
+	}
+	return map[string]string{digest[:i]: digest[i+1:]}
+}
+
+func splitDigest(image string) (string, string) {
+	i := strings.Index(image, "@")
+	if i == -1 {
+		return image, ""
+	}
+	return image[:i], image[i+1:]
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -2,7 +2,13 @@ package build
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
@@ -11,16 +17,23 @@ import (
 	k8sclient "k8s.io/client-go/kubernetes"
 	v1Listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/controller"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
 	"github.com/pivotal/kpack/pkg/buildpod"
+	"github.com/pivotal/kpack/pkg/buildqueue"
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
 	v1alpha1informer "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
 	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/logs"
+	"github.com/pivotal/kpack/pkg/metrics"
+	"github.com/pivotal/kpack/pkg/notification"
 	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/sbom"
+	"github.com/pivotal/kpack/pkg/tracing"
 )
 
 const (
@@ -34,23 +47,82 @@ type MetadataRetriever interface {
 }
 
 type PodGenerator interface {
-	Generate(build buildpod.BuildPodable) (*corev1.Pod, error)
+	Generate(ctx context.Context, build buildpod.BuildPodable) (*corev1.Pod, error)
 }
 
-func NewController(opt reconciler.Options, k8sClient k8sclient.Interface, informer v1alpha1informer.BuildInformer, podInformer corev1Informers.PodInformer, metadataRetriever MetadataRetriever, podGenerator PodGenerator) *controller.Impl {
+type CreatorPhaseReader interface {
+	Phases(pod *corev1.Pod) ([]logs.CreatorPhase, error)
+	Forget(pod *corev1.Pod)
+}
+
+type LogArchiver interface {
+	Enqueue(build *v1alpha1.Build)
+}
+
+type Notifier interface {
+	Notify(eventType string, build *v1alpha1.Build)
+}
+
+type BuildQueue interface {
+	Admit(build *v1alpha1.Build) (buildqueue.Admission, error)
+	Release(build *v1alpha1.Build)
+}
+
+func NewController(opt reconciler.Options, k8sClient k8sclient.Interface, informer v1alpha1informer.BuildInformer, podInformer corev1Informers.PodInformer, metadataRetriever MetadataRetriever, podGenerator PodGenerator, creatorPhaseReader CreatorPhaseReader, logArchiver LogArchiver, notifier Notifier, queue BuildQueue, quotaInformer v1alpha1informer.BuildQuotaInformer) *controller.Impl {
 	c := &Reconciler{
-		Client:            opt.Client,
-		K8sClient:         k8sClient,
-		MetadataRetriever: metadataRetriever,
-		Lister:            informer.Lister(),
-		PodLister:         podInformer.Lister(),
-		PodGenerator:      podGenerator,
+		Client:             opt.Client,
+		EventRecorder:      opt.EventRecorder,
+		K8sClient:          k8sClient,
+		MetadataRetriever:  metadataRetriever,
+		Lister:             informer.Lister(),
+		PodLister:          podInformer.Lister(),
+		PodGenerator:       podGenerator,
+		CreatorPhaseReader: creatorPhaseReader,
+		LogArchiver:        logArchiver,
+		Notifier:           notifier,
+		Queue:              queue,
 	}
 
 	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
 
 	informer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
 
+	if queue != nil {
+		// a finished or deleted build frees a slot for the pending builds
+		informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				if !oldObj.(*v1alpha1.Build).Finished() && newObj.(*v1alpha1.Build).Finished() {
+					impl.FilteredGlobalResync(pendingIn(""), informer.Informer())
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				if build, ok := obj.(*v1alpha1.Build); ok {
+					queue.Release(build)
+				}
+				impl.FilteredGlobalResync(pendingIn(""), informer.Informer())
+			},
+		})
+
+		// a changed or deleted quota may allow the pending builds of its namespace
+		resyncQuotaNamespace := func(obj interface{}) {
+			key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+			if err != nil {
+				return
+			}
+			namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+			impl.FilteredGlobalResync(pendingIn(namespace), informer.Informer())
+		}
+		quotaInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(_, newObj interface{}) {
+				resyncQuotaNamespace(newObj)
+			},
+			DeleteFunc: resyncQuotaNamespace,
+		})
+	}
+
 	podInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: controller.Filter(v1alpha1.SchemeGroupVersion.WithKind(Kind)),
 		Handler:    reconciler.Handler(impl.EnqueueControllerOf),
@@ -60,12 +132,20 @@ func NewController(opt reconciler.Options, k8sClient k8sclient.Interface, inform
 }
 
 type Reconciler struct {
-	Client            versioned.Interface
-	Lister            v1alpha1lister.BuildLister
-	MetadataRetriever MetadataRetriever
-	K8sClient         k8sclient.Interface
-	PodLister         v1Listers.PodLister
-	PodGenerator      PodGenerator
+	Client             versioned.Interface
+	EventRecorder      record.EventRecorder
+	Lister             v1alpha1lister.BuildLister
+	MetadataRetriever  MetadataRetriever
+	K8sClient          k8sclient.Interface
+	PodLister          v1Listers.PodLister
+	PodGenerator       PodGenerator
+	CreatorPhaseReader CreatorPhaseReader
+	// LogArchiver is optional, the logs of finished builds are only archived when it is set
+	LogArchiver LogArchiver
+	// Notifier is optional, build lifecycle notifications are only sent when it is set
+	Notifier Notifier
+	// Queue is optional, builds create their pod without waiting for admission when it is not set
+	Queue BuildQueue
 }
 
 func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
@@ -83,47 +163,147 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 
 	build = build.DeepCopy()
 	build.SetDefaults(ctx)
+	wasFinished := build.Finished()
 
-	err = c.reconcile(build)
+	ctx, span := tracing.StartSpan(tracing.WithTraceParent(ctx, build.Annotations[tracing.TraceParentAnnotation]), "build.reconcile", trace.WithAttributes(
+		label.String("namespace", build.Namespace),
+		label.String("build", build.Name),
+	))
+	pod, err := c.reconcile(ctx, build)
+	tracing.EndSpan(span, err)
 	if err != nil && !controller.IsPermanentError(err) {
 		return err
 	} else if controller.IsPermanentError(err) {
 		build.Status.Error(err)
 	}
 
-	return c.updateStatus(build)
+	if err := c.updateStatus(build); err != nil {
+		return err
+	}
+
+	// a build is only reported finished once that is persisted so a conflicting update or a stale lister
+	// reconciling the finished pod again does not report it twice
+	if !wasFinished && build.Finished() && pod != nil {
+		c.recordFinished(build)
+		c.notifyFinished(build, pod)
+		traceSteps(ctx, build)
+		c.CreatorPhaseReader.Forget(pod)
+	}
+	return nil
 }
 
-func (c *Reconciler) reconcile(build *v1alpha1.Build) error {
+func (c *Reconciler) reconcile(ctx context.Context, build *v1alpha1.Build) (*corev1.Pod, error) {
 	if build.Finished() {
-		return nil
+		return nil, c.archiveLogs(build)
 	}
 
-	pod, err := c.reconcileBuildPod(build)
+	pod, err := c.reconcileBuildPod(ctx, build)
 	if err != nil {
-		return err
+		return nil, err
+	} else if pod == nil {
+		// the build is waiting in the admission queue
+		return nil, nil
 	}
 
 	if build.MetadataReady(pod) {
 		image, err := c.MetadataRetriever.GetBuiltImage(build)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		build.Status.BuildMetadata = buildMetadataFromBuiltImage(image)
+		build.Status.BOM = bomFromBuiltImage(image)
+		build.Status.SBOMImage, err = sbomImage(image)
+		if err != nil {
+			return nil, err
+		}
 		build.Status.LatestImage = image.Identifier
 		build.Status.Stack.RunImage = image.Stack.RunImage
 		build.Status.Stack.ID = image.Stack.ID
 	}
 
 	build.Status.PodName = pod.Name
-	build.Status.StepStates = stepStates(pod)
-	build.Status.StepsCompleted = stepCompleted(pod)
+	build.Status.QueuePosition = 0
+	build.Status.Commit = commitMetadata(build, pod)
+	build.Status.SignedTargets = signedTargets(build, pod)
+	build.Status.StepStates, build.Status.StepsCompleted, build.Status.Steps = c.steps(pod)
+	build.Status.Timing = buildTiming(build, pod, build.Status.Steps)
 	build.Status.Conditions = conditionForPod(pod)
-	return nil
+	return pod, nil
+}
+
+func (c *Reconciler) recordFinished(build *v1alpha1.Build) {
+	condition := build.Status.GetCondition(corev1alpha1.ConditionSucceeded)
+	switch {
+	case condition.IsTrue():
+		c.EventRecorder.Eventf(build, corev1.EventTypeNormal, reconciler.BuildSucceededReason, "Build %s succeeded", build.Name)
+		metrics.RecordBuild(build, metrics.BuildSucceeded)
+	case condition.IsFalse():
+		if condition.Message != "" {
+			c.EventRecorder.Eventf(build, corev1.EventTypeWarning, reconciler.BuildFailedReason, "Build %s failed: %s", build.Name, condition.Message)
+		} else {
+			c.EventRecorder.Eventf(build, corev1.EventTypeWarning, reconciler.BuildFailedReason, "Build %s failed", build.Name)
+		}
+		metrics.RecordBuild(build, metrics.BuildFailed)
+	}
+}
+
+func (c *Reconciler) notifyFinished(build *v1alpha1.Build, pod *corev1.Pod) {
+	condition := build.Status.GetCondition(corev1alpha1.ConditionSucceeded)
+	switch {
+	case condition.IsTrue() && rebasePod(pod):
+		c.notify(notification.BuildRebased, build)
+	case condition.IsTrue():
+		c.notify(notification.BuildSucceeded, build)
+	case condition.IsFalse():
+		c.notify(notification.BuildFailed, build)
+	}
+}
+
+func (c *Reconciler) notify(eventType string, build *v1alpha1.Build) {
+	if c.Notifier != nil {
+		c.Notifier.Notify(eventType, build)
+	}
+}
+
+func rebasePod(pod *corev1.Pod) bool {
+	for _, c := range pod.Spec.InitContainers {
+		if c.Name == v1alpha1.RebaseContainerName {
+			return true
+		}
+	}
+	return false
+}
+
+// traceSteps records spans for the time a finished build waited for its pod to be scheduled and for each of its steps
+func traceSteps(ctx context.Context, build *v1alpha1.Build) {
+	if !build.Finished() || build.Status.Timing == nil {
+		return
+	}
+
+	var firstStarted *metav1.Time
+	for _, step := range build.Status.Steps {
+		if step.StartedAt == nil || step.FinishedAt == nil {
+			continue
+		}
+		if firstStarted == nil || step.StartedAt.Before(firstStarted) {
+			firstStarted = step.StartedAt
+		}
+
+		_, span := tracing.StartSpan(ctx, "build.step", trace.WithTimestamp(step.StartedAt.Time), trace.WithAttributes(label.String("step", step.Name)))
+		if step.ExitCode != nil && *step.ExitCode != 0 {
+			span.SetStatus(codes.Error, step.Message)
+		}
+		span.End(trace.WithTimestamp(step.FinishedAt.Time))
+	}
+
+	if firstStarted != nil && !build.CreationTimestamp.IsZero() {
+		_, span := tracing.StartSpan(ctx, "build.scheduling", trace.WithTimestamp(build.CreationTimestamp.Time))
+		span.End(trace.WithTimestamp(firstStarted.Time))
+	}
 }
 
-func (c *Reconciler) reconcileBuildPod(build *v1alpha1.Build) (*corev1.Pod, error) {
+func (c *Reconciler) reconcileBuildPod(ctx context.Context, build *v1alpha1.Build) (*corev1.Pod, error) {
 	pod, err := c.PodLister.Pods(build.Namespace).Get(build.PodName())
 	if err != nil && !k8s_errors.IsNotFound(err) {
 		return nil, err
@@ -131,11 +311,71 @@ func (c *Reconciler) reconcileBuildPod(build *v1alpha1.Build) (*corev1.Pod, erro
 		return pod, nil
 	}
 
-	podConfig, err := c.PodGenerator.Generate(build)
+	if c.Queue != nil {
+		admission, err := c.Queue.Admit(build)
+		if err != nil {
+			return nil, err
+		} else if admission.QuotaExceeded != "" {
+			build.Status.QuotaExceeded(admission.QuotaExceeded)
+			return nil, nil
+		} else if !admission.Admitted {
+			build.Status.Pending(admission.Position)
+			return nil, nil
+		}
+	}
+
+	podConfig, err := c.PodGenerator.Generate(ctx, build)
 	if err != nil {
+		c.release(build)
 		return nil, controller.NewPermanentError(err)
 	}
-	return c.K8sClient.CoreV1().Pods(build.Namespace).Create(podConfig)
+	tracing.InjectPod(ctx, podConfig, v1alpha1.PrepareContainerName, v1alpha1.RebaseContainerName, v1alpha1.CompletionContainerName)
+
+	pod, err = c.K8sClient.CoreV1().Pods(build.Namespace).Create(podConfig)
+	if err != nil {
+		// the pod of an existing build only has to be observed by the lister to keep its slot
+		if !k8s_errors.IsAlreadyExists(err) {
+			c.release(build)
+		}
+		return nil, err
+	}
+
+	c.notify(notification.BuildStarted, build)
+	metrics.RecordBuild(build, metrics.BuildStarted)
+	return pod, nil
+}
+
+// release frees the admission of a build that did not get a pod
+func (c *Reconciler) release(build *v1alpha1.Build) {
+	if c.Queue != nil {
+		c.Queue.Release(build)
+	}
+}
+
+// archiveLogs queues the logs of a finished build for archiving in the background while its pod still exists,
+// the archiver records their location in the build status
+func (c *Reconciler) archiveLogs(build *v1alpha1.Build) error {
+	if c.LogArchiver == nil || build.Status.LogArchive != "" {
+		return nil
+	}
+
+	_, err := c.PodLister.Pods(build.Namespace).Get(build.PodName())
+	if k8s_errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	c.LogArchiver.Enqueue(build)
+	return nil
+}
+
+// pendingIn filters the builds of a namespace, or of every namespace if it is empty, that have not been admitted to create their pod
+func pendingIn(namespace string) func(interface{}) bool {
+	return func(obj interface{}) bool {
+		build, ok := obj.(*v1alpha1.Build)
+		return ok && (namespace == "" || build.Namespace == namespace) && !build.Finished() && build.Status.PodName == ""
+	}
 }
 
 func conditionForPod(pod *corev1.Pod) corev1alpha1.Conditions {
@@ -149,6 +389,17 @@ func conditionForPod(pod *corev1.Pod) corev1alpha1.Conditions {
 			},
 		}
 	case corev1.PodFailed:
+		if hook, ok := failedHook(pod); ok {
+			return corev1alpha1.Conditions{
+				{
+					Type:               corev1alpha1.ConditionSucceeded,
+					Status:             corev1.ConditionFalse,
+					Reason:             v1alpha1.HookFailed,
+					Message:            fmt.Sprintf("hook %s failed with exit code %d", hook.Name, hook.State.Terminated.ExitCode),
+					LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+				},
+			}
+		}
 		return corev1alpha1.Conditions{
 			{
 				Type:               corev1alpha1.ConditionSucceeded,
@@ -182,22 +433,222 @@ func conditionForPod(pod *corev1.Pod) corev1alpha1.Conditions {
 	}
 }
 
-func stepStates(pod *corev1.Pod) []corev1.ContainerState {
-	states := make([]corev1.ContainerState, 0, len(pod.Status.InitContainerStatuses))
+func failedHook(pod *corev1.Pod) (corev1.ContainerStatus, bool) {
 	for _, s := range pod.Status.InitContainerStatuses {
-		states = append(states, s.State)
+		if !strings.HasPrefix(s.Name, v1alpha1.PreBuildHookPrefix) && !strings.HasPrefix(s.Name, v1alpha1.PostBuildHookPrefix) {
+			continue
+		}
+
+		if s.State.Terminated != nil && s.State.Terminated.ExitCode != 0 {
+			return s, true
+		}
+	}
+	return corev1.ContainerStatus{}, false
+}
+
+// commitMetadata reads the commit metadata build-init writes to the prepare step's termination message
+func commitMetadata(build *v1alpha1.Build, pod *corev1.Pod) *v1alpha1.GitCommit {
+	if build.Spec.Source.Git == nil {
+		return nil
+	}
+
+	for _, s := range pod.Status.InitContainerStatuses {
+		if s.Name != v1alpha1.PrepareContainerName || s.State.Terminated == nil || s.State.Terminated.ExitCode != 0 {
+			continue
+		}
+
+		var commit v1alpha1.GitCommit
+		if err := json.Unmarshal([]byte(s.State.Terminated.Message), &commit); err != nil {
+			return nil
+		}
+		return &commit
+	}
+	return nil
+}
+
+// signedTargets reads the notary targets the completion step writes to its termination message
+func signedTargets(build *v1alpha1.Build, pod *corev1.Pod) []v1alpha1.NotarySignedTarget {
+	if build.NotaryV1Config() == nil {
+		return nil
+	}
+
+	for _, s := range pod.Status.ContainerStatuses {
+		if s.Name != v1alpha1.CompletionContainerName || s.State.Terminated == nil || s.State.Terminated.ExitCode != 0 {
+			continue
+		}
+
+		var targets []v1alpha1.NotarySignedTarget
+		if err := json.Unmarshal([]byte(s.State.Terminated.Message), &targets); err != nil {
+			return nil
+		}
+		return targets
 	}
-	return states
+	return nil
 }
 
-func stepCompleted(pod *corev1.Pod) []string {
+func (c *Reconciler) steps(pod *corev1.Pod) ([]corev1.ContainerState, []string, []v1alpha1.BuildStep) {
+	states := make([]corev1.ContainerState, 0, len(pod.Status.InitContainerStatuses))
 	completed := make([]string, 0, len(pod.Status.InitContainerStatuses))
+	steps := make([]v1alpha1.BuildStep, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
 	for _, s := range pod.Status.InitContainerStatuses {
+		if s.Name == v1alpha1.CreateContainerName {
+			phaseStates, phasesCompleted, phaseSteps := c.creatorSteps(pod, s)
+			states = append(states, phaseStates...)
+			completed = append(completed, phasesCompleted...)
+			steps = append(steps, phaseSteps...)
+			continue
+		}
+
+		states = append(states, s.State)
 		if s.State.Terminated != nil {
 			completed = append(completed, s.Name)
 		}
+		steps = append(steps, buildStep(s.Name, s.State))
+	}
+
+	for _, s := range pod.Status.ContainerStatuses {
+		steps = append(steps, buildStep(s.Name, s.State))
+	}
+	return states, completed, steps
+}
+
+// creatorSteps derives a step for each lifecycle phase from the phase headers in the creator's logs
+func (c *Reconciler) creatorSteps(pod *corev1.Pod, creator corev1.ContainerStatus) ([]corev1.ContainerState, []string, []v1alpha1.BuildStep) {
+	if creator.State.Waiting != nil {
+		states := make([]corev1.ContainerState, 0, len(logs.CreatorPhases))
+		steps := make([]v1alpha1.BuildStep, 0, len(logs.CreatorPhases))
+		for _, phase := range logs.CreatorPhases {
+			states = append(states, creator.State)
+			steps = append(steps, buildStep(phase, creator.State))
+		}
+		return states, nil, steps
+	}
+
+	started, err := c.CreatorPhaseReader.Phases(pod)
+	if err != nil || len(started) == 0 {
+		step := buildStep(creator.Name, creator.State)
+		if creator.State.Terminated != nil {
+			return []corev1.ContainerState{creator.State}, []string{creator.Name}, []v1alpha1.BuildStep{step}
+		}
+		return []corev1.ContainerState{creator.State}, nil, []v1alpha1.BuildStep{step}
 	}
-	return completed
+
+	succeeded := creator.State.Terminated != nil && creator.State.Terminated.ExitCode == 0
+	current := started[len(started)-1]
+	reached := true
+
+	var (
+		states    []corev1.ContainerState
+		completed []string
+		steps     []v1alpha1.BuildStep
+	)
+	for _, phase := range logs.CreatorPhases {
+		switch {
+		case phase == current.Name:
+			states = append(states, creator.State)
+			if creator.State.Terminated != nil {
+				completed = append(completed, phase)
+			}
+			steps = append(steps, creatorPhaseStep(phase, current.StartedAt, creator.State))
+			reached = false
+		case reached || succeeded:
+			states = append(states, corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"},
+			})
+			completed = append(completed, phase)
+			steps = append(steps, buildStep(phase, completedPhaseState(started, phase)))
+		default:
+			states = append(states, corev1.ContainerState{
+				Waiting: &corev1.ContainerStateWaiting{},
+			})
+			steps = append(steps, v1alpha1.BuildStep{Name: phase})
+		}
+	}
+	return states, completed, steps
+}
+
+// completedPhaseState times a completed phase from its start until the start of the next phase
+func completedPhaseState(started []logs.CreatorPhase, phase string) corev1.ContainerState {
+	terminated := &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}
+	for i := 0; i < len(started)-1; i++ {
+		if started[i].Name == phase {
+			terminated.StartedAt = started[i].StartedAt
+			terminated.FinishedAt = started[i+1].StartedAt
+		}
+	}
+	return corev1.ContainerState{Terminated: terminated}
+}
+
+// creatorPhaseStep describes the phase the creator was last in with the start time of the phase
+func creatorPhaseStep(name string, startedAt metav1.Time, creator corev1.ContainerState) v1alpha1.BuildStep {
+	step := buildStep(name, creator)
+	if !startedAt.IsZero() {
+		step.StartedAt = &startedAt
+	}
+	step.Duration = duration(step.StartedAt, step.FinishedAt)
+	return step
+}
+
+func buildStep(name string, state corev1.ContainerState) v1alpha1.BuildStep {
+	step := v1alpha1.BuildStep{Name: name}
+	switch {
+	case state.Running != nil:
+		step.StartedAt = timePointer(state.Running.StartedAt)
+	case state.Terminated != nil:
+		exitCode := state.Terminated.ExitCode
+		step.StartedAt = timePointer(state.Terminated.StartedAt)
+		step.FinishedAt = timePointer(state.Terminated.FinishedAt)
+		step.Duration = duration(step.StartedAt, step.FinishedAt)
+		step.ExitCode = &exitCode
+		step.Message = state.Terminated.Reason
+	case state.Waiting != nil:
+		step.Message = state.Waiting.Reason
+	}
+	return step
+}
+
+// buildTiming splits the time of a build into the time spent before its first step started and the time spent running steps
+func buildTiming(build *v1alpha1.Build, pod *corev1.Pod, steps []v1alpha1.BuildStep) *v1alpha1.BuildTiming {
+	var firstStarted, lastFinished *metav1.Time
+	for _, step := range steps {
+		if step.StartedAt != nil && (firstStarted == nil || step.StartedAt.Before(firstStarted)) {
+			firstStarted = step.StartedAt
+		}
+		if step.FinishedAt != nil && (lastFinished == nil || lastFinished.Before(step.FinishedAt)) {
+			lastFinished = step.FinishedAt
+		}
+	}
+
+	if firstStarted == nil {
+		return nil
+	}
+
+	timing := &v1alpha1.BuildTiming{}
+	if !build.CreationTimestamp.IsZero() {
+		timing.Queued = duration(&build.CreationTimestamp, firstStarted)
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		timing.Running = duration(firstStarted, lastFinished)
+	}
+
+	if timing.Queued == nil && timing.Running == nil {
+		return nil
+	}
+	return timing
+}
+
+func duration(start, finish *metav1.Time) *metav1.Duration {
+	if start == nil || finish == nil || finish.Before(start) {
+		return nil
+	}
+	return &metav1.Duration{Duration: finish.Sub(start.Time)}
+}
+
+func timePointer(t metav1.Time) *metav1.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
 }
 
 func (c *Reconciler) updateStatus(desired *v1alpha1.Build) error {
@@ -225,3 +676,31 @@ func buildMetadataFromBuiltImage(image cnb.BuiltImage) []v1alpha1.BuildpackMetad
 	}
 	return buildpackMetadata
 }
+
+func bomFromBuiltImage(image cnb.BuiltImage) []v1alpha1.BOMPackage {
+	if len(image.BOM) == 0 {
+		return nil
+	}
+
+	bom := make([]v1alpha1.BOMPackage, 0, len(image.BOM))
+	for _, entry := range image.BOM {
+		bom = append(bom, v1alpha1.BOMPackage{
+			Name:    entry.Name,
+			Version: sbom.Version(entry),
+			Buildpack: v1alpha1.BuildpackMetadata{
+				Id:      entry.Buildpack.ID,
+				Version: entry.Buildpack.Version,
+			},
+		})
+	}
+	return bom
+}
+
+// sbomImage is the reference the completion step publishes the bill of materials to
+func sbomImage(image cnb.BuiltImage) (string, error) {
+	if len(image.BOM) == 0 {
+		return "", nil
+	}
+
+	return sbom.Reference(image.Identifier)
+}
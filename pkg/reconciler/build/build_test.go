@@ -1,6 +1,7 @@
 package build_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -9,7 +10,9 @@ import (
 	"github.com/sclevine/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -17,13 +20,19 @@ import (
 	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/controller"
+	"knative.dev/pkg/metrics"
+	"knative.dev/pkg/metrics/metricstest"
 	rtesting "knative.dev/pkg/reconciler/testing"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
 	"github.com/pivotal/kpack/pkg/buildpod"
+	"github.com/pivotal/kpack/pkg/buildqueue"
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
 	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/logs"
+	kpackmetrics "github.com/pivotal/kpack/pkg/metrics"
+	"github.com/pivotal/kpack/pkg/notification"
 	"github.com/pivotal/kpack/pkg/reconciler/build"
 	"github.com/pivotal/kpack/pkg/reconciler/build/buildfakes"
 	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
@@ -47,32 +56,55 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 	var (
 		fakeMetadataRetriever = &buildfakes.FakeMetadataRetriever{}
 		podGenerator          = &testPodGenerator{}
+		creatorPhaseReader    = &fakeCreatorPhaseReader{}
+		logArchiver           build.LogArchiver
+		notifier              build.Notifier
+		buildQueue            build.BuildQueue
+		podCreateErr          error
+		buildUpdateReactor    clientgotesting.ReactionFunc
 	)
 
-	rt := testhelpers.ReconcilerTester(t,
-		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
-			listers := testhelpers.NewListers(row.Objects)
+	newReconciler := func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
+		listers := testhelpers.NewListers(row.Objects)
 
-			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
-			k8sfakeClient := k8sfake.NewSimpleClientset(listers.GetKubeObjects()...)
+		fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+		k8sfakeClient := k8sfake.NewSimpleClientset(listers.GetKubeObjects()...)
 
-			eventRecorder := record.NewFakeRecorder(10)
-			actionRecorderList := rtesting.ActionRecorderList{fakeClient, k8sfakeClient}
-			eventList := rtesting.EventList{Recorder: eventRecorder}
+		eventRecorder := record.NewFakeRecorder(10)
+		actionRecorderList := rtesting.ActionRecorderList{fakeClient, k8sfakeClient}
+		eventList := rtesting.EventList{Recorder: eventRecorder}
 
-			r := &build.Reconciler{
-				K8sClient:         k8sfakeClient,
-				Client:            fakeClient,
-				Lister:            listers.GetBuildLister(),
-				PodLister:         listers.GetPodLister(),
-				MetadataRetriever: fakeMetadataRetriever,
-				PodGenerator:      podGenerator,
-			}
+		r := &build.Reconciler{
+			EventRecorder:      eventRecorder,
+			K8sClient:          k8sfakeClient,
+			Client:             fakeClient,
+			Lister:             listers.GetBuildLister(),
+			PodLister:          listers.GetPodLister(),
+			MetadataRetriever:  fakeMetadataRetriever,
+			PodGenerator:       podGenerator,
+			CreatorPhaseReader: creatorPhaseReader,
+			LogArchiver:        logArchiver,
+			Notifier:           notifier,
+			Queue:              buildQueue,
+		}
 
-			rtesting.PrependGenerateNameReactor(&fakeClient.Fake)
+		rtesting.PrependGenerateNameReactor(&fakeClient.Fake)
+		if podCreateErr != nil {
+			k8sfakeClient.PrependReactor("create", "pods", func(clientgotesting.Action) (bool, runtime.Object, error) {
+				return true, nil, podCreateErr
+			})
+		}
+		if buildUpdateReactor != nil {
+			fakeClient.PrependReactor("update", "builds", buildUpdateReactor)
+		}
 
-			return r, actionRecorderList, eventList
-		})
+		return r, actionRecorderList, eventList
+	}
+	rt := testhelpers.ReconcilerTester(t, newReconciler)
+
+	exitCode := func(code int32) *int32 {
+		return &code
+	}
 
 	build := &v1alpha1.Build{
 		ObjectMeta: metav1.ObjectMeta{
@@ -118,7 +150,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 
 	when("#Reconcile", func() {
 		it("schedules a pod to execute the build", func() {
-			buildPod, err := podGenerator.Generate(build)
+			buildPod, err := podGenerator.Generate(context.TODO(), build)
 			require.NoError(t, err)
 
 			rt.Test(rtesting.TableRow{
@@ -155,7 +187,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("does not schedule a build if already created", func() {
-			buildPod, err := podGenerator.Generate(build)
+			buildPod, err := podGenerator.Generate(context.TODO(), build)
 			require.NoError(t, err)
 
 			rt.Test(rtesting.TableRow{
@@ -189,7 +221,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("updates observed generation when processing an update", func() {
-			buildPod, err := podGenerator.Generate(build)
+			buildPod, err := podGenerator.Generate(context.TODO(), build)
 			require.NoError(t, err)
 			build.Generation = 3
 
@@ -224,7 +256,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("does not update status if there is no update", func() {
-			buildPod, err := podGenerator.Generate(build)
+			buildPod, err := podGenerator.Generate(context.TODO(), build)
 			require.NoError(t, err)
 
 			build.Status = v1alpha1.BuildStatus{
@@ -283,7 +315,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 		})
 
 		it("gracefully handles a pod that has already been created", func() {
-			buildPod, err := podGenerator.Generate(build)
+			buildPod, err := podGenerator.Generate(context.TODO(), build)
 			require.NoError(t, err)
 
 			rt.Test(rtesting.TableRow{
@@ -319,7 +351,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 
 		when("pod executing", func() {
 			it("updates the status step states with the statuses of the containers", func() {
-				pod, err := podGenerator.Generate(build)
+				pod, err := podGenerator.Generate(context.TODO(), build)
 				require.NoError(t, err)
 
 				startTime := time.Now()
@@ -402,6 +434,11 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 									StepsCompleted: []string{
 										"step-1",
 									},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "step-1", ExitCode: exitCode(0), Message: "Terminated"},
+										{Name: "step-2", StartedAt: &metav1.Time{Time: startTime}},
+										{Name: "step-3", Message: "Waiting"},
+									},
 								},
 							},
 						},
@@ -409,8 +446,161 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 
+			when("the lifecycle runs in a single creator container", func() {
+				creatorStarted := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+				at := func(seconds int) *metav1.Time {
+					t := metav1.NewTime(creatorStarted.Add(time.Duration(seconds) * time.Second))
+					return &t
+				}
+				took := func(seconds int) *metav1.Duration {
+					return &metav1.Duration{Duration: time.Duration(seconds) * time.Second}
+				}
+				prepared := corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"},
+				}
+				completed := corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"},
+				}
+				notStarted := corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{},
+				}
+				preparedStep := v1alpha1.BuildStep{Name: "prepare", ExitCode: exitCode(0), Message: "Completed"}
+
+				creatorBuild := func(creator corev1.ContainerState) (*corev1.Pod, func(states []corev1.ContainerState, stepsCompleted []string, steps []v1alpha1.BuildStep) rtesting.TableRow) {
+					pod, err := podGenerator.Generate(context.TODO(), build)
+					require.NoError(t, err)
+
+					pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+						{Name: "prepare", State: prepared},
+						{Name: "create", State: creator},
+					}
+
+					return pod, func(states []corev1.ContainerState, stepsCompleted []string, steps []v1alpha1.BuildStep) rtesting.TableRow {
+						return rtesting.TableRow{
+							Key: key,
+							Objects: []runtime.Object{
+								build,
+								pod,
+							},
+							WantErr: false,
+							WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+								{
+									Object: &v1alpha1.Build{
+										ObjectMeta: build.ObjectMeta,
+										Spec:       build.Spec,
+										Status: v1alpha1.BuildStatus{
+											Status: corev1alpha1.Status{
+												ObservedGeneration: originalGeneration,
+												Conditions: corev1alpha1.Conditions{
+													{
+														Type:   corev1alpha1.ConditionSucceeded,
+														Status: corev1.ConditionUnknown,
+													},
+												},
+											},
+											PodName:        "build-name-build-pod",
+											StepStates:     states,
+											StepsCompleted: stepsCompleted,
+											Steps:          steps,
+										},
+									},
+								},
+							},
+						}
+					}
+				}
+
+				it("derives a step for each phase the creator has logged", func() {
+					running := corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{StartedAt: *at(0)},
+					}
+					creatorPhaseReader.phases = []logs.CreatorPhase{
+						{Name: "detect", StartedAt: *at(1)},
+						{Name: "analyze", StartedAt: *at(5)},
+						{Name: "restore", StartedAt: *at(7)},
+					}
+
+					_, row := creatorBuild(running)
+					rt.Test(row(
+						[]corev1.ContainerState{prepared, completed, completed, running, notStarted, notStarted},
+						[]string{"prepare", "detect", "analyze"},
+						[]v1alpha1.BuildStep{
+							preparedStep,
+							{Name: "detect", StartedAt: at(1), FinishedAt: at(5), Duration: took(4), ExitCode: exitCode(0), Message: "Completed"},
+							{Name: "analyze", StartedAt: at(5), FinishedAt: at(7), Duration: took(2), ExitCode: exitCode(0), Message: "Completed"},
+							{Name: "restore", StartedAt: at(7)},
+							{Name: "build"},
+							{Name: "export"},
+						},
+					))
+				})
+
+				it("reports the creator failure on the phase that failed", func() {
+					failed := corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{ExitCode: 51, Reason: "Error", StartedAt: *at(0), FinishedAt: *at(30)},
+					}
+					creatorPhaseReader.phases = []logs.CreatorPhase{
+						{Name: "detect", StartedAt: *at(1)},
+						{Name: "analyze", StartedAt: *at(5)},
+						{Name: "restore", StartedAt: *at(7)},
+						{Name: "build", StartedAt: *at(10)},
+					}
+
+					_, row := creatorBuild(failed)
+					rt.Test(row(
+						[]corev1.ContainerState{prepared, completed, completed, completed, failed, notStarted},
+						[]string{"prepare", "detect", "analyze", "restore", "build"},
+						[]v1alpha1.BuildStep{
+							preparedStep,
+							{Name: "detect", StartedAt: at(1), FinishedAt: at(5), Duration: took(4), ExitCode: exitCode(0), Message: "Completed"},
+							{Name: "analyze", StartedAt: at(5), FinishedAt: at(7), Duration: took(2), ExitCode: exitCode(0), Message: "Completed"},
+							{Name: "restore", StartedAt: at(7), FinishedAt: at(10), Duration: took(3), ExitCode: exitCode(0), Message: "Completed"},
+							{Name: "build", StartedAt: at(10), FinishedAt: at(30), Duration: took(20), ExitCode: exitCode(51), Message: "Error"},
+							{Name: "export"},
+						},
+					))
+				})
+
+				it("waits on every phase until the creator starts", func() {
+					waiting := corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"},
+					}
+
+					_, row := creatorBuild(waiting)
+					rt.Test(row(
+						[]corev1.ContainerState{prepared, waiting, waiting, waiting, waiting, waiting},
+						[]string{"prepare"},
+						[]v1alpha1.BuildStep{
+							preparedStep,
+							{Name: "detect", Message: "PodInitializing"},
+							{Name: "analyze", Message: "PodInitializing"},
+							{Name: "restore", Message: "PodInitializing"},
+							{Name: "build", Message: "PodInitializing"},
+							{Name: "export", Message: "PodInitializing"},
+						},
+					))
+				})
+
+				it("uses the creator state when the phases cannot be read", func() {
+					running := corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{StartedAt: *at(0)},
+					}
+					creatorPhaseReader.err = errors.New("logs unavailable")
+
+					_, row := creatorBuild(running)
+					rt.Test(row(
+						[]corev1.ContainerState{prepared, running},
+						[]string{"prepare"},
+						[]v1alpha1.BuildStep{
+							preparedStep,
+							{Name: "create", StartedAt: at(0)},
+						},
+					))
+				})
+			})
+
 			it("updates the status with the container status when a container is waiting", func() {
-				pod, err := podGenerator.Generate(build)
+				pod, err := podGenerator.Generate(context.TODO(), build)
 				require.NoError(t, err)
 
 				pod.Status.Phase = corev1.PodPending
@@ -482,6 +672,10 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 									StepsCompleted: []string{
 										"step-1",
 									},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "step-1", ExitCode: exitCode(0), Message: "Terminated"},
+										{Name: "step-2", Message: "ImagePullBackOff"},
+									},
 								},
 							},
 						},
@@ -506,8 +700,10 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 			}
 			fakeMetadataRetriever.GetBuiltImageReturns(builtImage, nil)
 
+			commitTimestamp := metav1.NewTime(time.Date(2020, 7, 14, 10, 30, 0, 0, time.UTC))
+
 			it("sets the build status to Succeeded", func() {
-				pod, err := podGenerator.Generate(build)
+				pod, err := podGenerator.Generate(context.TODO(), build)
 				require.NoError(t, err)
 				pod.Status.Phase = corev1.PodSucceeded
 				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
@@ -589,17 +785,45 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 										"step-1",
 										"step-2",
 									},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "step-1", ExitCode: exitCode(0), Message: "Terminated"},
+										{Name: "step-2", ExitCode: exitCode(0), Message: "Terminated"},
+									},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildSucceeded Build build-name succeeded",
+					},
 				})
 
 				assert.Equal(t, fakeMetadataRetriever.GetBuiltImageCallCount(), 1)
 			})
 
-			it("does not fetch metadata if already retrieved", func() {
-				pod, err := podGenerator.Generate(build)
+			it("records a summary of the bill of materials and the published sbom", func() {
+				const digestedIdentifier = "someimage/name@sha256:c3c3e6f8c83a2b62ec4b3e1c67ef6d7b0cfd0a3b0ee6b2e4fd6efa0e2f8b1a44"
+				bomImage := builtImage
+				bomImage.Identifier = digestedIdentifier
+				bomImage.BOM = []lifecycle.BOMEntry{
+					{
+						Require: lifecycle.Require{
+							Name:    "node",
+							Version: "12.18.0",
+						},
+						Buildpack: lifecycle.Buildpack{ID: "io.buildpack.executed", Version: "1.1"},
+					},
+					{
+						Require: lifecycle.Require{
+							Name:     "yarn",
+							Metadata: map[string]interface{}{"version": "1.22.4"},
+						},
+						Buildpack: lifecycle.Buildpack{ID: "io.buildpack.executed", Version: "1.1"},
+					},
+				}
+				fakeMetadataRetriever.GetBuiltImageReturns(bomImage, nil)
+
+				pod, err := podGenerator.Generate(context.TODO(), build)
 				require.NoError(t, err)
 				pod.Status.Phase = corev1.PodSucceeded
 				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
@@ -607,21 +831,7 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 						Name: "step-1",
 						State: corev1.ContainerState{
 							Terminated: &corev1.ContainerStateTerminated{
-								ExitCode:    0,
-								Reason:      "Terminated",
-								Message:     "Message",
-								ContainerID: "container.ID",
-							},
-						},
-					},
-					{
-						Name: "step-2",
-						State: corev1.ContainerState{
-							Terminated: &corev1.ContainerStateTerminated{
-								ExitCode:    0,
-								Reason:      "Terminated",
-								Message:     "Message",
-								ContainerID: "container.ID2",
+								ExitCode: 0,
 							},
 						},
 					},
@@ -630,135 +840,82 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
-						&v1alpha1.Build{
-							ObjectMeta: build.ObjectMeta,
-							Spec:       build.Spec,
-							Status: v1alpha1.BuildStatus{
-								Status: corev1alpha1.Status{
-									ObservedGeneration: originalGeneration,
-									Conditions: corev1alpha1.Conditions{
-										{
-											Type:   corev1alpha1.ConditionSucceeded,
-											Status: corev1.ConditionTrue,
+						build,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
 										},
 									},
-								},
-								BuildMetadata: v1alpha1.BuildpackMetadataList{{
-									Id:      "io.buildpack.previouslyfetched",
-									Version: "1.1",
-								}},
-								PodName:     "build-name-build-pod",
-								LatestImage: "previously/fetched@sha256:abcd",
-								StepStates: []corev1.ContainerState{
-									{
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    0,
-											Reason:      "Terminated",
-											Message:     "Message",
-											ContainerID: "container.ID",
+									PodName: "build-name-build-pod",
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										Id:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									BOM: []v1alpha1.BOMPackage{
+										{
+											Name:      "node",
+											Version:   "12.18.0",
+											Buildpack: v1alpha1.BuildpackMetadata{Id: "io.buildpack.executed", Version: "1.1"},
 										},
-									},
-									{
-										Waiting: nil,
-										Running: nil,
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    0,
-											Reason:      "Terminated",
-											Message:     "Message",
-											ContainerID: "container.ID2",
+										{
+											Name:      "yarn",
+											Version:   "1.22.4",
+											Buildpack: v1alpha1.BuildpackMetadata{Id: "io.buildpack.executed", Version: "1.1"},
 										},
 									},
-								},
-								StepsCompleted: []string{
-									"step-1",
-									"step-2", //todo realistic names
-								},
-							},
-						},
-						pod,
-					},
-					WantErr: false,
-				})
-				assert.Equal(t, fakeMetadataRetriever.GetBuiltImageCallCount(), 0)
-			})
-
-			it("does not recreate pods if build has finished", func() {
-				rt.Test(rtesting.TableRow{
-					Key: key,
-					Objects: []runtime.Object{
-						&v1alpha1.Build{
-							ObjectMeta: build.ObjectMeta,
-							Spec:       build.Spec,
-							Status: v1alpha1.BuildStatus{
-								Status: corev1alpha1.Status{
-									ObservedGeneration: originalGeneration,
-									Conditions: corev1alpha1.Conditions{
+									SBOMImage:   "index.docker.io/someimage/name:sha256-c3c3e6f8c83a2b62ec4b3e1c67ef6d7b0cfd0a3b0ee6b2e4fd6efa0e2f8b1a44.sbom",
+									LatestImage: digestedIdentifier,
+									Stack: v1alpha1.BuildStack{
+										RunImage: "somerun/123@sha256:12334563ad",
+										ID:       "io.buildpacks.stacks.bionic",
+									},
+									StepStates: []corev1.ContainerState{
 										{
-											Type:   corev1alpha1.ConditionSucceeded,
-											Status: corev1.ConditionTrue,
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode: 0,
+											},
 										},
 									},
-								},
-								BuildMetadata: v1alpha1.BuildpackMetadataList{{
-									Id:      "io.buildpack.previouslyfetched",
-									Version: "1.1",
-								}},
-								PodName:     "build-name-build-pod",
-								LatestImage: "previously/fetched@sha256:abcd",
-								StepStates: []corev1.ContainerState{
-									{
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    0,
-											Reason:      "Terminated",
-											Message:     "Message",
-											ContainerID: "container.ID",
-										},
+									StepsCompleted: []string{
+										"step-1",
 									},
-									{
-										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    0,
-											Reason:      "Terminated",
-											Message:     "Message",
-											ContainerID: "container.ID2",
-										},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "step-1", ExitCode: exitCode(0)},
 									},
 								},
-								StepsCompleted: []string{
-									"step-1",
-									"step-2",
-								},
 							},
 						},
 					},
-					WantErr: false,
+					WantEvents: []string{
+						"Normal BuildSucceeded Build build-name succeeded",
+					},
 				})
 			})
 
-		})
-
-		when("pod failed", func() {
-			it("sets the build status to Failed", func() {
-				pod, err := podGenerator.Generate(build)
+			it("records commit metadata from the prepare step", func() {
+				pod, err := podGenerator.Generate(context.TODO(), build)
 				require.NoError(t, err)
-				pod.Status.Phase = corev1.PodFailed
+				pod.Status.Phase = corev1.PodSucceeded
 				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
 					{
-						Name: "step-1",
+						Name: "prepare",
 						State: corev1.ContainerState{
 							Terminated: &corev1.ContainerStateTerminated{
-								ExitCode:    1,
-								Reason:      "Terminated",
-								Message:     "Errors",
-								ContainerID: "container.ID",
-							},
-						},
-					},
-					{
-						Name: "step-2",
-						State: corev1.ContainerState{
-							Waiting: &corev1.ContainerStateWaiting{
-								Reason:  "Waiting",
-								Message: "My Turn",
+								ExitCode: 0,
+								Message:  `{"branch":"main","author":"Some Author <author@example.com>","committer":"Some Committer <committer@example.com>","timestamp":"2020-07-14T10:30:00Z","subject":"Some subject"}`,
 							},
 						},
 					},
@@ -782,73 +939,350 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 										Conditions: corev1alpha1.Conditions{
 											{
 												Type:   corev1alpha1.ConditionSucceeded,
-												Status: corev1.ConditionFalse,
+												Status: corev1.ConditionTrue,
 											},
 										},
 									},
 									PodName: "build-name-build-pod",
+									Commit: &v1alpha1.GitCommit{
+										Branch:    "main",
+										Author:    "Some Author <author@example.com>",
+										Committer: "Some Committer <committer@example.com>",
+										Timestamp: &commitTimestamp,
+										Subject:   "Some subject",
+									},
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										Id:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									LatestImage: identifier,
+									Stack: v1alpha1.BuildStack{
+										RunImage: "somerun/123@sha256:12334563ad",
+										ID:       "io.buildpacks.stacks.bionic",
+									},
 									StepStates: []corev1.ContainerState{
-										{
-											Terminated: &corev1.ContainerStateTerminated{
-												ExitCode:    1,
-												Reason:      "Terminated",
-												Message:     "Errors",
-												ContainerID: "container.ID",
-											},
-										},
-										{
-											Waiting: &corev1.ContainerStateWaiting{
-												Reason:  "Waiting",
-												Message: "My Turn",
-											},
-										},
+										pod.Status.InitContainerStatuses[0].State,
 									},
 									StepsCompleted: []string{
-										"step-1",
+										"prepare",
+									},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "prepare", ExitCode: exitCode(0)},
 									},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildSucceeded Build build-name succeeded",
+					},
 				})
 			})
 
-			it("does not recreate pods if build has finished", func() {
+			it("records the targets signed by the completion step", func() {
+				build.Spec.Notary = &v1alpha1.NotaryConfig{
+					V1: &v1alpha1.NotaryV1Config{
+						URL: "some-notary-url",
+						SecretRef: v1alpha1.NotarySecretRef{
+							Name: "some-notary-secret",
+						},
+					},
+				}
+
+				pod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodSucceeded
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "completion",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode: 0,
+								Message:  `[{"Name":"latest","Digest":"sha256:1234567","Roles":["targets/releases"]}]`,
+							},
+						},
+					},
+				}
+
 				rt.Test(rtesting.TableRow{
 					Key: key,
 					Objects: []runtime.Object{
-						&v1alpha1.Build{
-							ObjectMeta: build.ObjectMeta,
-							Spec:       build.Spec,
+						build,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									SignedTargets: []v1alpha1.NotarySignedTarget{
+										{
+											Name:   "latest",
+											Digest: "sha256:1234567",
+											Roles:  []string{"targets/releases"},
+										},
+									},
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										Id:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									LatestImage: identifier,
+									Stack: v1alpha1.BuildStack{
+										RunImage: "somerun/123@sha256:12334563ad",
+										ID:       "io.buildpacks.stacks.bionic",
+									},
+									StepStates:     []corev1.ContainerState{},
+									StepsCompleted: []string{},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "completion", ExitCode: exitCode(0)},
+									},
+								},
+							},
+						},
+					},
+					WantEvents: []string{
+						"Normal BuildSucceeded Build build-name succeeded",
+					},
+				})
+			})
+
+			it("records the duration of each step and the time the build was queued and running", func() {
+				created := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+				at := func(seconds int) metav1.Time {
+					return metav1.NewTime(created.Add(time.Duration(seconds) * time.Second))
+				}
+				took := func(seconds int) *metav1.Duration {
+					return &metav1.Duration{Duration: time.Duration(seconds) * time.Second}
+				}
+
+				timedBuild := build.DeepCopy()
+				timedBuild.CreationTimestamp = at(0)
+
+				pod, err := podGenerator.Generate(context.TODO(), timedBuild)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodSucceeded
+				terminated := func(started, finished int) corev1.ContainerState {
+					return corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{
+							ExitCode:   0,
+							Reason:     "Completed",
+							StartedAt:  at(started),
+							FinishedAt: at(finished),
+						},
+					}
+				}
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{Name: "prepare", State: terminated(30, 35)},
+					{Name: "build", State: terminated(35, 95)},
+				}
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+					{Name: "completion", State: terminated(96, 100)},
+				}
+
+				startedAt := func(seconds int) *metav1.Time {
+					t := at(seconds)
+					return &t
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						timedBuild,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: timedBuild.ObjectMeta,
+								Spec:       timedBuild.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									BuildMetadata: v1alpha1.BuildpackMetadataList{{
+										Id:      "io.buildpack.executed",
+										Version: "1.1",
+									}},
+									LatestImage: identifier,
+									Stack: v1alpha1.BuildStack{
+										RunImage: "somerun/123@sha256:12334563ad",
+										ID:       "io.buildpacks.stacks.bionic",
+									},
+									StepStates: []corev1.ContainerState{
+										terminated(30, 35),
+										terminated(35, 95),
+									},
+									StepsCompleted: []string{
+										"prepare",
+										"build",
+									},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "prepare", StartedAt: startedAt(30), FinishedAt: startedAt(35), Duration: took(5), ExitCode: exitCode(0), Message: "Completed"},
+										{Name: "build", StartedAt: startedAt(35), FinishedAt: startedAt(95), Duration: took(60), ExitCode: exitCode(0), Message: "Completed"},
+										{Name: "completion", StartedAt: startedAt(96), FinishedAt: startedAt(100), Duration: took(4), ExitCode: exitCode(0), Message: "Completed"},
+									},
+									Timing: &v1alpha1.BuildTiming{
+										Queued:  took(30),
+										Running: took(70),
+									},
+								},
+							},
+						},
+					},
+					WantEvents: []string{
+						"Normal BuildSucceeded Build build-name succeeded",
+					},
+				})
+			})
+
+			it("does not fetch metadata if already retrieved", func() {
+				pod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodSucceeded
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "step-1",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode:    0,
+								Reason:      "Terminated",
+								Message:     "Message",
+								ContainerID: "container.ID",
+							},
+						},
+					},
+					{
+						Name: "step-2",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode:    0,
+								Reason:      "Terminated",
+								Message:     "Message",
+								ContainerID: "container.ID2",
+							},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						&v1alpha1.Build{
+							ObjectMeta: build.ObjectMeta,
+							Spec:       build.Spec,
 							Status: v1alpha1.BuildStatus{
 								Status: corev1alpha1.Status{
 									ObservedGeneration: originalGeneration,
 									Conditions: corev1alpha1.Conditions{
 										{
 											Type:   corev1alpha1.ConditionSucceeded,
-											Status: corev1.ConditionFalse,
+											Status: corev1.ConditionTrue,
 										},
 									},
 								},
-								PodName: "build-name-build-pod",
+								BuildMetadata: v1alpha1.BuildpackMetadataList{{
+									Id:      "io.buildpack.previouslyfetched",
+									Version: "1.1",
+								}},
+								PodName:     "build-name-build-pod",
+								LatestImage: "previously/fetched@sha256:abcd",
 								StepStates: []corev1.ContainerState{
 									{
 										Terminated: &corev1.ContainerStateTerminated{
-											ExitCode:    1,
+											ExitCode:    0,
 											Reason:      "Terminated",
-											Message:     "Errors",
+											Message:     "Message",
 											ContainerID: "container.ID",
 										},
 									},
 									{
-										Waiting: &corev1.ContainerStateWaiting{
-											Reason:  "Waiting",
-											Message: "My Turn",
+										Waiting: nil,
+										Running: nil,
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    0,
+											Reason:      "Terminated",
+											Message:     "Message",
+											ContainerID: "container.ID2",
+										},
+									},
+								},
+								StepsCompleted: []string{
+									"step-1",
+									"step-2", //todo realistic names
+								},
+							},
+						},
+						pod,
+					},
+					WantErr: false,
+				})
+				assert.Equal(t, fakeMetadataRetriever.GetBuiltImageCallCount(), 0)
+			})
+
+			it("does not recreate pods if build has finished", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						&v1alpha1.Build{
+							ObjectMeta: build.ObjectMeta,
+							Spec:       build.Spec,
+							Status: v1alpha1.BuildStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: originalGeneration,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								BuildMetadata: v1alpha1.BuildpackMetadataList{{
+									Id:      "io.buildpack.previouslyfetched",
+									Version: "1.1",
+								}},
+								PodName:     "build-name-build-pod",
+								LatestImage: "previously/fetched@sha256:abcd",
+								StepStates: []corev1.ContainerState{
+									{
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    0,
+											Reason:      "Terminated",
+											Message:     "Message",
+											ContainerID: "container.ID",
+										},
+									},
+									{
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    0,
+											Reason:      "Terminated",
+											Message:     "Message",
+											ContainerID: "container.ID2",
 										},
 									},
 								},
 								StepsCompleted: []string{
 									"step-1",
+									"step-2",
 								},
 							},
 						},
@@ -856,16 +1290,742 @@ func testBuildReconciler(t *testing.T, when spec.G, it spec.S) {
 					WantErr: false,
 				})
 			})
+
 		})
 
-	})
+		when("pod failed", func() {
+			it("sets the build status to Failed", func() {
+				pod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodFailed
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{
+						Name: "step-1",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								ExitCode:    1,
+								Reason:      "Terminated",
+								Message:     "Errors",
+								ContainerID: "container.ID",
+							},
+						},
+					},
+					{
+						Name: "step-2",
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{
+								Reason:  "Waiting",
+								Message: "My Turn",
+							},
+						},
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionFalse,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+									StepStates: []corev1.ContainerState{
+										{
+											Terminated: &corev1.ContainerStateTerminated{
+												ExitCode:    1,
+												Reason:      "Terminated",
+												Message:     "Errors",
+												ContainerID: "container.ID",
+											},
+										},
+										{
+											Waiting: &corev1.ContainerStateWaiting{
+												Reason:  "Waiting",
+												Message: "My Turn",
+											},
+										},
+									},
+									StepsCompleted: []string{
+										"step-1",
+									},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "step-1", ExitCode: exitCode(1), Message: "Terminated"},
+										{Name: "step-2", Message: "Waiting"},
+									},
+								},
+							},
+						},
+					},
+					WantEvents: []string{
+						"Warning BuildFailed Build build-name failed",
+					},
+				})
+			})
+
+			it("sets the HookFailed reason when a hook fails", func() {
+				pod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				pod.Status.Phase = corev1.PodFailed
+				hookFailed := corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 3, Reason: "Error"},
+				}
+				pod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+					{Name: "pre-build-unit-tests", State: hookFailed},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+						pod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionFalse,
+												Reason:  "HookFailed",
+												Message: "hook pre-build-unit-tests failed with exit code 3",
+											},
+										},
+									},
+									PodName:        "build-name-build-pod",
+									StepStates:     []corev1.ContainerState{hookFailed},
+									StepsCompleted: []string{"pre-build-unit-tests"},
+									Steps: []v1alpha1.BuildStep{
+										{Name: "pre-build-unit-tests", ExitCode: exitCode(3), Message: "Error"},
+									},
+								},
+							},
+						},
+					},
+					WantEvents: []string{
+						"Warning BuildFailed Build build-name failed: hook pre-build-unit-tests failed with exit code 3",
+					},
+				})
+			})
+
+			it("does not recreate pods if build has finished", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						&v1alpha1.Build{
+							ObjectMeta: build.ObjectMeta,
+							Spec:       build.Spec,
+							Status: v1alpha1.BuildStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: originalGeneration,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionSucceeded,
+											Status: corev1.ConditionFalse,
+										},
+									},
+								},
+								PodName: "build-name-build-pod",
+								StepStates: []corev1.ContainerState{
+									{
+										Terminated: &corev1.ContainerStateTerminated{
+											ExitCode:    1,
+											Reason:      "Terminated",
+											Message:     "Errors",
+											ContainerID: "container.ID",
+										},
+									},
+									{
+										Waiting: &corev1.ContainerStateWaiting{
+											Reason:  "Waiting",
+											Message: "My Turn",
+										},
+									},
+								},
+								StepsCompleted: []string{
+									"step-1",
+								},
+							},
+						},
+					},
+					WantErr: false,
+				})
+			})
+		})
+
+		when("build finished", func() {
+			archiver := &fakeLogArchiver{}
+
+			it.Before(func() {
+				logArchiver = archiver
+			})
+
+			finishedBuild := func() *v1alpha1.Build {
+				return &v1alpha1.Build{
+					ObjectMeta: build.ObjectMeta,
+					Spec:       build.Spec,
+					Status: v1alpha1.BuildStatus{
+						Status: corev1alpha1.Status{
+							ObservedGeneration: originalGeneration,
+							Conditions: corev1alpha1.Conditions{
+								{
+									Type:   corev1alpha1.ConditionSucceeded,
+									Status: corev1.ConditionTrue,
+								},
+							},
+						},
+						PodName: "build-name-build-pod",
+					},
+				}
+			}
+
+			it("queues the logs for archiving while the pod exists", func() {
+				pod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						finishedBuild(),
+						pod,
+					},
+					WantErr: false,
+				})
+
+				require.Equal(t, []string{buildName}, archiver.queued)
+			})
+
+			it("does not archive the logs again", func() {
+				pod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+
+				archived := finishedBuild()
+				archived.Status.LogArchive = "some/archive/build-name.log"
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						archived,
+						pod,
+					},
+					WantErr: false,
+				})
+
+				require.Empty(t, archiver.queued)
+			})
+
+			it("does not archive the logs when the pod is gone", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						finishedBuild(),
+					},
+					WantErr: false,
+				})
+
+				require.Empty(t, archiver.queued)
+			})
+		})
+		when("notifications", func() {
+			fakeNotifier := &fakeNotifier{}
+
+			it.Before(func() {
+				fakeNotifier.notified = nil
+				notifier = fakeNotifier
+			})
+
+			it.After(func() {
+				notifier = nil
+			})
+
+			builtImage := cnb.BuiltImage{
+				Identifier: "someimage/name@sha256:1234567",
+				Stack: cnb.BuiltImageStack{
+					RunImage: "somerun/123@sha256:12334563ad",
+					ID:       "io.buildpacks.stacks.bionic",
+				},
+			}
+
+			statusWithCondition := func(status corev1.ConditionStatus) v1alpha1.BuildStatus {
+				buildStatus := v1alpha1.BuildStatus{
+					Status: corev1alpha1.Status{
+						ObservedGeneration: originalGeneration,
+						Conditions: corev1alpha1.Conditions{
+							{
+								Type:   corev1alpha1.ConditionSucceeded,
+								Status: status,
+							},
+						},
+					},
+					PodName: "build-name-build-pod",
+				}
+				if status == corev1.ConditionTrue {
+					buildStatus.LatestImage = builtImage.Identifier
+					buildStatus.Stack = v1alpha1.BuildStack{
+						RunImage: builtImage.Stack.RunImage,
+						ID:       builtImage.Stack.ID,
+					}
+				}
+				return buildStatus
+			}
+
+			it("notifies that the build started when the pod is created", func() {
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						buildPod,
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     statusWithCondition(corev1.ConditionUnknown),
+							},
+						},
+					},
+				})
+
+				require.Equal(t, []string{notification.BuildStarted}, fakeNotifier.notified)
+			})
+
+			it("does not notify while the build is running", func() {
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				buildPod.Status.Phase = corev1.PodRunning
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+						buildPod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     statusWithCondition(corev1.ConditionUnknown),
+							},
+						},
+					},
+				})
+
+				require.Empty(t, fakeNotifier.notified)
+			})
+
+			it("notifies that the build succeeded", func() {
+				fakeMetadataRetriever.GetBuiltImageReturns(builtImage, nil)
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				buildPod.Status.Phase = corev1.PodSucceeded
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+						buildPod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     statusWithCondition(corev1.ConditionTrue),
+							},
+						},
+					},
+					WantEvents: []string{
+						"Normal BuildSucceeded Build build-name succeeded",
+					},
+				})
+
+				require.Equal(t, []string{notification.BuildSucceeded}, fakeNotifier.notified)
+			})
+
+			it("reports a finished build once when a stale lister reconciles its pod again", func() {
+				metrics.InitForTesting()
+				view.Unregister(kpackmetrics.Views...)
+				require.NoError(t, kpackmetrics.RegisterViews())
+				defer view.Unregister(kpackmetrics.Views...)
+
+				fakeMetadataRetriever.GetBuiltImageReturns(builtImage, nil)
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				buildPod.Status.Phase = corev1.PodSucceeded
+
+				statusUpdates := 0
+				buildUpdateReactor = func(action clientgotesting.Action) (bool, runtime.Object, error) {
+					if action.GetSubresource() != "status" {
+						return false, nil, nil
+					}
+					statusUpdates++
+					if statusUpdates > 1 {
+						// the lister has not observed the first update so the api server rejects its stale copy
+						return true, nil, k8serrors.NewConflict(v1alpha1.Resource("builds"), buildName, errors.New("stale"))
+					}
+					return false, nil, nil
+				}
+				defer func() { buildUpdateReactor = nil }()
+				creatorPhaseReader.forgotten = nil
+
+				r, _, events := newReconciler(t, &rtesting.TableRow{Objects: []runtime.Object{build, buildPod}})
+
+				require.NoError(t, r.Reconcile(context.TODO(), key))
+				require.Error(t, r.Reconcile(context.TODO(), key))
+
+				require.Equal(t, 2, statusUpdates)
+				require.Equal(t, []string{notification.BuildSucceeded}, fakeNotifier.notified)
+				require.Len(t, events.Recorder.Events, 1)
+				require.Equal(t, []string{buildPod.Name}, creatorPhaseReader.forgotten)
+				metricstest.CheckCountData(t, "build_count", map[string]string{
+					"namespace": namespace,
+					"builder":   "somebuilder/123",
+					"result":    "succeeded",
+				}, 1)
+			})
+
+			it("notifies that the build was rebased when the rebase pod succeeded", func() {
+				fakeMetadataRetriever.GetBuiltImageReturns(builtImage, nil)
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				buildPod.Spec.InitContainers = []corev1.Container{{Name: v1alpha1.RebaseContainerName}}
+				buildPod.Status.Phase = corev1.PodSucceeded
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+						buildPod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     statusWithCondition(corev1.ConditionTrue),
+							},
+						},
+					},
+					WantEvents: []string{
+						"Normal BuildSucceeded Build build-name succeeded",
+					},
+				})
+
+				require.Equal(t, []string{notification.BuildRebased}, fakeNotifier.notified)
+			})
+
+			it("notifies that the build failed", func() {
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+				buildPod.Status.Phase = corev1.PodFailed
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+						buildPod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status:     statusWithCondition(corev1.ConditionFalse),
+							},
+						},
+					},
+					WantEvents: []string{
+						"Warning BuildFailed Build build-name failed",
+					},
+				})
+
+				require.Equal(t, []string{notification.BuildFailed}, fakeNotifier.notified)
+			})
+		})
+
+		when("admission queue", func() {
+			fakeQueue := &fakeBuildQueue{}
+
+			it.Before(func() {
+				*fakeQueue = fakeBuildQueue{}
+				buildQueue = fakeQueue
+			})
+
+			it.After(func() {
+				buildQueue = nil
+				podCreateErr = nil
+			})
+
+			it("keeps the build pending without a pod while it is queued", func() {
+				fakeQueue.admission = buildqueue.Admission{Position: 3}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionUnknown,
+												Reason:  v1alpha1.BuildPending,
+												Message: "waiting for a build slot at queue position 3",
+											},
+										},
+									},
+									QueuePosition: 3,
+								},
+							},
+						},
+					},
+				})
+
+				require.Equal(t, []string{build.Name}, fakeQueue.asked)
+			})
+
+			it("keeps the build waiting without a pod while it exceeds a quota", func() {
+				fakeQueue.admission = buildqueue.Admission{QuotaExceeded: "exceeded quota: some-quota"}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionSucceeded,
+												Status:  corev1.ConditionUnknown,
+												Reason:  v1alpha1.BuildQuotaExceeded,
+												Message: "exceeded quota: some-quota",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("creates the pod once the build is admitted", func() {
+				fakeQueue.admission = buildqueue.Admission{Admitted: true}
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+
+				pendingBuild := build.DeepCopy()
+				pendingBuild.Status.Pending(1)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						pendingBuild,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						buildPod,
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionUnknown,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("releases the admission when the pod can not be created", func() {
+				fakeQueue.admission = buildqueue.Admission{Admitted: true}
+				podCreateErr = errors.New("some create error")
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+					},
+					WantErr: true,
+					WantCreates: []runtime.Object{
+						buildPod,
+					},
+				})
+
+				require.Equal(t, []string{build.Name}, fakeQueue.released)
+			})
+
+			it("keeps the admission when the pod already exists", func() {
+				fakeQueue.admission = buildqueue.Admission{Admitted: true}
+				podCreateErr = k8serrors.NewAlreadyExists(corev1.Resource("pods"), build.PodName())
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+					},
+					WantErr: true,
+					WantCreates: []runtime.Object{
+						buildPod,
+					},
+				})
+
+				require.Empty(t, fakeQueue.released)
+			})
+
+			it("does not queue builds that have a pod", func() {
+				buildPod, err := podGenerator.Generate(context.TODO(), build)
+				require.NoError(t, err)
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						build,
+						buildPod,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Build{
+								ObjectMeta: build.ObjectMeta,
+								Spec:       build.Spec,
+								Status: v1alpha1.BuildStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionSucceeded,
+												Status: corev1.ConditionUnknown,
+											},
+										},
+									},
+									PodName: "build-name-build-pod",
+								},
+							},
+						},
+					},
+				})
+
+				require.Empty(t, fakeQueue.asked)
+			})
+		})
+	})
+}
+
+type fakeLogArchiver struct {
+	queued []string
+}
+
+func (f *fakeLogArchiver) Enqueue(build *v1alpha1.Build) {
+	f.queued = append(f.queued, build.Name)
+}
+
+type fakeNotifier struct {
+	notified []string
+}
+
+func (f *fakeNotifier) Notify(eventType string, _ *v1alpha1.Build) {
+	f.notified = append(f.notified, eventType)
+}
+
+type fakeBuildQueue struct {
+	admission buildqueue.Admission
+	asked     []string
+	released  []string
+}
+
+func (f *fakeBuildQueue) Admit(build *v1alpha1.Build) (buildqueue.Admission, error) {
+	f.asked = append(f.asked, build.Name)
+	return f.admission, nil
+}
+
+func (f *fakeBuildQueue) Release(build *v1alpha1.Build) {
+	f.released = append(f.released, build.Name)
+}
+
+type fakeCreatorPhaseReader struct {
+	phases    []logs.CreatorPhase
+	err       error
+	forgotten []string
+}
+
+func (f *fakeCreatorPhaseReader) Phases(*corev1.Pod) ([]logs.CreatorPhase, error) {
+	return f.phases, f.err
+}
+
+func (f *fakeCreatorPhaseReader) Forget(pod *corev1.Pod) {
+	f.forgotten = append(f.forgotten, pod.Name)
 }
 
 type testPodGenerator struct {
 	returnErr error
 }
 
-func (tpg testPodGenerator) Generate(build buildpod.BuildPodable) (*corev1.Pod, error) {
+func (tpg testPodGenerator) Generate(_ context.Context, build buildpod.BuildPodable) (*corev1.Pod, error) {
 	if tpg.returnErr != nil {
 		return nil, tpg.returnErr
 	}
@@ -2,12 +2,17 @@ package builder
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/label"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/controller"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
@@ -16,8 +21,10 @@ import (
 	v1alpha1informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
 	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/cnb"
+	"github.com/pivotal/kpack/pkg/metrics"
 	"github.com/pivotal/kpack/pkg/reconciler"
 	"github.com/pivotal/kpack/pkg/registry"
+	"github.com/pivotal/kpack/pkg/tracing"
 	"github.com/pivotal/kpack/pkg/tracker"
 )
 
@@ -41,6 +48,7 @@ func NewController(opt reconciler.Options,
 ) *controller.Impl {
 	c := &Reconciler{
 		Client:             opt.Client,
+		EventRecorder:      opt.EventRecorder,
 		BuilderLister:      builderInformer.Lister(),
 		BuilderCreator:     builderCreator,
 		KeychainFactory:    keychainFactory,
@@ -59,6 +67,7 @@ func NewController(opt reconciler.Options,
 
 type Reconciler struct {
 	Client             versioned.Interface
+	EventRecorder      record.EventRecorder
 	BuilderLister      v1alpha1Listers.BuilderLister
 	BuilderCreator     BuilderCreator
 	KeychainFactory    registry.KeychainFactory
@@ -84,6 +93,7 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 
 	builderRecord, creationError := c.reconcileBuilder(builder)
 	if creationError != nil {
+		c.EventRecorder.Eventf(builder, corev1.EventTypeWarning, reconciler.BuilderCreateFailedReason, "Failed to create builder: %s", creationError)
 		builder.Status.ErrorCreate(creationError)
 
 		err := c.updateStatus(builder)
@@ -94,6 +104,10 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 		return controller.NewPermanentError(creationError)
 	}
 
+	if builderRecord.Image != builder.Status.LatestImage {
+		c.EventRecorder.Eventf(builder, corev1.EventTypeNormal, reconciler.BuilderImagePushedReason, "Pushed builder image %s", builderRecord.Image)
+	}
+
 	builder.Status.BuilderRecord(builderRecord)
 	return c.updateStatus(builder)
 }
@@ -131,7 +145,15 @@ func (c *Reconciler) reconcileBuilder(builder *v1alpha1.Builder) (v1alpha1.Build
 		return v1alpha1.BuilderRecord{}, err
 	}
 
-	return c.BuilderCreator.CreateBuilder(keychain, clusterStore, clusterStack, builder.Spec.BuilderSpec)
+	_, span := tracing.StartSpan(context.Background(), "builder.create", trace.WithAttributes(
+		label.String("builder", builder.Name),
+		label.String("tag", builder.Spec.Tag),
+	))
+	start := time.Now()
+	builderRecord, err := c.BuilderCreator.CreateBuilder(keychain, clusterStore, clusterStack, builder.Spec.BuilderSpec)
+	metrics.RecordBuilderCreate(Kind, time.Since(start), err)
+	tracing.EndSpan(span, err)
+	return builderRecord, err
 }
 
 func (c *Reconciler) updateStatus(desired *v1alpha1.Builder) error {
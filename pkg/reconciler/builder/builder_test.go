@@ -48,8 +48,10 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
 			listers := testhelpers.NewListers(row.Objects)
 			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+			eventRecorder := record.NewFakeRecorder(10)
 			r := &builder.Reconciler{
 				Client:             fakeClient,
+				EventRecorder:      eventRecorder,
 				BuilderLister:      listers.GetBuilderLister(),
 				BuilderCreator:     builderCreator,
 				KeychainFactory:    keychainFactory,
@@ -57,7 +59,7 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 				ClusterStoreLister: listers.GetClusterStoreLister(),
 				ClusterStackLister: listers.GetClusterStackLister(),
 			}
-			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: eventRecorder}
 		})
 
 	clusterStore := &v1alpha1.ClusterStore{
@@ -204,6 +206,9 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 						Object: expectedBuilder,
 					},
 				},
+				WantEvents: []string{
+					"Normal BuilderImagePushed Pushed builder image example.com/custom-builder@sha256:resolved-builder-digest",
+				},
 			})
 
 			assert.Equal(t, []testhelpers.CreateBuilderArgs{{
@@ -340,6 +345,9 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 				},
+				WantEvents: []string{
+					"Warning BuilderCreateFailed Failed to create builder: create error",
+				},
 			})
 
 		})
@@ -389,6 +397,9 @@ func testBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 				},
+				WantEvents: []string{
+					"Warning BuilderCreateFailed Failed to create builder: stack some-stack is not ready",
+				},
 			})
 
 			//still track resources
@@ -0,0 +1,149 @@
+package buildquota
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	corev1Informers "k8s.io/client-go/informers/core/v1"
+	corev1Listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	v1alpha1Informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
+	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/reconciler"
+)
+
+const (
+	ReconcilerName = "BuildQuotas"
+	Kind           = "BuildQuota"
+)
+
+func NewController(opt reconciler.Options, buildQuotaInformer v1alpha1Informers.BuildQuotaInformer, podInformer corev1Informers.PodInformer, imageInformer v1alpha1Informers.ImageInformer) *controller.Impl {
+	c := &Reconciler{
+		Client:           opt.Client,
+		BuildQuotaLister: buildQuotaInformer.Lister(),
+		PodLister:        podInformer.Lister(),
+		ImageLister:      imageInformer.Lister(),
+	}
+	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
+	buildQuotaInformer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
+
+	// build pods and images change the usage of the quotas in their namespace
+	enqueueNamespaceQuotas := func(obj interface{}) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			return
+		}
+		namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+
+		quotas, err := c.BuildQuotaLister.BuildQuotas(namespace).List(labels.Everything())
+		if err != nil {
+			return
+		}
+		for _, quota := range quotas {
+			impl.Enqueue(quota)
+		}
+	}
+	podInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.Filter(v1alpha1.SchemeGroupVersion.WithKind("Build")),
+		Handler:    reconciler.Handler(enqueueNamespaceQuotas),
+	})
+	imageInformer.Informer().AddEventHandler(reconciler.Handler(enqueueNamespaceQuotas))
+	return impl
+}
+
+type Reconciler struct {
+	Client           versioned.Interface
+	BuildQuotaLister v1alpha1Listers.BuildQuotaLister
+	PodLister        corev1Listers.PodLister
+	ImageLister      v1alpha1Listers.ImageLister
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	quota, err := c.BuildQuotaLister.BuildQuotas(namespace).Get(name)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	quota = quota.DeepCopy()
+
+	used, err := c.usage(namespace)
+	if err != nil {
+		return err
+	}
+
+	quota.Status = v1alpha1.BuildQuotaStatus{
+		Status: corev1alpha1.Status{
+			Conditions: corev1alpha1.Conditions{
+				{
+					LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+					Type:               corev1alpha1.ConditionReady,
+					Status:             corev1.ConditionTrue,
+				},
+			},
+		},
+		Used: used,
+	}
+
+	return c.updateStatus(quota)
+}
+
+// usage counts the effective requests of the running build pods of the namespace and the caches of its images
+func (c *Reconciler) usage(namespace string) (v1alpha1.BuildQuotaUsage, error) {
+	used := v1alpha1.BuildQuotaUsage{}
+
+	hasBuild, err := labels.NewRequirement(v1alpha1.BuildLabel, selection.Exists, nil)
+	if err != nil {
+		return used, err
+	}
+	pods, err := c.PodLister.Pods(namespace).List(labels.NewSelector().Add(*hasBuild))
+	if err != nil {
+		return used, err
+	}
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			used.AddRequests(v1alpha1.PodRequests(pod))
+		}
+	}
+
+	images, err := c.ImageLister.Images(namespace).List(labels.Everything())
+	if err != nil {
+		return used, err
+	}
+	for _, image := range images {
+		used.AddImage(image)
+	}
+	return used, nil
+}
+
+func (c *Reconciler) updateStatus(desired *v1alpha1.BuildQuota) error {
+	desired.Status.ObservedGeneration = desired.Generation
+
+	original, err := c.BuildQuotaLister.BuildQuotas(desired.Namespace).Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.KpackV1alpha1().BuildQuotas(desired.Namespace).UpdateStatus(desired)
+	return err
+}
@@ -0,0 +1,179 @@
+package buildquota_test
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	"github.com/pivotal/kpack/pkg/reconciler/buildquota"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+)
+
+func TestBuildQuotaReconciler(t *testing.T) {
+	spec.Run(t, "BuildQuota Reconciler", testBuildQuotaReconciler)
+}
+
+func testBuildQuotaReconciler(t *testing.T, when spec.G, it spec.S) {
+	const (
+		namespace               = "some-namespace"
+		quotaName               = "some-quota"
+		key                     = "some-namespace/some-quota"
+		initialGeneration int64 = 1
+	)
+
+	concurrentBuilds := int64(3)
+	cpu := resource.MustParse("4")
+
+	quota := &v1alpha1.BuildQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       quotaName,
+			Namespace:  namespace,
+			Generation: initialGeneration,
+		},
+		Spec: v1alpha1.BuildQuotaSpec{
+			ConcurrentBuilds: &concurrentBuilds,
+			CPU:              &cpu,
+		},
+	}
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
+			listers := testhelpers.NewListers(row.Objects)
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+			r := &buildquota.Reconciler{
+				Client:           fakeClient,
+				BuildQuotaLister: listers.GetBuildQuotaLister(),
+				PodLister:        listers.GetPodLister(),
+				ImageLister:      listers.GetImageLister(),
+			}
+			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+		})
+
+	requests := func(cpu, memory string) corev1.ResourceRequirements {
+		return corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		}
+	}
+
+	// buildPod runs steps that request 500m cpu and 1Gi memory at most, the completion container requests less
+	buildPod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					v1alpha1.BuildLabel: name + "-build",
+				},
+			},
+			Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{
+					{Name: "prepare", Resources: requests("100m", "128Mi")},
+					{Name: "build", Resources: requests("500m", "1Gi")},
+				},
+				Containers: []corev1.Container{
+					{Name: "completion", Resources: requests("100m", "128Mi")},
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	cacheSize := resource.MustParse("2G")
+	image := &v1alpha1.Image{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-image",
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.ImageSpec{
+			CacheSize: &cacheSize,
+		},
+	}
+
+	when("#Reconcile", func() {
+		it("reports the usage of the running builds and image caches of the namespace", func() {
+			otherNamespacePod := buildPod("other-namespace", corev1.PodRunning)
+			otherNamespacePod.Namespace = "other-namespace"
+			notABuild := buildPod("not-a-build", corev1.PodRunning)
+			notABuild.Labels = nil
+
+			rt.Test(rtesting.TableRow{
+				Key: key,
+				Objects: []runtime.Object{
+					quota,
+					image,
+					buildPod("running-1", corev1.PodRunning),
+					buildPod("running-2", corev1.PodPending),
+					buildPod("succeeded", corev1.PodSucceeded),
+					buildPod("failed", corev1.PodFailed),
+					otherNamespacePod,
+					notABuild,
+				},
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &v1alpha1.BuildQuota{
+							ObjectMeta: quota.ObjectMeta,
+							Spec:       quota.Spec,
+							Status: v1alpha1.BuildQuotaStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: initialGeneration,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionReady,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								Used: v1alpha1.BuildQuotaUsage{
+									ConcurrentBuilds: 2,
+									CPU:              resource.MustParse("1"),
+									Memory:           resource.MustParse("2Gi"),
+									CacheStorage:     resource.MustParse("2G"),
+								},
+							},
+						},
+					},
+				},
+			})
+		})
+
+		it("does not update the status when the usage did not change", func() {
+			reconciled := quota.DeepCopy()
+			reconciled.Status = v1alpha1.BuildQuotaStatus{
+				Status: corev1alpha1.Status{
+					ObservedGeneration: initialGeneration,
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+			}
+
+			rt.Test(rtesting.TableRow{
+				Key: key,
+				Objects: []runtime.Object{
+					reconciled,
+				},
+				WantErr: false,
+			})
+		})
+	})
+}
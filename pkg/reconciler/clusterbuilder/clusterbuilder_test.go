@@ -47,8 +47,10 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
 			listers := testhelpers.NewListers(row.Objects)
 			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+			eventRecorder := record.NewFakeRecorder(10)
 			r := &clusterBuilder.Reconciler{
 				Client:               fakeClient,
+				EventRecorder:        eventRecorder,
 				ClusterBuilderLister: listers.GetClusterBuilderLister(),
 				BuilderCreator:       builderCreator,
 				KeychainFactory:      keychainFactory,
@@ -56,7 +58,7 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 				ClusterStoreLister:   listers.GetClusterStoreLister(),
 				ClusterStackLister:   listers.GetClusterStackLister(),
 			}
-			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: eventRecorder}
 		})
 
 	clusterStore := &v1alpha1.ClusterStore{
@@ -205,6 +207,9 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 						Object: expectedBuilder,
 					},
 				},
+				WantEvents: []string{
+					"Normal BuilderImagePushed Pushed builder image example.com/custom-builder@sha256:resolved-builder-digest",
+				},
 			})
 
 			assert.Equal(t, []testhelpers.CreateBuilderArgs{{
@@ -343,6 +348,9 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 						Object: expectedBuilder,
 					},
 				},
+				WantEvents: []string{
+					"Warning BuilderCreateFailed Failed to create builder: create error",
+				},
 			})
 		})
 
@@ -391,6 +399,9 @@ func testClusterBuilderReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 				},
+				WantEvents: []string{
+					"Warning BuilderCreateFailed Failed to create builder: stack some-stack is not ready",
+				},
 			})
 
 			//still track resources
@@ -8,6 +8,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/controller"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
@@ -31,6 +32,7 @@ type ClusterStackReader interface {
 func NewController(opt reconciler.Options, clusterStackInformer v1alpha1Informers.ClusterStackInformer, clusterStackReader ClusterStackReader) *controller.Impl {
 	c := &Reconciler{
 		Client:             opt.Client,
+		EventRecorder:      opt.EventRecorder,
 		ClusterStackLister: clusterStackInformer.Lister(),
 		ClusterStackReader: clusterStackReader,
 	}
@@ -41,6 +43,7 @@ func NewController(opt reconciler.Options, clusterStackInformer v1alpha1Informer
 
 type Reconciler struct {
 	Client             versioned.Interface
+	EventRecorder      record.EventRecorder
 	ClusterStackLister v1alpha1Listers.ClusterStackLister
 	ClusterStackReader ClusterStackReader
 }
@@ -76,6 +79,7 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 func (c *Reconciler) reconcileClusterStackStatus(clusterStack *v1alpha1.ClusterStack) (*v1alpha1.ClusterStack, error) {
 	resolvedClusterStack, err := c.ClusterStackReader.Read(clusterStack.Spec)
 	if err != nil {
+		c.EventRecorder.Eventf(clusterStack, corev1.EventTypeWarning, reconciler.StackResolutionFailedReason, "Failed to resolve stack: %s", err)
 		clusterStack.Status = v1alpha1.ClusterStackStatus{
 			Status: corev1alpha1.Status{
 				ObservedGeneration: clusterStack.Generation,
@@ -92,6 +96,10 @@ func (c *Reconciler) reconcileClusterStackStatus(clusterStack *v1alpha1.ClusterS
 		return clusterStack, err
 	}
 
+	if !equality.Semantic.DeepEqual(resolvedClusterStack, clusterStack.Status.ResolvedClusterStack) {
+		c.EventRecorder.Eventf(clusterStack, corev1.EventTypeNormal, reconciler.StackResolvedReason, "Resolved stack %s with build image %s and run image %s", resolvedClusterStack.Id, resolvedClusterStack.BuildImage.LatestImage, resolvedClusterStack.RunImage.LatestImage)
+	}
+
 	clusterStack.Status = v1alpha1.ClusterStackStatus{
 		Status: corev1alpha1.Status{
 			ObservedGeneration: clusterStack.Generation,
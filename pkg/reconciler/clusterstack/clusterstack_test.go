@@ -55,12 +55,14 @@ func testClusterStackReconciler(t *testing.T, when spec.G, it spec.S) {
 		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
 			listers := testhelpers.NewListers(row.Objects)
 			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+			eventRecorder := record.NewFakeRecorder(10)
 			r := &clusterstack.Reconciler{
 				Client:             fakeClient,
+				EventRecorder:      eventRecorder,
 				ClusterStackLister: listers.GetClusterStackLister(),
 				ClusterStackReader: fakeClusterStackReader,
 			}
-			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: eventRecorder}
 		})
 
 	when("#Reconcile", func() {
@@ -104,6 +106,9 @@ func testClusterStackReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 				},
+				WantEvents: []string{
+					"Normal StackResolved Resolved stack  with build image some-registry.io/build-image@sha245:123 and run image some-registry.io/run-image@sha245:123",
+				},
 			})
 
 			require.Equal(t, 1, fakeClusterStackReader.ReadCallCount())
@@ -174,6 +179,9 @@ func testClusterStackReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 				},
+				WantEvents: []string{
+					"Warning StackResolutionFailed Failed to resolve stack: invalid mixins on run image",
+				},
 			})
 		})
 	})
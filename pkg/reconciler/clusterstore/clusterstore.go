@@ -8,6 +8,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/controller"
 
 	v1alpha1 "github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
@@ -31,6 +32,7 @@ type StoreReader interface {
 func NewController(opt reconciler.Options, clusterStoreInformer v1alpha1expInformers.ClusterStoreInformer, storeReader StoreReader) *controller.Impl {
 	c := &Reconciler{
 		Client:             opt.Client,
+		EventRecorder:      opt.EventRecorder,
 		ClusterStoreLister: clusterStoreInformer.Lister(),
 		StoreReader:        storeReader,
 	}
@@ -41,6 +43,7 @@ func NewController(opt reconciler.Options, clusterStoreInformer v1alpha1expInfor
 
 type Reconciler struct {
 	Client             versioned.Interface
+	EventRecorder      record.EventRecorder
 	StoreReader        StoreReader
 	ClusterStoreLister v1alpha1expListers.ClusterStoreLister
 }
@@ -92,6 +95,7 @@ func (c *Reconciler) updateClusterStoreStatus(desired *v1alpha1.ClusterStore) er
 func (c *Reconciler) reconcileClusterStoreStatus(clusterStore *v1alpha1.ClusterStore) (*v1alpha1.ClusterStore, error) {
 	buildpacks, err := c.StoreReader.Read(clusterStore.Spec.Sources)
 	if err != nil {
+		c.EventRecorder.Eventf(clusterStore, corev1.EventTypeWarning, reconciler.StoreResolutionFailedReason, "Failed to resolve store: %s", err)
 		clusterStore.Status = v1alpha1.ClusterStoreStatus{
 			Status: corev1alpha1.Status{
 				ObservedGeneration: clusterStore.Generation,
@@ -108,6 +112,10 @@ func (c *Reconciler) reconcileClusterStoreStatus(clusterStore *v1alpha1.ClusterS
 		return clusterStore, err
 	}
 
+	if !equality.Semantic.DeepEqual(buildpacks, clusterStore.Status.Buildpacks) {
+		c.EventRecorder.Eventf(clusterStore, corev1.EventTypeNormal, reconciler.StoreResolvedReason, "Resolved %d buildpacks", len(buildpacks))
+	}
+
 	clusterStore.Status = v1alpha1.ClusterStoreStatus{
 		Buildpacks: buildpacks,
 		Status: corev1alpha1.Status{
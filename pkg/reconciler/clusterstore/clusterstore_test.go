@@ -42,12 +42,14 @@ func testClusterStoreReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
 
+			eventRecorder := record.NewFakeRecorder(10)
 			r := &clusterstore.Reconciler{
 				Client:             fakeClient,
+				EventRecorder:      eventRecorder,
 				StoreReader:        fakeStoreReader,
 				ClusterStoreLister: listers.GetClusterStoreLister(),
 			}
-			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: eventRecorder}
 		})
 
 	store := &v1alpha1.ClusterStore{
@@ -122,6 +124,9 @@ func testClusterStoreReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 				},
+				WantEvents: []string{
+					"Normal StoreResolved Resolved 2 buildpacks",
+				},
 			})
 
 			assert.Equal(t, 1, fakeStoreReader.ReadCallCount())
@@ -182,6 +187,9 @@ func testClusterStoreReconciler(t *testing.T, when spec.G, it spec.S) {
 						},
 					},
 				},
+				WantEvents: []string{
+					"Warning StoreResolutionFailed Failed to resolve store: no buildpacks left",
+				},
 			})
 		})
 	})
@@ -0,0 +1,42 @@
+package reconciler
+
+import (
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	k8sclient "k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/scheme"
+)
+
+const (
+	BuildScheduledReason        = "BuildScheduled"
+	BuildSucceededReason        = "BuildSucceeded"
+	BuildFailedReason           = "BuildFailed"
+	BuilderImagePushedReason    = "BuilderImagePushed"
+	BuilderCreateFailedReason   = "BuilderCreateFailed"
+	StoreResolvedReason         = "StoreResolved"
+	StoreResolutionFailedReason = "StoreResolutionFailed"
+	StackResolvedReason         = "StackResolved"
+	StackResolutionFailedReason = "StackResolutionFailed"
+
+	eventComponent = "kpack-controller"
+
+	// each object may record a burst of events, after which one event is recorded every eventRefillSeconds
+	eventBurstSize     = 10
+	eventRefillSeconds = 60
+)
+
+// NewEventRecorder returns a recorder that writes the events of kpack resources to the api server.
+// Similar events are aggregated and the events of each object are rate limited.
+func NewEventRecorder(k8sClient k8sclient.Interface, logger *zap.SugaredLogger) record.EventRecorder {
+	broadcaster := record.NewBroadcasterWithCorrelatorOptions(record.CorrelatorOptions{
+		BurstSize: eventBurstSize,
+		QPS:       1.0 / eventRefillSeconds,
+	})
+	broadcaster.StartLogging(logger.Named("event-broadcaster").Infof)
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: k8sClient.CoreV1().Events("")})
+
+	return broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: eventComponent})
+}
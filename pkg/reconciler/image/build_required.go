@@ -27,23 +27,39 @@ func newBuildRequiredResult(summary buildchange.ChangeSummary) buildRequiredResu
 	return result
 }
 
+// RolloutGate holds back the STACK and BUILDPACK rebuilds of images during a staged rollout
+type RolloutGate interface {
+	Admit(image *v1alpha1.Image) (bool, error)
+}
+
 func isBuildRequired(img *v1alpha1.Image,
 	lastBuild *v1alpha1.Build,
 	srcResolver *v1alpha1.SourceResolver,
-	builder v1alpha1.BuilderResource) (buildRequiredResult, error) {
+	builder v1alpha1.BuilderResource,
+	rollout RolloutGate) (buildRequiredResult, error) {
 
 	result := buildRequiredResult{ConditionStatus: corev1.ConditionUnknown}
 	if !srcResolver.Ready() || !builder.Ready() {
 		return result, nil
 	}
 
-	changeSummary, err := buildchange.NewChangeProcessor().
+	processor := buildchange.NewChangeProcessor().
 		Process(triggerChange(lastBuild)).
 		Process(commitChange(lastBuild, srcResolver)).
-		Process(configChange(img, lastBuild, srcResolver)).
-		Process(buildpackChange(lastBuild, builder)).
-		Process(stackChange(lastBuild, builder)).
-		Summarize()
+		Process(configChange(img, lastBuild, srcResolver))
+
+	admitted, err := rolloutAdmitted(img, lastBuild, builder, rollout)
+	if err != nil {
+		return result, err
+	}
+
+	if admitted {
+		processor = processor.
+			Process(buildpackChange(lastBuild, builder)).
+			Process(stackChange(lastBuild, builder))
+	}
+
+	changeSummary, err := processor.Summarize()
 	if err != nil {
 		return result, err
 	}
@@ -51,6 +67,14 @@ func isBuildRequired(img *v1alpha1.Image,
 	return newBuildRequiredResult(changeSummary), nil
 }
 
+// rolloutAdmitted only asks the rollout for images that were not built with the stack and buildpacks of the builder
+func rolloutAdmitted(img *v1alpha1.Image, lastBuild *v1alpha1.Build, builder v1alpha1.BuilderResource, rollout RolloutGate) (bool, error) {
+	if rollout == nil || lastBuild == nil || !lastBuild.Outdated(builder) {
+		return true, nil
+	}
+	return rollout.Admit(img)
+}
+
 func triggerChange(lastBuild *v1alpha1.Build) buildchange.Change {
 	if lastBuild == nil || lastBuild.Annotations == nil {
 		return nil
@@ -66,14 +90,23 @@ func triggerChange(lastBuild *v1alpha1.Build) buildchange.Change {
 }
 
 func commitChange(lastBuild *v1alpha1.Build, srcResolver *v1alpha1.SourceResolver) buildchange.Change {
-	// If the lastBuild was not a Git source, then it is not a COMMIT change
-	if lastBuild == nil || lastBuild.Spec.Source.Git == nil || srcResolver.Status.Source.Git == nil {
+	if lastBuild == nil {
 		return nil
 	}
 
-	oldRevision := lastBuild.Spec.Source.Git.Revision
-	newRevision := srcResolver.Status.Source.Git.Revision
-	return buildchange.NewCommitChange(oldRevision, newRevision)
+	switch {
+	case lastBuild.Spec.Source.Git != nil && srcResolver.Status.Source.Git != nil:
+		oldRevision := lastBuild.Spec.Source.Git.Revision
+		newRevision := srcResolver.Status.Source.Git.Revision
+		return buildchange.NewCommitChange(oldRevision, newRevision)
+	case lastBuild.Spec.Source.Artifact != nil && srcResolver.Status.Source.Artifact != nil:
+		oldRevision := lastBuild.Spec.Source.Artifact.Revision
+		newRevision := srcResolver.Status.Source.Artifact.Revision
+		return buildchange.NewCommitChange(oldRevision, newRevision)
+	default:
+		// If the lastBuild was not a Git or Artifact source, then it is not a COMMIT change
+		return nil
+	}
 }
 
 func configChange(img *v1alpha1.Image, lastBuild *v1alpha1.Build, srcResolver *v1alpha1.SourceResolver) buildchange.Change {
@@ -82,18 +115,22 @@ func configChange(img *v1alpha1.Image, lastBuild *v1alpha1.Build, srcResolver *v
 
 	if lastBuild != nil {
 		old = buildchange.Config{
-			Env:       lastBuild.Spec.Env,
-			Resources: lastBuild.Spec.Resources,
-			Bindings:  lastBuild.Spec.Bindings,
-			Source:    lastBuild.Spec.Source,
+			Env:           lastBuild.Spec.Env,
+			Resources:     lastBuild.Spec.Resources,
+			StepResources: lastBuild.Spec.StepResources,
+			Hooks:         lastBuild.Spec.Hooks,
+			Bindings:      lastBuild.Spec.Bindings,
+			Source:        lastBuild.Spec.Source,
 		}
 	}
 
 	new = buildchange.Config{
-		Env:       img.Env(),
-		Resources: img.Resources(),
-		Bindings:  img.Bindings(),
-		Source:    srcResolver.Status.Source.ResolvedSource().SourceConfig(),
+		Env:           img.Env(),
+		Resources:     img.Resources(),
+		StepResources: img.StepResources(),
+		Hooks:         img.Hooks(),
+		Bindings:      img.Bindings(),
+		Source:        srcResolver.Status.Source.ResolvedSource().SourceConfig(),
 	}
 
 	return buildchange.NewConfigChange(old, new)
@@ -8,6 +8,7 @@ import (
 	"github.com/sclevine/spec"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
@@ -111,7 +112,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 		}
 
 		it("false for no changes", func() {
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
 			assert.Equal(t, "", result.ReasonsStr)
@@ -121,7 +122,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 		it("false for different ServiceAccount", func() {
 			image.Spec.ServiceAccount = "different"
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
 			assert.Equal(t, "", result.ReasonsStr)
@@ -164,7 +165,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 			assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
@@ -214,7 +215,55 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
+			assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
+			assert.Equal(t, expectedChanges, result.ChangesStr)
+		})
+
+		it("true if build step resources changes", func() {
+			image.Spec.Build = &v1alpha1.ImageBuild{
+				StepResources: &v1alpha1.StepResources{
+					Build: &corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1G")},
+					},
+				},
+			}
+
+			expectedChanges := testhelpers.CompactJSON(`
+[
+  {
+    "reason": "CONFIG",
+    "old": {
+      "resources": {},
+      "source": {
+        "git": {
+          "url": "https://some.git/url",
+          "revision": "revision"
+        }
+      }
+    },
+    "new": {
+      "resources": {},
+      "stepResources": {
+        "build": {
+          "limits": {
+            "memory": "1G"
+          }
+        }
+      },
+      "source": {
+        "git": {
+          "url": "https://some.git/url",
+          "revision": "revision"
+        }
+      }
+    }
+  }
+]`)
+
+			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 			assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
@@ -234,7 +283,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				Stack: v1alpha1.BuildStack{},
 			}
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
 			assert.Equal(t, "", result.ReasonsStr)
@@ -246,7 +295,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				v1alpha1.BuildNeededAnnotation: "true",
 			}
 
-			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+			result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 			assert.Equal(t, v1alpha1.BuildReasonTrigger, result.ReasonsStr)
@@ -267,7 +316,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 					{Id: "buildpack.unused", Version: "unused"},
 				}
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
 				assert.Equal(t, "", result.ReasonsStr)
@@ -294,7 +343,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonBuildpack, result.ReasonsStr)
@@ -321,7 +370,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonBuildpack, result.ReasonsStr)
@@ -340,12 +389,46 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonStack, result.ReasonsStr)
 				assert.Equal(t, expectedChanges, result.ChangesStr)
 			})
+
+			when("a rollout holds the image", func() {
+				rollout := &fakeRolloutGate{admitted: false}
+
+				it.Before(func() {
+					builder.LatestRunImage = "some.registry.io/run-image@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"
+				})
+
+				it("false for a different run image", func() {
+					result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, rollout)
+					assert.NoError(t, err)
+					assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
+					assert.Equal(t, "", result.ReasonsStr)
+					assert.Equal(t, image, rollout.image)
+				})
+
+				it("true with only a CONFIG change", func() {
+					image.Spec.Build = &v1alpha1.ImageBuild{
+						Env: []corev1.EnvVar{{Name: "keyA", Value: "new"}},
+					}
+
+					result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, rollout)
+					assert.NoError(t, err)
+					assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
+					assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
+				})
+
+				it("true for a different run image once admitted", func() {
+					result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, &fakeRolloutGate{admitted: true})
+					assert.NoError(t, err)
+					assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
+					assert.Equal(t, v1alpha1.BuildReasonStack, result.ReasonsStr)
+				})
+			})
 		})
 
 		when("Git", func() {
@@ -377,7 +460,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
@@ -413,7 +496,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
@@ -432,7 +515,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonCommit, result.ReasonsStr)
@@ -447,7 +530,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 						Status: corev1.ConditionFalse,
 					}}
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.ReasonsStr)
@@ -458,7 +541,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				sourceResolver.Status.Source.Git.URL = "some-change"
 				builder.BuilderReady = false
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.ReasonsStr)
@@ -469,7 +552,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				sourceResolver.Status.Source.Git.Revision = "different"
 				sourceResolver.Status.Conditions = []corev1alpha1.Condition{}
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.ReasonsStr)
@@ -480,7 +563,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				sourceResolver.Status.Source.Git.Revision = "different"
 				sourceResolver.Status.Conditions = []corev1alpha1.Condition{}
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.ReasonsStr)
@@ -492,7 +575,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
 				sourceResolver.ObjectMeta.Generation = 2
 				sourceResolver.Status.ObservedGeneration = 1
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionUnknown, result.ConditionStatus)
 				assert.Equal(t, "", result.ReasonsStr)
@@ -547,7 +630,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
@@ -566,7 +649,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonCommit, result.ReasonsStr)
@@ -611,7 +694,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
@@ -645,7 +728,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
@@ -690,7 +773,7 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
@@ -723,13 +806,62 @@ func testImageBuilds(t *testing.T, when spec.G, it spec.S) {
   }
 ]`)
 
-				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
 				assert.NoError(t, err)
 				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
 				assert.Equal(t, v1alpha1.BuildReasonConfig, result.ReasonsStr)
 				assert.Equal(t, expectedChanges, result.ChangesStr)
 			})
 		})
+
+		when("Artifact", func() {
+			sourceResolver.Status.Source = v1alpha1.ResolvedSourceConfig{
+				Artifact: &v1alpha1.ResolvedArtifactSource{
+					URL:      "http://source-controller/some-repo/revision.tar.gz",
+					Revision: "main/revision",
+					Checksum: "some-checksum",
+				},
+			}
+
+			latestBuild.Spec.Source = v1alpha1.SourceConfig{
+				Artifact: &v1alpha1.Artifact{
+					URL:      "http://source-controller/some-repo/revision.tar.gz",
+					Revision: "main/revision",
+					Checksum: "some-checksum",
+				},
+			}
+
+			it("false for same revision", func() {
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, corev1.ConditionFalse, result.ConditionStatus)
+				assert.Equal(t, "", result.ReasonsStr)
+				assert.Equal(t, "", result.ChangesStr)
+			})
+
+			it("true with only a COMMIT change for a different revision", func() {
+				sourceResolver.Status.Source.Artifact = &v1alpha1.ResolvedArtifactSource{
+					URL:      "http://source-controller/some-repo/different.tar.gz",
+					Revision: "main/different",
+					Checksum: "different-checksum",
+				}
+
+				expectedChanges := testhelpers.CompactJSON(`
+[
+  {
+    "reason": "COMMIT",
+    "old": "main/revision",
+    "new": "main/different"
+  }
+]`)
+
+				result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, corev1.ConditionTrue, result.ConditionStatus)
+				assert.Equal(t, v1alpha1.BuildReasonCommit, result.ReasonsStr)
+				assert.Equal(t, expectedChanges, result.ChangesStr)
+			})
+		})
 	})
 }
 
@@ -764,3 +896,13 @@ func (t TestBuilderResource) RunImage() string {
 func (t TestBuilderResource) GetName() string {
 	return t.Name
 }
+
+type fakeRolloutGate struct {
+	admitted bool
+	image    *v1alpha1.Image
+}
+
+func (f *fakeRolloutGate) Admit(image *v1alpha1.Image) (bool, error) {
+	f.image = image
+	return f.admitted, nil
+}
@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -15,6 +16,7 @@ import (
 	k8sclient "k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"knative.dev/pkg/controller"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
@@ -23,6 +25,7 @@ import (
 	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/duckbuilder"
 	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/rollout"
 	"github.com/pivotal/kpack/pkg/tracker"
 )
 
@@ -39,15 +42,21 @@ func NewController(
 	duckbuilderInformer *duckbuilder.DuckBuilderInformer,
 	sourceResolverInformer v1alpha1informers.SourceResolverInformer,
 	pvcInformer coreinformers.PersistentVolumeClaimInformer,
+	rolloutPolicyInformer v1alpha1informers.RolloutPolicyInformer,
 ) *controller.Impl {
 	c := &Reconciler{
 		Client:               opt.Client,
+		EventRecorder:        opt.EventRecorder,
 		K8sClient:            k8sClient,
 		ImageLister:          imageInformer.Lister(),
 		BuildLister:          buildInformer.Lister(),
 		DuckBuilderLister:    duckbuilderInformer.Lister(),
 		SourceResolverLister: sourceResolverInformer.Lister(),
 		PvcLister:            pvcInformer.Lister(),
+		// admission only reads the progress the rolloutpolicy reconciler reports
+		Rollout: &rollout.Rollout{
+			PolicyLister: rolloutPolicyInformer.Lister(),
+		},
 	}
 
 	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
@@ -69,6 +78,20 @@ func NewController(
 		Handler:    reconciler.Handler(impl.EnqueueControllerOf),
 	})
 
+	// the progress of a rollout in the status of a policy can admit the next wave of images
+	rolloutPolicyInformer.Informer().AddEventHandler(reconciler.Handler(func(obj interface{}) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			return
+		}
+		namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+
+		impl.FilteredGlobalResync(func(obj interface{}) bool {
+			image, ok := obj.(*v1alpha1.Image)
+			return ok && image.Namespace == namespace
+		}, imageInformer.Informer())
+	}))
+
 	c.Tracker = tracker.New(impl.EnqueueKey, opt.TrackerResyncPeriod())
 
 	duckbuilderInformer.AddEventHandler(reconciler.Handler(c.Tracker.OnChanged))
@@ -78,6 +101,7 @@ func NewController(
 
 type Reconciler struct {
 	Client               versioned.Interface
+	EventRecorder        record.EventRecorder
 	DuckBuilderLister    *duckbuilder.DuckBuilderLister
 	ImageLister          v1alpha1Listers.ImageLister
 	BuildLister          v1alpha1Listers.BuildLister
@@ -85,6 +109,7 @@ type Reconciler struct {
 	PvcLister            corelisters.PersistentVolumeClaimLister
 	Tracker              reconciler.Tracker
 	K8sClient            k8sclient.Interface
+	Rollout              RolloutGate
 }
 
 func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
@@ -135,11 +160,16 @@ func (c *Reconciler) reconcileImage(image *v1alpha1.Image) (*v1alpha1.Image, err
 		return image, nil
 	}
 
-	buildCacheName, err := c.reconcileBuildCache(image)
+	buildCacheName, cacheStatus, err := c.reconcileBuildCache(image)
 	if err != nil {
 		return nil, err
 	}
 
+	if cacheStatus.State == v1alpha1.CacheStateRecreating {
+		image.Status.Cache = cacheStatus
+		return image, nil
+	}
+
 	sourceResolver, err := c.reconcileSourceResolver(image)
 	if err != nil {
 		return nil, err
@@ -149,6 +179,7 @@ func (c *Reconciler) reconcileImage(image *v1alpha1.Image) (*v1alpha1.Image, err
 	if err != nil {
 		return nil, err
 	}
+	image.Status.Cache = cacheStatus
 
 	return image, c.deleteOldBuilds(image)
 }
@@ -176,41 +207,74 @@ func (c *Reconciler) reconcileSourceResolver(image *v1alpha1.Image) (*v1alpha1.S
 	return c.Client.KpackV1alpha1().SourceResolvers(image.Namespace).Update(sourceResolver)
 }
 
-func (c *Reconciler) reconcileBuildCache(image *v1alpha1.Image) (string, error) {
+func (c *Reconciler) reconcileBuildCache(image *v1alpha1.Image) (string, *v1alpha1.ImageCacheStatus, error) {
+	cacheStatus := image.CacheStatus()
+	if image.Status.Cache != nil {
+		cacheStatus.LastClearRequest = image.Status.Cache.LastClearRequest
+	}
+
 	if !image.NeedCache() {
 		buildCache, err := c.PvcLister.PersistentVolumeClaims(image.Namespace).Get(image.CacheName())
 		if err != nil && !k8serrors.IsNotFound(err) {
-			return "", errors.Wrap(err, "cannot retrieve persistent volume claim")
-		} else if k8serrors.IsNotFound(err) {
-			return "", nil
+			return "", nil, errors.Wrap(err, "cannot retrieve persistent volume claim")
+		} else if k8serrors.IsNotFound(err) || buildCache.DeletionTimestamp != nil {
+			return "", cacheStatus, nil
 		}
 
-		return "", c.K8sClient.CoreV1().PersistentVolumeClaims(image.Namespace).Delete(image.CacheName(), &metav1.DeleteOptions{
-			Preconditions: &metav1.Preconditions{UID: &buildCache.UID},
-		})
+		return "", cacheStatus, c.deleteBuildCache(buildCache)
 	}
 
 	desiredBuildCache := image.BuildCache()
+	clearRequested := image.ClearCacheRequested()
 
 	buildCache, err := c.PvcLister.PersistentVolumeClaims(image.Namespace).Get(image.CacheName())
 	if err != nil && !k8serrors.IsNotFound(err) {
-		return "", fmt.Errorf("failed to get image cache: %s", err)
+		return "", nil, fmt.Errorf("failed to get image cache: %s", err)
 	} else if k8serrors.IsNotFound(err) {
+		if clearRequested {
+			cacheStatus.LastClearRequest = image.Annotations[v1alpha1.ClearCacheAnnotation]
+		}
+
+		// a claim created from the desired cache is empty and needs neither clearing nor recreating
 		buildCache, err = c.K8sClient.CoreV1().PersistentVolumeClaims(image.Namespace).Create(desiredBuildCache)
 		if err != nil {
-			return "", fmt.Errorf("failed creating image cache for build: %s", err)
+			return "", nil, fmt.Errorf("failed creating image cache for build: %s", err)
+		}
+		cacheStatus.State, cacheStatus.Size = buildCacheState(buildCache)
+		return buildCache.Name, cacheStatus, nil
+	}
+
+	if buildCache.DeletionTimestamp != nil {
+		cacheStatus.State = v1alpha1.CacheStateRecreating
+		return "", cacheStatus, nil
+	}
+
+	// storage class and access modes are immutable and claims cannot shrink so the cache is recreated instead
+	if clearRequested || buildCacheRequiresRecreate(desiredBuildCache, buildCache) {
+		if clearRequested {
+			cacheStatus.LastClearRequest = image.Annotations[v1alpha1.ClearCacheAnnotation]
 		}
+		cacheStatus.State = v1alpha1.CacheStateRecreating
+		return "", cacheStatus, c.deleteBuildCache(buildCache)
 	}
 
 	if buildCacheEqual(desiredBuildCache, buildCache) {
-		return buildCache.Name, nil
+		cacheStatus.State, cacheStatus.Size = buildCacheState(buildCache)
+		return buildCache.Name, cacheStatus, nil
 	}
 
 	existing := buildCache.DeepCopy()
 	existing.Spec.Resources = desiredBuildCache.Spec.Resources
 	existing.ObjectMeta.Labels = desiredBuildCache.ObjectMeta.Labels
 	_, err = c.K8sClient.CoreV1().PersistentVolumeClaims(image.Namespace).Update(existing)
-	return existing.Name, errors.Wrap(err, "cannot update persistent volume claim")
+	cacheStatus.State, cacheStatus.Size = buildCacheState(existing)
+	return existing.Name, cacheStatus, errors.Wrap(err, "cannot update persistent volume claim")
+}
+
+func (c *Reconciler) deleteBuildCache(buildCache *corev1.PersistentVolumeClaim) error {
+	return c.K8sClient.CoreV1().PersistentVolumeClaims(buildCache.Namespace).Delete(buildCache.Name, &metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &buildCache.UID},
+	})
 }
 
 func (c *Reconciler) deleteOldBuilds(image *v1alpha1.Image) error {
@@ -283,6 +347,41 @@ func sourceResolversEqual(desiredSourceResolver *v1alpha1.SourceResolver, source
 		equality.Semantic.DeepEqual(desiredSourceResolver.Labels, sourceResolver.Labels)
 }
 
+func buildCacheRequiresRecreate(desiredBuildCache *corev1.PersistentVolumeClaim, buildCache *corev1.PersistentVolumeClaim) bool {
+	desiredSize := desiredBuildCache.Spec.Resources.Requests[corev1.ResourceStorage]
+	if size, ok := buildCache.Spec.Resources.Requests[corev1.ResourceStorage]; ok && desiredSize.Cmp(size) < 0 {
+		return true
+	}
+
+	// a claim without a storage class is assigned the default storage class when it is created
+	if desiredBuildCache.Spec.StorageClassName != nil &&
+		(buildCache.Spec.StorageClassName == nil || *buildCache.Spec.StorageClassName != *desiredBuildCache.Spec.StorageClassName) {
+		return true
+	}
+
+	return !equality.Semantic.DeepEqual(desiredBuildCache.Spec.AccessModes, buildCache.Spec.AccessModes)
+}
+
+func buildCacheState(buildCache *corev1.PersistentVolumeClaim) (v1alpha1.CacheState, *resource.Quantity) {
+	if size, ok := buildCache.Status.Capacity[corev1.ResourceStorage]; ok {
+		return cacheState(buildCache.Status.Phase), &size
+	}
+
+	size := buildCache.Spec.Resources.Requests[corev1.ResourceStorage]
+	return cacheState(buildCache.Status.Phase), &size
+}
+
+func cacheState(phase corev1.PersistentVolumeClaimPhase) v1alpha1.CacheState {
+	switch phase {
+	case corev1.ClaimBound:
+		return v1alpha1.CacheStateBound
+	case corev1.ClaimLost:
+		return v1alpha1.CacheStateLost
+	default:
+		return v1alpha1.CacheStatePending
+	}
+}
+
 func buildCacheEqual(desiredBuildCache *corev1.PersistentVolumeClaim, buildCache *corev1.PersistentVolumeClaim) bool {
 	return equality.Semantic.DeepEqual(desiredBuildCache.Spec.Resources, buildCache.Spec.Resources) &&
 		equality.Semantic.DeepEqual(desiredBuildCache.Labels, buildCache.Labels)
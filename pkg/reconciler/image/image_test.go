@@ -61,6 +61,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 			eventList := rtesting.EventList{Recorder: eventRecorder}
 
 			r := &image.Reconciler{
+				EventRecorder:        eventRecorder,
 				Client:               fakeClient,
 				ImageLister:          listers.GetImageLister(),
 				BuildLister:          listers.GetBuildLister(),
@@ -108,6 +109,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 				ObservedGeneration: originalGeneration,
 				Conditions:         conditionReadyUnknown(),
 			},
+			Cache: &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 		},
 	}
 
@@ -191,6 +193,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									ObservedGeneration: updatedGeneration,
 									Conditions:         conditionReadyUnknown(),
 								},
+								Cache: &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 							},
 						},
 					},
@@ -250,6 +253,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 										},
 									},
 								},
+								Cache: &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 							},
 						},
 					},
@@ -436,6 +440,11 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 										ObservedGeneration: originalGeneration,
 										Conditions:         conditionReadyUnknown(),
 									},
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:  v1alpha1.CacheKindVolume,
+										State: v1alpha1.CacheStatePending,
+										Size:  &cacheSize,
+									},
 								},
 							},
 						},
@@ -446,6 +455,11 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 			it("does not create a cache if a cache already exists", func() {
 				image.Spec.CacheSize = &cacheSize
 				image.Status.BuildCacheName = image.CacheName()
+				image.Status.Cache = &v1alpha1.ImageCacheStatus{
+					Kind:  v1alpha1.CacheKindVolume,
+					State: v1alpha1.CacheStatePending,
+					Size:  &cacheSize,
+				}
 
 				rt.Test(rtesting.TableRow{
 					Key: key,
@@ -465,6 +479,11 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 				image.Status.BuildCacheName = imageCacheName
 				newCacheSize := resource.MustParse("2.5")
 				image.Spec.CacheSize = &newCacheSize
+				image.Status.Cache = &v1alpha1.ImageCacheStatus{
+					Kind:  v1alpha1.CacheKindVolume,
+					State: v1alpha1.CacheStatePending,
+					Size:  &newCacheSize,
+				}
 
 				rt.Test(rtesting.TableRow{
 					Key: key,
@@ -522,6 +541,11 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 				var imageCacheName = image.CacheName()
 				image.Spec.CacheSize = &cacheSize
 				image.Status.BuildCacheName = imageCacheName
+				image.Status.Cache = &v1alpha1.ImageCacheStatus{
+					Kind:  v1alpha1.CacheKindVolume,
+					State: v1alpha1.CacheStatePending,
+					Size:  &cacheSize,
+				}
 				cache := image.BuildCache()
 
 				extraLabelImage := image.DeepCopy()
@@ -596,6 +620,349 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 										ObservedGeneration: originalGeneration,
 										Conditions:         conditionReadyUnknown(),
 									},
+									Cache: &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("creates a cache with the configured storage class and access modes", func() {
+				image.Spec.CacheSize = &cacheSize
+				image.Spec.Cache = &v1alpha1.ImageCacheConfig{
+					Volume: &v1alpha1.VolumeCache{
+						StorageClassName: "some-storage-class",
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+					},
+				}
+				storageClassName := "some-storage-class"
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						builder,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						&corev1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      image.CacheName(),
+								Namespace: namespace,
+								OwnerReferences: []metav1.OwnerReference{
+									*kmeta.NewControllerRef(image),
+								},
+								Labels: map[string]string{
+									someLabelKey: someValueToPassThrough,
+								},
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceStorage: cacheSize,
+									},
+								},
+								StorageClassName: &storageClassName,
+							},
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									BuildCacheName: image.CacheName(),
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:  v1alpha1.CacheKindVolume,
+										State: v1alpha1.CacheStatePending,
+										Size:  &cacheSize,
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("reports the state and capacity of a bound cache", func() {
+				image.Spec.CacheSize = &cacheSize
+				image.Status.BuildCacheName = image.CacheName()
+				cache := image.BuildCache()
+				capacity := resource.MustParse("2G")
+				cache.Status = corev1.PersistentVolumeClaimStatus{
+					Phase:    corev1.ClaimBound,
+					Capacity: corev1.ResourceList{corev1.ResourceStorage: capacity},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						cache,
+						builder,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									BuildCacheName: image.CacheName(),
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:  v1alpha1.CacheKindVolume,
+										State: v1alpha1.CacheStateBound,
+										Size:  &capacity,
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("recreates the cache when it shrinks", func() {
+				image.Status.BuildCacheName = image.CacheName()
+				largerCacheSize := resource.MustParse("2.5")
+				image.Spec.CacheSize = &largerCacheSize
+				cache := image.BuildCache()
+				image.Spec.CacheSize = &cacheSize
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						cache,
+						builder,
+					},
+					WantErr: false,
+					WantDeletes: []clientgotesting.DeleteActionImpl{
+						{
+							Name: image.CacheName(),
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									BuildCacheName: image.CacheName(),
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:  v1alpha1.CacheKindVolume,
+										State: v1alpha1.CacheStateRecreating,
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("recreates the cache when the storage class changes", func() {
+				image.Spec.CacheSize = &cacheSize
+				image.Status.BuildCacheName = image.CacheName()
+				cache := image.BuildCache()
+				image.Spec.Cache = &v1alpha1.ImageCacheConfig{
+					Volume: &v1alpha1.VolumeCache{
+						StorageClassName: "some-storage-class",
+					},
+				}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						cache,
+						builder,
+					},
+					WantErr: false,
+					WantDeletes: []clientgotesting.DeleteActionImpl{
+						{
+							Name: image.CacheName(),
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									BuildCacheName: image.CacheName(),
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:  v1alpha1.CacheKindVolume,
+										State: v1alpha1.CacheStateRecreating,
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("clears the cache when the clear cache annotation changes", func() {
+				image.Spec.CacheSize = &cacheSize
+				image.Status.BuildCacheName = image.CacheName()
+				image.Status.Cache = &v1alpha1.ImageCacheStatus{
+					Kind:             v1alpha1.CacheKindVolume,
+					State:            v1alpha1.CacheStatePending,
+					Size:             &cacheSize,
+					LastClearRequest: "1",
+				}
+				image.Annotations = map[string]string{v1alpha1.ClearCacheAnnotation: "2"}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						image.BuildCache(),
+						builder,
+					},
+					WantErr: false,
+					WantDeletes: []clientgotesting.DeleteActionImpl{
+						{
+							Name: image.CacheName(),
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									BuildCacheName: image.CacheName(),
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:             v1alpha1.CacheKindVolume,
+										State:            v1alpha1.CacheStateRecreating,
+										LastClearRequest: "2",
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("does not clear the cache again for the same clear cache annotation", func() {
+				image.Spec.CacheSize = &cacheSize
+				image.Status.BuildCacheName = image.CacheName()
+				image.Status.Cache = &v1alpha1.ImageCacheStatus{
+					Kind:             v1alpha1.CacheKindVolume,
+					State:            v1alpha1.CacheStatePending,
+					Size:             &cacheSize,
+					LastClearRequest: "2",
+				}
+				image.Annotations = map[string]string{v1alpha1.ClearCacheAnnotation: "2"}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						image.BuildCache(),
+						builder,
+					},
+					WantErr: false,
+				})
+			})
+
+			it("creates the cache without clearing it when the clear cache annotation is set before the cache exists", func() {
+				image.Spec.CacheSize = &cacheSize
+				image.Annotations = map[string]string{v1alpha1.ClearCacheAnnotation: "1"}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						image.SourceResolver(),
+						builder,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						image.BuildCache(),
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									BuildCacheName: image.CacheName(),
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:             v1alpha1.CacheKindVolume,
+										State:            v1alpha1.CacheStatePending,
+										Size:             &cacheSize,
+										LastClearRequest: "1",
+									},
+								},
+							},
+						},
+					},
+				})
+			})
+
+			it("waits for the cache to be deleted before scheduling builds", func() {
+				image.Spec.CacheSize = &cacheSize
+				image.Status.BuildCacheName = image.CacheName()
+				cache := image.BuildCache()
+				cache.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						resolvedSourceResolver(image),
+						cache,
+						builder,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									BuildCacheName: image.CacheName(),
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionReadyUnknown(),
+									},
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:  v1alpha1.CacheKindVolume,
+										State: v1alpha1.CacheStateRecreating,
+									},
 								},
 							},
 						},
@@ -647,6 +1014,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 											},
 										},
 									},
+									Cache: &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
@@ -730,10 +1098,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-1-00001 with reasons CONFIG",
+					},
 				})
 			})
 
@@ -819,10 +1191,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-1-00001 with reasons CONFIG",
+					},
 				})
 			})
 
@@ -908,10 +1284,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-1-00001 with reasons CONFIG",
+					},
 				})
 			})
 
@@ -998,10 +1378,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-1-00001 with reasons CONFIG",
+					},
 				})
 			})
 
@@ -1088,10 +1472,110 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               1,
 									BuildCacheName:             image.CacheName(),
+									Cache: &v1alpha1.ImageCacheStatus{
+										Kind:  v1alpha1.CacheKindVolume,
+										State: v1alpha1.CacheStatePending,
+										Size:  &cacheSize,
+									},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-1-00001 with reasons CONFIG",
+					},
+				})
+			})
+
+			it("schedules a build with a registry cache", func() {
+				image.Spec.Cache = &v1alpha1.ImageCacheConfig{
+					Registry: &v1alpha1.RegistryCache{Tag: "some/image-cache"},
+				}
+
+				sourceResolver := resolvedSourceResolver(image)
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						image,
+						builder,
+						sourceResolver,
+					},
+					WantErr: false,
+					WantCreates: []runtime.Object{
+						&v1alpha1.Build{
+							ObjectMeta: metav1.ObjectMeta{
+								GenerateName: imageName + "-build-1-",
+								Namespace:    namespace,
+								OwnerReferences: []metav1.OwnerReference{
+									*kmeta.NewControllerRef(image),
+								},
+								Labels: map[string]string{
+									v1alpha1.BuildNumberLabel:     "1",
+									v1alpha1.ImageLabel:           imageName,
+									v1alpha1.ImageGenerationLabel: generation(image),
+									someLabelKey:                  someValueToPassThrough,
+								},
+								Annotations: map[string]string{
+									v1alpha1.BuildReasonAnnotation: v1alpha1.BuildReasonConfig,
+									v1alpha1.BuildChangesAnnotation: testhelpers.CompactJSON(`
+[
+  {
+    "reason": "CONFIG",
+    "old": {
+      "resources": {},
+      "source": {}
+    },
+    "new": {
+      "resources": {},
+      "source": {
+        "git": {
+          "url": "https://some.git/url-resolved",
+          "revision": "1234567-resolved"
+        }
+      }
+    }
+  }
+]`),
+								},
+							},
+							Spec: v1alpha1.BuildSpec{
+								Tags: []string{image.Spec.Tag},
+								Builder: v1alpha1.BuildBuilderSpec{
+									Image: builder.Status.LatestImage,
+								},
+								ServiceAccount: image.Spec.ServiceAccount,
+								Source: v1alpha1.SourceConfig{
+									Git: &v1alpha1.Git{
+										URL:      sourceResolver.Status.Source.Git.URL,
+										Revision: sourceResolver.Status.Source.Git.Revision,
+									},
+								},
+								CacheImage: "some/image-cache",
+							},
+						},
+					},
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions:         conditionBuildExecuting("image-name-build-1-00001"),
+									},
+									LatestBuildRef:             "image-name-build-1-00001", // GenerateNameReactor
+									LatestBuildReason:          "CONFIG",
+									LatestBuildImageGeneration: originalGeneration,
+									BuildCounter:               1,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindRegistry, Image: "some/image-cache"},
+								},
+							},
+						},
+					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-1-00001 with reasons CONFIG",
+					},
 				})
 			})
 
@@ -1233,10 +1717,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildImageGeneration: originalGeneration,
 									LatestImage:                image.Spec.Tag + "@sha256:just-built",
 									BuildCounter:               2,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-2-00001 with reasons COMMIT,CONFIG",
+					},
 				})
 			})
 
@@ -1358,10 +1846,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildImageGeneration: originalGeneration,
 									LatestImage:                image.Spec.Tag + "@sha256:just-built",
 									BuildCounter:               2,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-2-00001 with reasons COMMIT",
+					},
 				})
 			})
 
@@ -1517,10 +2009,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildImageGeneration: originalGeneration,
 									LatestImage:                image.Spec.Tag + "@sha256:just-built",
 									BuildCounter:               2,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-2-00001 with reasons BUILDPACK",
+					},
 				})
 			})
 
@@ -1671,10 +2167,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          v1alpha1.BuildReasonStack,
 									LatestImage:                image.Spec.Tag + "@sha256:just-built",
 									BuildCounter:               2,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-2-00001 with reasons STACK",
+					},
 				})
 			})
 
@@ -1814,10 +2314,14 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestBuildReason:          "COMMIT,CONFIG",
 									LatestBuildImageGeneration: originalGeneration,
 									BuildCounter:               3,
+									Cache:                      &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
 					},
+					WantEvents: []string{
+						"Normal BuildScheduled Scheduled build image-name-build-3-00001 with reasons COMMIT,CONFIG",
+					},
 				})
 			})
 
@@ -1976,6 +2480,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestImage:    "some/image@sha256:build-1",
 									BuildCounter:   1,
 									LatestStack:    "io.buildpacks.stacks.bionic",
+									Cache:          &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
@@ -2022,6 +2527,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestImage:    "some/image@sha256:build-1",
 									BuildCounter:   1,
 									LatestStack:    "io.buildpacks.stacks.bionic",
+									Cache:          &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
@@ -2030,6 +2536,58 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 
 			})
 
+			it("reports not ready with the source resolver failure when the source cannot be resolved", func() {
+				image.Status.BuildCounter = 1
+				image.Status.LatestBuildRef = "image-name-build-1"
+				image.Status.LatestImage = "some/image@some-old-sha"
+				image.Status.LatestStack = "io.buildpacks.stacks.bionic"
+
+				sourceResolver := resolvedSourceResolver(image)
+				failedSourceResolver := resolvedSourceResolver(image)
+				failedSourceResolver.ResolveFailed(v1alpha1.SourceNotFound, "repository not found")
+
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: runtimeObjects(
+						successfulBuilds(image, sourceResolver, 1),
+						image,
+						builder,
+						failedSourceResolver,
+					),
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.Image{
+								ObjectMeta: image.ObjectMeta,
+								Spec:       image.Spec,
+								Status: v1alpha1.ImageStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:    corev1alpha1.ConditionReady,
+												Status:  corev1.ConditionFalse,
+												Reason:  v1alpha1.SourceNotFound,
+												Message: "repository not found",
+											},
+											{
+												Type:   v1alpha1.ConditionBuilderReady,
+												Status: corev1.ConditionTrue,
+											},
+										},
+									},
+									LatestBuildRef: "image-name-build-1",
+									LatestImage:    "some/image@sha256:build-1",
+									BuildCounter:   1,
+									LatestStack:    "io.buildpacks.stacks.bionic",
+									Cache:          &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
+								},
+							},
+						},
+					},
+				})
+			})
+
 			it("reports unknown when last build was successful and builder is not ready", func() {
 				image.Status.BuildCounter = 1
 				image.Status.LatestBuildRef = "image-name-build-1"
@@ -2071,6 +2629,7 @@ func testImageReconciler(t *testing.T, when spec.G, it spec.S) {
 									LatestImage:    "some/image@sha256:build-1",
 									BuildCounter:   1,
 									LatestStack:    "io.buildpacks.stacks.bionic",
+									Cache:          &v1alpha1.ImageCacheStatus{Kind: v1alpha1.CacheKindNone},
 								},
 							},
 						},
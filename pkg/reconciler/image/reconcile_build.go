@@ -1,15 +1,20 @@
 package image
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/label"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/tracing"
 )
 
 func (c *Reconciler) reconcileBuild(image *v1alpha1.Image, latestBuild *v1alpha1.Build, sourceResolver *v1alpha1.SourceResolver, builder v1alpha1.BuilderResource, buildCacheName string) (v1alpha1.ImageStatus, error) {
@@ -18,7 +23,7 @@ func (c *Reconciler) reconcileBuild(image *v1alpha1.Image, latestBuild *v1alpha1
 		return v1alpha1.ImageStatus{}, err
 	}
 
-	result, err := isBuildRequired(image, latestBuild, sourceResolver, builder)
+	result, err := isBuildRequired(image, latestBuild, sourceResolver, builder, c.Rollout)
 	if err != nil {
 		return v1alpha1.ImageStatus{}, errors.Wrap(err, "error determining if an image build is needed")
 	}
@@ -28,10 +33,23 @@ func (c *Reconciler) reconcileBuild(image *v1alpha1.Image, latestBuild *v1alpha1
 		nextBuildNumber := currentBuildNumber + 1
 
 		build := image.Build(sourceResolver, builder, latestBuild, result.ReasonsStr, result.ChangesStr, buildCacheName, nextBuildNumber)
+
+		// every build starts a trace that its reconciles and the steps of its pod join
+		ctx, span := tracing.StartSpan(context.Background(), "image.scheduleBuild", trace.WithNewRoot(), trace.WithAttributes(
+			label.String("namespace", image.Namespace),
+			label.String("image", image.Name),
+			label.String("reasons", result.ReasonsStr),
+		))
+		if traceParent := tracing.TraceParent(ctx); traceParent != "" {
+			build.Annotations[tracing.TraceParentAnnotation] = traceParent
+		}
+
 		build, err = c.Client.KpackV1alpha1().Builds(build.Namespace).Create(build)
+		tracing.EndSpan(span, err)
 		if err != nil {
 			return v1alpha1.ImageStatus{}, err
 		}
+		c.EventRecorder.Eventf(image, corev1.EventTypeNormal, reconciler.BuildScheduledReason, "Scheduled build %s with reasons %s", build.Name, result.ReasonsStr)
 
 		return v1alpha1.ImageStatus{
 			Status: corev1alpha1.Status{
@@ -50,7 +68,7 @@ func (c *Reconciler) reconcileBuild(image *v1alpha1.Image, latestBuild *v1alpha1
 	case corev1.ConditionFalse:
 		return v1alpha1.ImageStatus{
 			Status: corev1alpha1.Status{
-				Conditions: noScheduledBuild(result.ConditionStatus, builder, latestBuild),
+				Conditions: noScheduledBuild(result.ConditionStatus, builder, latestBuild, sourceResolver),
 			},
 			LatestBuildRef:             latestBuild.BuildRef(),
 			LatestBuildReason:          latestBuild.BuildReason(),
@@ -65,14 +83,10 @@ func (c *Reconciler) reconcileBuild(image *v1alpha1.Image, latestBuild *v1alpha1
 	}
 }
 
-func noScheduledBuild(buildNeeded corev1.ConditionStatus, builder v1alpha1.BuilderResource, build *v1alpha1.Build) corev1alpha1.Conditions {
+func noScheduledBuild(buildNeeded corev1.ConditionStatus, builder v1alpha1.BuilderResource, build *v1alpha1.Build, sourceResolver *v1alpha1.SourceResolver) corev1alpha1.Conditions {
 	if buildNeeded == corev1.ConditionUnknown {
 		return corev1alpha1.Conditions{
-			{
-				Type:               corev1alpha1.ConditionReady,
-				Status:             corev1.ConditionUnknown,
-				LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
-			},
+			unresolvedReadyCondition(sourceResolver),
 			builderCondition(builder),
 		}
 	}
@@ -88,6 +102,24 @@ func noScheduledBuild(buildNeeded corev1.ConditionStatus, builder v1alpha1.Build
 
 }
 
+func unresolvedReadyCondition(sourceResolver *v1alpha1.SourceResolver) corev1alpha1.Condition {
+	if resolved := sourceResolver.Status.GetCondition(v1alpha1.SourceResolved); resolved.IsFalse() {
+		return corev1alpha1.Condition{
+			Type:               corev1alpha1.ConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             resolved.Reason,
+			Message:            resolved.Message,
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		}
+	}
+
+	return corev1alpha1.Condition{
+		Type:               corev1alpha1.ConditionReady,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+	}
+}
+
 func unknownIfNil(condition *corev1alpha1.Condition) corev1.ConditionStatus {
 	if condition == nil {
 		return corev1.ConditionUnknown
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"go.uber.org/zap"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
 )
@@ -12,6 +13,7 @@ type Options struct {
 	Logger *zap.SugaredLogger
 
 	Client                  versioned.Interface
+	EventRecorder           record.EventRecorder
 	ResyncPeriod            time.Duration
 	SourcePollingFrequency  time.Duration
 	BuilderPollingFrequency time.Duration
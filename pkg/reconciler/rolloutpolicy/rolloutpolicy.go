@@ -0,0 +1,109 @@
+package rolloutpolicy
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
+	v1alpha1Informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
+	v1alpha1Listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/duckbuilder"
+	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/rollout"
+)
+
+const (
+	ReconcilerName = "RolloutPolicies"
+	Kind           = "RolloutPolicy"
+)
+
+func NewController(opt reconciler.Options, rolloutPolicyInformer v1alpha1Informers.RolloutPolicyInformer, imageInformer v1alpha1Informers.ImageInformer, buildInformer v1alpha1Informers.BuildInformer, duckbuilderInformer *duckbuilder.DuckBuilderInformer) *controller.Impl {
+	c := &Reconciler{
+		Client:              opt.Client,
+		RolloutPolicyLister: rolloutPolicyInformer.Lister(),
+		Rollout: &rollout.Rollout{
+			PolicyLister:      rolloutPolicyInformer.Lister(),
+			ImageLister:       imageInformer.Lister(),
+			BuildLister:       buildInformer.Lister(),
+			DuckBuilderLister: duckbuilderInformer.Lister(),
+		},
+	}
+	impl := controller.NewImpl(c, opt.Logger, ReconcilerName)
+	rolloutPolicyInformer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
+
+	// images and their builds change the progress of the policies in their namespace
+	enqueueNamespacePolicies := func(obj interface{}) {
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+		if err != nil {
+			return
+		}
+		namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+
+		policies, err := c.RolloutPolicyLister.RolloutPolicies(namespace).List(labels.Everything())
+		if err != nil {
+			return
+		}
+		for _, policy := range policies {
+			impl.Enqueue(policy)
+		}
+	}
+	imageInformer.Informer().AddEventHandler(reconciler.Handler(enqueueNamespacePolicies))
+	buildInformer.Informer().AddEventHandler(reconciler.Handler(enqueueNamespacePolicies))
+
+	// a new stack or store in a builder outdates the images of every namespace
+	duckbuilderInformer.AddEventHandler(reconciler.Handler(func(interface{}) {
+		impl.GlobalResync(rolloutPolicyInformer.Informer())
+	}))
+	return impl
+}
+
+type Reconciler struct {
+	Client              versioned.Interface
+	RolloutPolicyLister v1alpha1Listers.RolloutPolicyLister
+	Rollout             *rollout.Rollout
+}
+
+func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	policy, err := c.RolloutPolicyLister.RolloutPolicies(namespace).Get(name)
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	policy = policy.DeepCopy()
+
+	policy.Status, err = c.Rollout.Status(policy)
+	if err != nil {
+		return err
+	}
+
+	return c.updateStatus(policy)
+}
+
+func (c *Reconciler) updateStatus(desired *v1alpha1.RolloutPolicy) error {
+	desired.Status.ObservedGeneration = desired.Generation
+
+	original, err := c.RolloutPolicyLister.RolloutPolicies(desired.Namespace).Get(desired.Name)
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(desired.Status, original.Status) {
+		return nil
+	}
+
+	_, err = c.Client.KpackV1alpha1().RolloutPolicies(desired.Namespace).UpdateStatus(desired)
+	return err
+}
@@ -0,0 +1,228 @@
+package rolloutpolicy_test
+
+import (
+	"testing"
+
+	"github.com/sclevine/spec"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/controller"
+	rtesting "knative.dev/pkg/reconciler/testing"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/client/clientset/versioned/fake"
+	"github.com/pivotal/kpack/pkg/reconciler/rolloutpolicy"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+	"github.com/pivotal/kpack/pkg/rollout"
+)
+
+func TestRolloutPolicyReconciler(t *testing.T) {
+	spec.Run(t, "RolloutPolicy Reconciler", testRolloutPolicyReconciler)
+}
+
+func testRolloutPolicyReconciler(t *testing.T, when spec.G, it spec.S) {
+	const (
+		namespace               = "some-namespace"
+		policyName              = "some-policy"
+		key                     = "some-namespace/some-policy"
+		initialGeneration int64 = 1
+		oldRunImage             = "some.registry.io/run@sha256:67e3de2af270bf09c02e9a644aeb7e87e6b3c049abe6766bf6b6c3728a83e7fb"
+		newRunImage             = "some.registry.io/run@sha256:a1aa3da2a80a775df55e880b094a1a8de19b919435ad0c71c29a0983d64e65db"
+	)
+
+	policy := &v1alpha1.RolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       policyName,
+			Namespace:  namespace,
+			Generation: initialGeneration,
+		},
+		Spec: v1alpha1.RolloutPolicySpec{
+			Waves: []v1alpha1.RolloutWave{
+				{
+					Name: "canary",
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"rollout": "canary"},
+					},
+				},
+				{
+					Name: "rest",
+				},
+			},
+		},
+	}
+
+	builder := &v1alpha1.ClusterBuilder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "some-builder",
+		},
+		Status: v1alpha1.BuilderStatus{
+			Status: corev1alpha1.Status{
+				Conditions: corev1alpha1.Conditions{{Type: corev1alpha1.ConditionReady, Status: corev1.ConditionTrue}},
+			},
+			Stack: v1alpha1.BuildStack{RunImage: newRunImage},
+		},
+	}
+
+	rt := testhelpers.ReconcilerTester(t,
+		func(t *testing.T, row *rtesting.TableRow) (reconciler controller.Reconciler, lists rtesting.ActionRecorderList, list rtesting.EventList) {
+			listers := testhelpers.NewListers(row.Objects)
+			fakeClient := fake.NewSimpleClientset(listers.BuildServiceObjects()...)
+			r := &rolloutpolicy.Reconciler{
+				Client:              fakeClient,
+				RolloutPolicyLister: listers.GetRolloutPolicyLister(),
+				Rollout: &rollout.Rollout{
+					PolicyLister:      listers.GetRolloutPolicyLister(),
+					ImageLister:       listers.GetImageLister(),
+					BuildLister:       listers.GetBuildLister(),
+					DuckBuilderLister: listers.GetDuckBuilderLister(),
+				},
+			}
+			return r, rtesting.ActionRecorderList{fakeClient}, rtesting.EventList{Recorder: record.NewFakeRecorder(10)}
+		})
+
+	imageWithBuild := func(name string, labels map[string]string, reason string, succeeded corev1.ConditionStatus, runImage string) []runtime.Object {
+		return []runtime.Object{
+			&v1alpha1.Image{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    labels,
+				},
+				Spec: v1alpha1.ImageSpec{
+					Builder: corev1.ObjectReference{Kind: v1alpha1.ClusterBuilderKind, Name: builder.Name},
+				},
+				Status: v1alpha1.ImageStatus{
+					LatestBuildRef: name + "-build",
+				},
+			},
+			&v1alpha1.Build{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name + "-build",
+					Namespace:   namespace,
+					Annotations: map[string]string{v1alpha1.BuildReasonAnnotation: reason},
+				},
+				Status: v1alpha1.BuildStatus{
+					Status: corev1alpha1.Status{
+						Conditions: corev1alpha1.Conditions{{Type: corev1alpha1.ConditionSucceeded, Status: succeeded}},
+					},
+					Stack: v1alpha1.BuildStack{RunImage: runImage},
+				},
+			},
+		}
+	}
+
+	objects := func(images ...[]runtime.Object) []runtime.Object {
+		objs := []runtime.Object{policy, builder}
+		for _, image := range images {
+			objs = append(objs, image...)
+		}
+		return objs
+	}
+
+	when("#Reconcile", func() {
+		it("reports the progress of the rollout", func() {
+			rt.Test(rtesting.TableRow{
+				Key: key,
+				Objects: objects(
+					imageWithBuild("canary", map[string]string{"rollout": "canary"}, "STACK", corev1.ConditionTrue, newRunImage),
+					imageWithBuild("first", nil, "STACK", corev1.ConditionUnknown, ""),
+					imageWithBuild("second", nil, "COMMIT", corev1.ConditionTrue, oldRunImage),
+				),
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &v1alpha1.RolloutPolicy{
+							ObjectMeta: policy.ObjectMeta,
+							Spec:       policy.Spec,
+							Status: v1alpha1.RolloutPolicyStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: initialGeneration,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:   corev1alpha1.ConditionReady,
+											Status: corev1.ConditionTrue,
+										},
+									},
+								},
+								ActiveWave: "rest",
+								Waves: []v1alpha1.RolloutWaveStatus{
+									{Name: "canary", Images: 1},
+									{Name: "rest", Images: 2, Outdated: 1, Building: 1},
+									{Name: v1alpha1.RolloutUnselectedWave},
+								},
+							},
+						},
+					},
+				},
+			})
+		})
+
+		it("halts when the failures of a wave exceed the failure threshold", func() {
+			rt.Test(rtesting.TableRow{
+				Key: key,
+				Objects: objects(
+					imageWithBuild("canary", map[string]string{"rollout": "canary"}, "STACK", corev1.ConditionFalse, ""),
+					imageWithBuild("first", nil, "COMMIT", corev1.ConditionTrue, oldRunImage),
+				),
+				WantErr: false,
+				WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+					{
+						Object: &v1alpha1.RolloutPolicy{
+							ObjectMeta: policy.ObjectMeta,
+							Spec:       policy.Spec,
+							Status: v1alpha1.RolloutPolicyStatus{
+								Status: corev1alpha1.Status{
+									ObservedGeneration: initialGeneration,
+									Conditions: corev1alpha1.Conditions{
+										{
+											Type:    corev1alpha1.ConditionReady,
+											Status:  corev1.ConditionFalse,
+											Reason:  v1alpha1.RolloutHalted,
+											Message: "wave canary failed 1 of 1 rebuilds exceeding the failure threshold of 10%",
+										},
+									},
+								},
+								ActiveWave: "rest",
+								Waves: []v1alpha1.RolloutWaveStatus{
+									{Name: "canary", Images: 1, Failed: 1},
+									{Name: "rest", Images: 1, Outdated: 1},
+									{Name: v1alpha1.RolloutUnselectedWave},
+								},
+							},
+						},
+					},
+				},
+			})
+		})
+
+		it("does not update the status when the progress did not change", func() {
+			reconciled := policy.DeepCopy()
+			reconciled.Status = v1alpha1.RolloutPolicyStatus{
+				Status: corev1alpha1.Status{
+					ObservedGeneration: initialGeneration,
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:   corev1alpha1.ConditionReady,
+							Status: corev1.ConditionTrue,
+						},
+					},
+				},
+				Waves: []v1alpha1.RolloutWaveStatus{
+					{Name: "canary"},
+					{Name: "rest"},
+					{Name: v1alpha1.RolloutUnselectedWave},
+				},
+			}
+
+			rt.Test(rtesting.TableRow{
+				Key:     key,
+				Objects: []runtime.Object{reconciled},
+				WantErr: false,
+			})
+		})
+	})
+}
@@ -6,12 +6,34 @@ import (
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 )
 
+const maxPollingBackoff = 30 * time.Minute
+
 type workQueueEnqueuer struct {
 	enqueueAfter func(obj interface{}, after time.Duration)
 	delay        time.Duration
+	maxDelay     time.Duration
 }
 
 func (e *workQueueEnqueuer) Enqueue(sr *v1alpha1.SourceResolver) error {
-	e.enqueueAfter(sr, 1*time.Minute)
+	e.enqueueAfter(sr, e.pollingDelay(sr))
 	return nil
 }
+
+// pollingDelay doubles the delay for as long as the source has been failing to resolve
+func (e *workQueueEnqueuer) pollingDelay(sr *v1alpha1.SourceResolver) time.Duration {
+	if !sr.ResolveFailing() {
+		return e.delay
+	}
+
+	failingFor := time.Since(sr.Status.GetCondition(v1alpha1.SourceResolved).LastTransitionTime.Inner.Time)
+
+	delay := e.delay
+	for delay > 0 && delay <= failingFor && delay < e.maxDelay {
+		delay *= 2
+	}
+
+	if delay > e.maxDelay {
+		return e.maxDelay
+	}
+	return delay
+}
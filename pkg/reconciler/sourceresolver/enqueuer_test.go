@@ -5,9 +5,11 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
 )
 
 func TestEnqueueAfter(t *testing.T) {
@@ -18,7 +20,8 @@ func TestEnqueueAfter(t *testing.T) {
 	}
 
 	enqueuer := &workQueueEnqueuer{
-		delay: time.Minute,
+		delay:    time.Minute,
+		maxDelay: 30 * time.Minute,
 		enqueueAfter: func(obj interface{}, after time.Duration) {
 			require.Equal(t, sourceResolver, obj)
 			require.Equal(t, after, time.Minute)
@@ -28,3 +31,44 @@ func TestEnqueueAfter(t *testing.T) {
 	err := enqueuer.Enqueue(sourceResolver)
 	require.NoError(t, err)
 }
+
+func TestEnqueueAfterBacksOffWhileResolveFails(t *testing.T) {
+	failingFor := func(d time.Duration) *v1alpha1.SourceResolver {
+		return &v1alpha1.SourceResolver{
+			Status: v1alpha1.SourceResolverStatus{
+				Status: corev1alpha1.Status{
+					Conditions: corev1alpha1.Conditions{
+						{
+							Type:               v1alpha1.SourceResolved,
+							Status:             corev1.ConditionFalse,
+							LastTransitionTime: corev1alpha1.VolatileTime{Inner: v1.NewTime(time.Now().Add(-d))},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		failingFor time.Duration
+		expected   time.Duration
+	}{
+		{0, time.Minute},
+		{90 * time.Second, 2 * time.Minute},
+		{5 * time.Minute, 8 * time.Minute},
+		{2 * time.Hour, 30 * time.Minute},
+	} {
+		var delay time.Duration
+		enqueuer := &workQueueEnqueuer{
+			delay:    time.Minute,
+			maxDelay: 30 * time.Minute,
+			enqueueAfter: func(obj interface{}, after time.Duration) {
+				delay = after
+			},
+		}
+
+		err := enqueuer.Enqueue(failingFor(tc.failingFor))
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, delay, "failing for %s", tc.failingFor)
+	}
+}
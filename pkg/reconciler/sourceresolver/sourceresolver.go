@@ -3,16 +3,24 @@ package sourceresolver
 import (
 	"context"
 	"errors"
+	"time"
 
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/label"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
 	"github.com/pivotal/kpack/pkg/client/clientset/versioned"
 	v1alpha1informers "github.com/pivotal/kpack/pkg/client/informers/externalversions/build/v1alpha1"
 	v1alpha1listers "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/metrics"
 	"github.com/pivotal/kpack/pkg/reconciler"
+	"github.com/pivotal/kpack/pkg/tracing"
 	"knative.dev/pkg/controller"
 )
 
@@ -27,17 +35,30 @@ type Resolver interface {
 	CanResolve(*v1alpha1.SourceResolver) bool
 }
 
+// resolveFailure is implemented by resolver errors that classify why a source could not be resolved
+type resolveFailure interface {
+	error
+	Reason() string
+}
+
+type ArtifactResolver interface {
+	Resolver
+	AddEventHandler(handler cache.ResourceEventHandler)
+}
+
 func NewController(
 	opt reconciler.Options,
 	sourceResolverInformer v1alpha1informers.SourceResolverInformer,
 	gitResolver Resolver,
 	blobResolver Resolver,
 	registryResolver Resolver,
+	artifactResolver ArtifactResolver,
 ) *controller.Impl {
 	c := &Reconciler{
 		GitResolver:          gitResolver,
 		BlobResolver:         blobResolver,
 		RegistryResolver:     registryResolver,
+		ArtifactResolver:     artifactResolver,
 		Client:               opt.Client,
 		SourceResolverLister: sourceResolverInformer.Lister(),
 	}
@@ -47,10 +68,13 @@ func NewController(
 	c.Enqueuer = &workQueueEnqueuer{
 		enqueueAfter: impl.EnqueueAfter,
 		delay:        opt.SourcePollingFrequency,
+		maxDelay:     maxPollingBackoff,
 	}
 
 	sourceResolverInformer.Informer().AddEventHandler(reconciler.Handler(impl.Enqueue))
 
+	artifactResolver.AddEventHandler(reconciler.Handler(c.enqueueArtifactReferences(impl.Enqueue)))
+
 	return impl
 }
 
@@ -63,6 +87,7 @@ type Reconciler struct {
 	GitResolver          Resolver
 	BlobResolver         Resolver
 	RegistryResolver     Resolver
+	ArtifactResolver     Resolver
 	Enqueuer             Enqueuer
 	Client               versioned.Interface
 	SourceResolverLister v1alpha1listers.SourceResolverLister
@@ -83,18 +108,30 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 
 	sourceResolver = sourceResolver.DeepCopy()
 
-	sourceReconciler, err := c.sourceReconciler(sourceResolver)
+	sourceReconciler, sourceType, err := c.sourceReconciler(sourceResolver)
 	if err != nil {
 		return err
 	}
 
+	_, span := tracing.StartSpan(ctx, "sourceresolver.resolve", trace.WithAttributes(
+		label.String("namespace", sourceResolver.Namespace),
+		label.String("sourceresolver", sourceResolver.Name),
+		label.String("source.type", sourceType),
+	))
+	start := time.Now()
 	resolvedSource, err := sourceReconciler.Resolve(sourceResolver)
+	metrics.RecordSourceResolve(sourceResolver.Namespace, sourceType, time.Since(start), err)
+	tracing.EndSpan(span, err)
 	if err != nil {
-		return err
+		var failure resolveFailure
+		if !errors.As(err, &failure) {
+			return err
+		}
+		sourceResolver.ResolveFailed(failure.Reason(), err.Error())
+	} else {
+		sourceResolver.ResolvedSource(resolvedSource)
 	}
 
-	sourceResolver.ResolvedSource(resolvedSource)
-
 	if sourceResolver.PollingReady() {
 		err := c.Enqueuer.Enqueue(sourceResolver)
 		if err != nil {
@@ -106,15 +143,52 @@ func (c *Reconciler) Reconcile(ctx context.Context, key string) error {
 	return c.updateStatus(sourceResolver)
 }
 
-func (c *Reconciler) sourceReconciler(sourceResolver *v1alpha1.SourceResolver) (Resolver, error) {
+func (c *Reconciler) sourceReconciler(sourceResolver *v1alpha1.SourceResolver) (Resolver, string, error) {
 	if c.GitResolver.CanResolve(sourceResolver) {
-		return c.GitResolver, nil
+		return c.GitResolver, "git", nil
 	} else if c.BlobResolver.CanResolve(sourceResolver) {
-		return c.BlobResolver, nil
+		return c.BlobResolver, "blob", nil
 	} else if c.RegistryResolver.CanResolve(sourceResolver) {
-		return c.RegistryResolver, nil
+		return c.RegistryResolver, "registry", nil
+	} else if c.ArtifactResolver.CanResolve(sourceResolver) {
+		return c.ArtifactResolver, "artifact", nil
+	}
+	return nil, "", errors.New("invalid source type")
+}
+
+func (c *Reconciler) enqueueArtifactReferences(enqueue func(interface{})) func(interface{}) {
+	return func(obj interface{}) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+
+		object, err := meta.Accessor(obj)
+		if err != nil {
+			return
+		}
+
+		typ, err := meta.TypeAccessor(obj)
+		if err != nil {
+			return
+		}
+		groupKind := schema.FromAPIVersionAndKind(typ.GetAPIVersion(), typ.GetKind()).GroupKind()
+
+		sourceResolvers, err := c.SourceResolverLister.SourceResolvers(object.GetNamespace()).List(labels.Everything())
+		if err != nil {
+			return
+		}
+
+		for _, sourceResolver := range sourceResolvers {
+			ref := sourceResolver.Spec.Source.ArtifactRef
+			if ref == nil || ref.Name != object.GetName() {
+				continue
+			}
+
+			if schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).GroupKind() == groupKind {
+				enqueue(sourceResolver)
+			}
+		}
 	}
-	return nil, errors.New("invalid source type")
 }
 
 func (c *Reconciler) updateStatus(desired *v1alpha1.SourceResolver) error {
@@ -1,6 +1,7 @@
 package sourceresolver_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/sclevine/spec"
@@ -38,6 +39,7 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 	fakeGitResolver := &sourceresolverfakes.FakeResolver{}
 	fakeBlobResolver := &sourceresolverfakes.FakeResolver{}
 	fakeRegistryResolver := &sourceresolverfakes.FakeResolver{}
+	fakeArtifactResolver := &sourceresolverfakes.FakeResolver{}
 	fakeEnqueuer := &sourceresolverfakes.FakeEnqueuer{}
 
 	rt := testhelpers.ReconcilerTester(t,
@@ -55,6 +57,7 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 				GitResolver:          fakeGitResolver,
 				BlobResolver:         fakeBlobResolver,
 				RegistryResolver:     fakeRegistryResolver,
+				ArtifactResolver:     fakeArtifactResolver,
 				Enqueuer:             fakeEnqueuer,
 				Client:               fakeClient,
 				SourceResolverLister: listers.GetSourceResolverLister(),
@@ -167,6 +170,10 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 													Type:   corev1alpha1.ConditionReady,
 													Status: corev1.ConditionTrue,
 												},
+												{
+													Type:   v1alpha1.SourceResolved,
+													Status: corev1.ConditionTrue,
+												},
 												{
 													Type:   v1alpha1.ActivePolling,
 													Status: corev1.ConditionTrue,
@@ -225,6 +232,10 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 													Type:   corev1alpha1.ConditionReady,
 													Status: corev1.ConditionTrue,
 												},
+												{
+													Type:   v1alpha1.SourceResolved,
+													Status: corev1.ConditionTrue,
+												},
 												{
 													Type:   v1alpha1.ActivePolling,
 													Status: corev1.ConditionFalse,
@@ -282,6 +293,10 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 													Type:   corev1alpha1.ConditionReady,
 													Status: corev1.ConditionTrue,
 												},
+												{
+													Type:   v1alpha1.SourceResolved,
+													Status: corev1.ConditionTrue,
+												},
 												{
 													Type:   v1alpha1.ActivePolling,
 													Status: corev1.ConditionFalse,
@@ -322,6 +337,115 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 					})
 				})
 			})
+			when("git fails to resolve", func() {
+				fakeGitResolver.ResolveReturns(v1alpha1.ResolvedSourceConfig{}, resolveError{
+					reason:  v1alpha1.SourceAuthenticationFailed,
+					message: "authentication required",
+				})
+				fakeGitResolver.CanResolveReturns(true)
+
+				it("records the classified failure and keeps polling", func() {
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							sourceResolver,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.SourceResolver{
+									ObjectMeta: sourceResolver.ObjectMeta,
+									Spec:       sourceResolver.Spec,
+									Status: v1alpha1.SourceResolverStatus{
+										Status: corev1alpha1.Status{
+											ObservedGeneration: originalGeneration,
+											Conditions: corev1alpha1.Conditions{
+												{
+													Type:    corev1alpha1.ConditionReady,
+													Status:  corev1.ConditionFalse,
+													Reason:  v1alpha1.SourceAuthenticationFailed,
+													Message: "authentication required",
+												},
+												{
+													Type:    v1alpha1.SourceResolved,
+													Status:  corev1.ConditionFalse,
+													Reason:  v1alpha1.SourceAuthenticationFailed,
+													Message: "authentication required",
+												},
+												{
+													Type:   v1alpha1.ActivePolling,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					})
+
+					require.Equal(t, 1, fakeEnqueuer.EnqueueCallCount())
+				})
+
+				it("keeps the previously resolved source", func() {
+					sourceResolver := resolvedSourceResolver(sourceResolver.DeepCopy(), resolvedSource)
+
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							sourceResolver,
+						},
+						WantErr: false,
+						WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+							{
+								Object: &v1alpha1.SourceResolver{
+									ObjectMeta: sourceResolver.ObjectMeta,
+									Spec:       sourceResolver.Spec,
+									Status: v1alpha1.SourceResolverStatus{
+										Status: corev1alpha1.Status{
+											ObservedGeneration: originalGeneration,
+											Conditions: corev1alpha1.Conditions{
+												{
+													Type:    corev1alpha1.ConditionReady,
+													Status:  corev1.ConditionFalse,
+													Reason:  v1alpha1.SourceAuthenticationFailed,
+													Message: "authentication required",
+												},
+												{
+													Type:    v1alpha1.SourceResolved,
+													Status:  corev1.ConditionFalse,
+													Reason:  v1alpha1.SourceAuthenticationFailed,
+													Message: "authentication required",
+												},
+												{
+													Type:   v1alpha1.ActivePolling,
+													Status: corev1.ConditionTrue,
+												},
+											},
+										},
+										Source: resolvedSource,
+									},
+								},
+							},
+						},
+					})
+				})
+			})
+
+			when("git fails with an unclassified error", func() {
+				fakeGitResolver.ResolveReturns(v1alpha1.ResolvedSourceConfig{}, errors.New("some-error"))
+				fakeGitResolver.CanResolveReturns(true)
+
+				it("returns the error", func() {
+					rt.Test(rtesting.TableRow{
+						Key: key,
+						Objects: []runtime.Object{
+							sourceResolver,
+						},
+						WantErr: true,
+					})
+				})
+			})
 		})
 
 		when("a blob based source config", func() {
@@ -370,6 +494,10 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 												Type:   corev1alpha1.ConditionReady,
 												Status: corev1.ConditionTrue,
 											},
+											{
+												Type:   v1alpha1.SourceResolved,
+												Status: corev1.ConditionTrue,
+											},
 											{
 												Type:   v1alpha1.ActivePolling,
 												Status: corev1.ConditionFalse,
@@ -435,6 +563,10 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 												Type:   corev1alpha1.ConditionReady,
 												Status: corev1.ConditionTrue,
 											},
+											{
+												Type:   v1alpha1.SourceResolved,
+												Status: corev1.ConditionTrue,
+											},
 											{
 												Type:   v1alpha1.ActivePolling,
 												Status: corev1.ConditionFalse,
@@ -453,9 +585,93 @@ func testSourceResolver(t *testing.T, when spec.G, it spec.S) {
 				})
 			})
 		})
+
+		when("an artifact ref based source config", func() {
+			sourceResolver := &v1alpha1.SourceResolver{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       sourceResolverName,
+					Namespace:  namespace,
+					Generation: originalGeneration,
+				},
+				Spec: v1alpha1.SourceResolverSpec{
+					ServiceAccount: serviceAccount,
+					Source: v1alpha1.SourceConfig{
+						ArtifactRef: &v1alpha1.ArtifactRef{
+							APIVersion: "source.toolkit.fluxcd.io/v1beta1",
+							Kind:       "GitRepository",
+							Name:       "some-repo",
+						},
+					},
+				},
+			}
+
+			resolvedSource := v1alpha1.ResolvedSourceConfig{
+				Artifact: &v1alpha1.ResolvedArtifactSource{
+					URL:      "http://source-controller.flux-system/gitrepository/some-namespace/some-repo/abcdef.tar.gz",
+					Revision: "main/abcdef",
+					Checksum: "4f1b1e5d8a2c",
+				},
+			}
+
+			fakeArtifactResolver.ResolveReturns(resolvedSource, nil)
+			fakeArtifactResolver.CanResolveReturns(true)
+
+			it("reconciles to ready and not active polling", func() {
+				rt.Test(rtesting.TableRow{
+					Key: key,
+					Objects: []runtime.Object{
+						sourceResolver,
+					},
+					WantErr: false,
+					WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+						{
+							Object: &v1alpha1.SourceResolver{
+								ObjectMeta: sourceResolver.ObjectMeta,
+								Spec:       sourceResolver.Spec,
+								Status: v1alpha1.SourceResolverStatus{
+									Status: corev1alpha1.Status{
+										ObservedGeneration: originalGeneration,
+										Conditions: corev1alpha1.Conditions{
+											{
+												Type:   corev1alpha1.ConditionReady,
+												Status: corev1.ConditionTrue,
+											},
+											{
+												Type:   v1alpha1.SourceResolved,
+												Status: corev1.ConditionTrue,
+											},
+											{
+												Type:   v1alpha1.ActivePolling,
+												Status: corev1.ConditionFalse,
+											},
+										},
+									},
+									Source: resolvedSource,
+								},
+							},
+						},
+					},
+				})
+
+				require.Equal(t, 0, fakeEnqueuer.EnqueueCallCount())
+			})
+		})
 	})
 }
 
+type resolveError struct {
+	reason  string
+	message string
+}
+
+func (e resolveError) Error() string {
+	return e.message
+}
+
+func (e resolveError) Reason() string {
+	return e.reason
+}
+
 func resolvedSourceResolver(sourceResolver *v1alpha1.SourceResolver, resolvedSource v1alpha1.ResolvedSourceConfig) *v1alpha1.SourceResolver {
 	sourceResolver.ResolvedSource(resolvedSource)
 	return sourceResolver
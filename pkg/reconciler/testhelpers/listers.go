@@ -59,6 +59,14 @@ func (l *Listers) GetBuildLister() v1alpha1Listers.BuildLister {
 	return v1alpha1Listers.NewBuildLister(l.indexerFor(&v1alpha1.Build{}))
 }
 
+func (l *Listers) GetBuildQuotaLister() v1alpha1Listers.BuildQuotaLister {
+	return v1alpha1Listers.NewBuildQuotaLister(l.indexerFor(&v1alpha1.BuildQuota{}))
+}
+
+func (l *Listers) GetRolloutPolicyLister() v1alpha1Listers.RolloutPolicyLister {
+	return v1alpha1Listers.NewRolloutPolicyLister(l.indexerFor(&v1alpha1.RolloutPolicy{}))
+}
+
 func (l *Listers) GetBuilderLister() v1alpha1Listers.BuilderLister {
 	return v1alpha1Listers.NewBuilderLister(l.indexerFor(&v1alpha1.Builder{}))
 }
@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -9,12 +10,35 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/label"
+
+	"github.com/pivotal/kpack/pkg/metrics"
+	"github.com/pivotal/kpack/pkg/tracing"
 )
 
 type Client struct {
 }
 
 func (t *Client) Fetch(keychain authn.Keychain, repoName string) (v1.Image, string, error) {
+	_, span := tracing.StartSpan(context.Background(), "registry.fetch", trace.WithAttributes(label.String("image", repoName)))
+	start := time.Now()
+	image, identifier, err := fetch(keychain, repoName)
+	metrics.RecordRegistryRequest("fetch", time.Since(start), err)
+	tracing.EndSpan(span, err)
+	return image, identifier, err
+}
+
+func (t *Client) Save(keychain authn.Keychain, tag string, image v1.Image) (string, error) {
+	_, span := tracing.StartSpan(context.Background(), "registry.save", trace.WithAttributes(label.String("image", tag)))
+	start := time.Now()
+	identifier, err := save(keychain, tag, image)
+	metrics.RecordRegistryRequest("save", time.Since(start), err)
+	tracing.EndSpan(span, err)
+	return identifier, err
+}
+
+func fetch(keychain authn.Keychain, repoName string) (v1.Image, string, error) {
 	reference, err := name.ParseReference(repoName)
 	if err != nil {
 		return nil, "", err
@@ -33,7 +57,7 @@ func (t *Client) Fetch(keychain authn.Keychain, repoName string) (v1.Image, stri
 	return image, identifier, nil
 }
 
-func (t *Client) Save(keychain authn.Keychain, tag string, image v1.Image) (string, error) {
+func save(keychain authn.Keychain, tag string, image v1.Image) (string, error) {
 	ref, err := name.ParseReference(tag)
 	if err != nil {
 		return "", err
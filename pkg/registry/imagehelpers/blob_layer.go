@@ -0,0 +1,48 @@
+package imagehelpers
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+// NewBlobLayer returns an uncompressed layer holding content with a custom media type.
+// It is used to push artifacts such as signatures and bills of materials that are not filesystem layers.
+func NewBlobLayer(content []byte, mediaType types.MediaType) v1.Layer {
+	// hashing an in-memory buffer cannot fail
+	hash, _, _ := v1.SHA256(bytes.NewReader(content))
+	return &blobLayer{content: content, mediaType: mediaType, hash: hash}
+}
+
+type blobLayer struct {
+	content   []byte
+	mediaType types.MediaType
+	hash      v1.Hash
+}
+
+func (l *blobLayer) Digest() (v1.Hash, error) {
+	return l.hash, nil
+}
+
+func (l *blobLayer) DiffID() (v1.Hash, error) {
+	return l.hash, nil
+}
+
+func (l *blobLayer) Compressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
+
+func (l *blobLayer) Uncompressed() (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(l.content)), nil
+}
+
+func (l *blobLayer) Size() (int64, error) {
+	return int64(len(l.content)), nil
+}
+
+func (l *blobLayer) MediaType() (types.MediaType, error) {
+	return l.mediaType, nil
+}
@@ -0,0 +1,171 @@
+package rollout
+
+import (
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	v1alpha1lister "github.com/pivotal/kpack/pkg/client/listers/build/v1alpha1"
+	"github.com/pivotal/kpack/pkg/duckbuilder"
+)
+
+// Rollout holds back the STACK and BUILDPACK rebuilds of images until the earlier waves of the RolloutPolicies of their namespace are rebuilt
+type Rollout struct {
+	PolicyLister      v1alpha1lister.RolloutPolicyLister
+	ImageLister       v1alpha1lister.ImageLister
+	BuildLister       v1alpha1lister.BuildLister
+	DuckBuilderLister *duckbuilder.DuckBuilderLister
+}
+
+// Admit reports whether the image may rebuild for a new stack or new buildpacks from the progress the
+// policy reconciler reports in the status of the RolloutPolicies
+func (r *Rollout) Admit(image *v1alpha1.Image) (bool, error) {
+	policies, err := r.PolicyLister.RolloutPolicies(image.Namespace).List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	for _, policy := range policies {
+		wave, err := policy.Wave(image)
+		if err != nil {
+			return false, err
+		}
+
+		if !policy.Admits(wave) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Status is the progress of the rollout of the policy
+func (r *Rollout) Status(policy *v1alpha1.RolloutPolicy) (v1alpha1.RolloutPolicyStatus, error) {
+	waves, err := r.Progress(policy)
+	if err != nil {
+		return v1alpha1.RolloutPolicyStatus{}, err
+	}
+
+	status := v1alpha1.RolloutPolicyStatus{
+		Status: corev1alpha1.Status{
+			ObservedGeneration: policy.Generation,
+			Conditions:         corev1alpha1.Conditions{rolloutCondition(policy, waves)},
+		},
+		Waves: waves,
+	}
+	if active := policy.ActiveWave(waves); active < len(waves) {
+		status.ActiveWave = waves[active].Name
+	}
+	return status, nil
+}
+
+func rolloutCondition(policy *v1alpha1.RolloutPolicy, waves []v1alpha1.RolloutWaveStatus) corev1alpha1.Condition {
+	if halted := policy.Halted(waves); halted != "" {
+		return corev1alpha1.Condition{
+			LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+			Type:               corev1alpha1.ConditionReady,
+			Status:             corev1.ConditionFalse,
+			Reason:             v1alpha1.RolloutHalted,
+			Message:            halted,
+		}
+	}
+
+	return corev1alpha1.Condition{
+		LastTransitionTime: corev1alpha1.VolatileTime{Inner: metav1.Now()},
+		Type:               corev1alpha1.ConditionReady,
+		Status:             corev1.ConditionTrue,
+	}
+}
+
+// Progress counts the images of each wave of the policy by the state of their rebuild
+func (r *Rollout) Progress(policy *v1alpha1.RolloutPolicy) ([]v1alpha1.RolloutWaveStatus, error) {
+	names := policy.WaveNames()
+	waves := make([]v1alpha1.RolloutWaveStatus, len(names))
+	for i, name := range names {
+		waves[i].Name = name
+	}
+
+	images, err := r.ImageLister.Images(policy.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, image := range images {
+		i, err := policy.Wave(image)
+		if err != nil {
+			return nil, err
+		}
+
+		waves[i].Images++
+
+		build, err := r.latestBuild(image)
+		if err != nil {
+			return nil, err
+		} else if build == nil {
+			continue
+		}
+
+		switch {
+		case !build.Finished():
+			if rolloutBuild(build) {
+				waves[i].Building++
+			}
+		case build.IsFailure():
+			if rolloutBuild(build) && policy.CountsFailure(build) {
+				waves[i].Failed++
+			}
+		default:
+			outdated, err := r.outdated(image, build)
+			if err != nil {
+				return nil, err
+			}
+			if outdated {
+				waves[i].Outdated++
+			}
+		}
+	}
+	return waves, nil
+}
+
+func (r *Rollout) latestBuild(image *v1alpha1.Image) (*v1alpha1.Build, error) {
+	if image.Status.LatestBuildRef == "" {
+		return nil, nil
+	}
+
+	build, err := r.BuildLister.Builds(image.Namespace).Get(image.Status.LatestBuildRef)
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return build, err
+}
+
+// outdated images wait for a rebuild with the stack and buildpacks of a ready builder
+func (r *Rollout) outdated(image *v1alpha1.Image, build *v1alpha1.Build) (bool, error) {
+	builder, err := r.DuckBuilderLister.Namespace(image.Namespace).Get(image.Spec.Builder)
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return builder.Ready() && build.Outdated(builder), nil
+}
+
+// rolloutBuild is true for builds that rebuilt an image for a new stack or new buildpacks
+func rolloutBuild(build *v1alpha1.Build) bool {
+	for _, reason := range strings.Split(build.BuildReason(), ",") {
+		if reason == v1alpha1.BuildReasonStack || reason == v1alpha1.BuildReasonBuildpack {
+			return true
+		}
+	}
+	return false
+}
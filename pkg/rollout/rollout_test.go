@@ -0,0 +1,289 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/pivotal/kpack/pkg/apis/build/v1alpha1"
+	corev1alpha1 "github.com/pivotal/kpack/pkg/apis/core/v1alpha1"
+	"github.com/pivotal/kpack/pkg/reconciler/testhelpers"
+)
+
+func TestRollout(t *testing.T) {
+	spec.Run(t, "Rollout", testRollout)
+}
+
+func testRollout(t *testing.T, when spec.G, it spec.S) {
+	const (
+		namespace   = "team"
+		oldRunImage = "some.registry.io/run@sha256:0000000000000000000000000000000000000000000000000000000000000001"
+		newRunImage = "some.registry.io/run@sha256:0000000000000000000000000000000000000000000000000000000000000002"
+	)
+
+	builder := &v1alpha1.ClusterBuilder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "some-builder",
+		},
+		Status: v1alpha1.BuilderStatus{
+			Status: corev1alpha1.Status{
+				Conditions: corev1alpha1.Conditions{{Type: corev1alpha1.ConditionReady, Status: corev1.ConditionTrue}},
+			},
+			Stack: v1alpha1.BuildStack{RunImage: newRunImage},
+		},
+	}
+
+	policy := &v1alpha1.RolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "some-policy",
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.RolloutPolicySpec{
+			Waves: []v1alpha1.RolloutWave{
+				{
+					Name: "canary",
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"rollout": "canary"},
+					},
+				},
+				{
+					Name: "rest",
+				},
+			},
+		},
+	}
+
+	image := func(name string, labels map[string]string) *v1alpha1.Image {
+		return &v1alpha1.Image{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    labels,
+			},
+			Spec: v1alpha1.ImageSpec{
+				Builder: corev1.ObjectReference{Kind: v1alpha1.ClusterBuilderKind, Name: builder.Name},
+			},
+			Status: v1alpha1.ImageStatus{
+				LatestBuildRef: name + "-build",
+			},
+		}
+	}
+
+	build := func(image *v1alpha1.Image, reason string, succeeded corev1.ConditionStatus, runImage string) *v1alpha1.Build {
+		return &v1alpha1.Build{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        image.Status.LatestBuildRef,
+				Namespace:   namespace,
+				Annotations: map[string]string{v1alpha1.BuildReasonAnnotation: reason},
+			},
+			Status: v1alpha1.BuildStatus{
+				Status: corev1alpha1.Status{
+					Conditions: corev1alpha1.Conditions{{Type: corev1alpha1.ConditionSucceeded, Status: succeeded}},
+				},
+				Stack: v1alpha1.BuildStack{RunImage: runImage},
+			},
+		}
+	}
+
+	canary := image("canary", map[string]string{"rollout": "canary"})
+	first := image("first", nil)
+	second := image("second", nil)
+
+	rollout := func(objects ...runtime.Object) *Rollout {
+		listers := testhelpers.NewListers(append([]runtime.Object{builder, policy, canary, first, second}, objects...))
+		return &Rollout{
+			PolicyLister:      listers.GetRolloutPolicyLister(),
+			ImageLister:       listers.GetImageLister(),
+			BuildLister:       listers.GetBuildLister(),
+			DuckBuilderLister: listers.GetDuckBuilderLister(),
+		}
+	}
+
+	// reconciled reports the progress of the policy in its status as the rolloutpolicy reconciler does
+	reconciled := func(objects ...runtime.Object) *Rollout {
+		t.Helper()
+		status, err := rollout(objects...).Status(policy)
+		require.NoError(t, err)
+		policy.Status = status
+		return rollout(objects...)
+	}
+
+	admit := func(r *Rollout, image *v1alpha1.Image) bool {
+		t.Helper()
+		admitted, err := r.Admit(image)
+		require.NoError(t, err)
+		return admitted
+	}
+
+	when("Admit", func() {
+		it("holds back every wave until the progress of the policy is reported", func() {
+			r := rollout(
+				build(canary, "COMMIT", corev1.ConditionTrue, oldRunImage),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.False(t, admit(r, canary))
+		})
+
+		it("holds back every wave until the progress of a changed policy is reported", func() {
+			r := reconciled(
+				build(canary, "STACK", corev1.ConditionTrue, newRunImage),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+			require.True(t, admit(r, first))
+
+			policy.Generation++
+			assert.False(t, admit(r, first))
+		})
+
+		it("rebuilds the first wave before later waves", func() {
+			r := reconciled(
+				build(canary, "COMMIT", corev1.ConditionTrue, oldRunImage),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.True(t, admit(r, canary))
+			assert.False(t, admit(r, first))
+		})
+
+		it("waits for the builds of the first wave", func() {
+			r := reconciled(
+				build(canary, "STACK", corev1.ConditionUnknown, ""),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.False(t, admit(r, first))
+		})
+
+		it("rebuilds the next wave once the first wave is rebuilt", func() {
+			r := reconciled(
+				build(canary, "STACK", corev1.ConditionTrue, newRunImage),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.True(t, admit(r, first))
+			assert.True(t, admit(r, second))
+		})
+
+		it("does not wait for builds of other reasons", func() {
+			r := reconciled(
+				build(canary, "COMMIT", corev1.ConditionUnknown, ""),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.True(t, admit(r, first))
+		})
+
+		it("halts when the failures of a wave exceed the failure threshold", func() {
+			r := reconciled(
+				build(canary, "STACK", corev1.ConditionFalse, ""),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.False(t, admit(r, first))
+			assert.False(t, admit(r, second))
+		})
+
+		it("proceeds with failures below the failure threshold", func() {
+			threshold := int64(100)
+			policy.Spec.FailureThreshold = &threshold
+			r := reconciled(
+				build(canary, "STACK", corev1.ConditionFalse, ""),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.True(t, admit(r, first))
+		})
+
+		it("halts when the failures of a wave exceed the default failure threshold", func() {
+			r := reconciled(
+				build(canary, "STACK", corev1.ConditionTrue, newRunImage),
+				build(first, "STACK", corev1.ConditionFalse, ""),
+			)
+
+			assert.False(t, admit(r, second))
+		})
+
+		it("resumes when the failures finished before ResumeFrom", func() {
+			failed := build(canary, "STACK", corev1.ConditionFalse, "")
+			failed.Status.Conditions[0].LastTransitionTime = corev1alpha1.VolatileTime{Inner: metav1.NewTime(time.Now().Add(-time.Hour))}
+			policy.Spec.ResumeFrom = &metav1.Time{Time: time.Now()}
+			r := reconciled(
+				failed,
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.True(t, admit(r, first))
+		})
+
+		it("halts again when rebuilds fail after ResumeFrom", func() {
+			failed := build(canary, "STACK", corev1.ConditionFalse, "")
+			failed.Status.Conditions[0].LastTransitionTime = corev1alpha1.VolatileTime{Inner: metav1.Now()}
+			policy.Spec.ResumeFrom = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+			r := reconciled(
+				failed,
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+
+			assert.False(t, admit(r, first))
+		})
+
+		it("rebuilds images that match no wave in a final wave", func() {
+			policy.Spec.Waves = policy.Spec.Waves[:1]
+
+			r := reconciled(
+				build(canary, "COMMIT", corev1.ConditionTrue, oldRunImage),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+			assert.False(t, admit(r, first))
+
+			r = reconciled(
+				build(canary, "STACK", corev1.ConditionTrue, newRunImage),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+			)
+			assert.True(t, admit(r, first))
+		})
+	})
+
+	when("Progress", func() {
+		it("counts the images of each wave by the state of their rebuild", func() {
+			r := rollout(
+				build(canary, "STACK,BUILDPACK", corev1.ConditionFalse, ""),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+				build(second, "BUILDPACK", corev1.ConditionUnknown, ""),
+			)
+
+			waves, err := r.Progress(policy)
+			require.NoError(t, err)
+			assert.Equal(t, []v1alpha1.RolloutWaveStatus{
+				{Name: "canary", Images: 1, Failed: 1},
+				{Name: "rest", Images: 2, Outdated: 1, Building: 1},
+				{Name: v1alpha1.RolloutUnselectedWave},
+			}, waves)
+			assert.Equal(t, "wave canary failed 1 of 1 rebuilds exceeding the failure threshold of 10%", policy.Halted(waves))
+			assert.Equal(t, 1, policy.ActiveWave(waves))
+		})
+
+		it("counts the images that match no wave in the unselected wave", func() {
+			policy.Spec.Waves = policy.Spec.Waves[:1]
+			r := rollout(
+				build(canary, "STACK", corev1.ConditionTrue, newRunImage),
+				build(first, "COMMIT", corev1.ConditionTrue, oldRunImage),
+				build(second, "STACK", corev1.ConditionUnknown, ""),
+			)
+
+			waves, err := r.Progress(policy)
+			require.NoError(t, err)
+			assert.Equal(t, []v1alpha1.RolloutWaveStatus{
+				{Name: "canary", Images: 1},
+				{Name: v1alpha1.RolloutUnselectedWave, Images: 2, Outdated: 1, Building: 1},
+			}, waves)
+		})
+	})
+}
@@ -0,0 +1,248 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/buildpacks/lifecycle"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+)
+
+const (
+	CycloneDXMediaType types.MediaType = "application/vnd.cyclonedx+json"
+	SPDXMediaType      types.MediaType = "application/spdx+json"
+
+	tagSuffix = ".sbom"
+	toolName  = "kpack"
+)
+
+// Subject identifies the image a bill of materials describes
+type Subject struct {
+	Repository string
+	Digest     string
+	Created    time.Time
+}
+
+// Reference returns the tag the bill of materials of a digested image reference is published to
+func Reference(image string) (string, error) {
+	digest, err := name.NewDigest(image, name.WeakValidation)
+	if err != nil {
+		return "", err
+	}
+
+	return tag(digest.Context(), digest.DigestStr()).Name(), nil
+}
+
+func tag(repository name.Repository, digest string) name.Tag {
+	return repository.Tag(strings.Replace(digest, ":", "-", 1) + tagSuffix)
+}
+
+// Version returns the version of a bill of materials entry.
+// Older buildpacks record the version in the entry metadata.
+func Version(entry lifecycle.BOMEntry) string {
+	if entry.Version != "" {
+		return entry.Version
+	}
+
+	if version, ok := entry.Metadata["version"].(string); ok {
+		return version
+	}
+	return ""
+}
+
+type cycloneDXDocument struct {
+	BOMFormat   string               `json:"bomFormat"`
+	SpecVersion string               `json:"specVersion"`
+	Version     int                  `json:"version"`
+	Metadata    cycloneDXMetadata    `json:"metadata"`
+	Components  []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDXTool    `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTool struct {
+	Name string `json:"name"`
+}
+
+type cycloneDXComponent struct {
+	BOMRef     string              `json:"bom-ref"`
+	Type       string              `json:"type"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CycloneDX renders the bill of materials as a CycloneDX 1.3 JSON document
+func CycloneDX(subject Subject, bom []lifecycle.BOMEntry) ([]byte, error) {
+	components := make([]cycloneDXComponent, 0, len(bom))
+	for i, entry := range bom {
+		properties := []cycloneDXProperty{
+			{Name: "kpack:buildpack:id", Value: entry.Buildpack.ID},
+			{Name: "kpack:buildpack:version", Value: entry.Buildpack.Version},
+		}
+
+		metadata, err := metadataJSON(entry)
+		if err != nil {
+			return nil, err
+		}
+		if metadata != "" {
+			properties = append(properties, cycloneDXProperty{Name: "kpack:metadata", Value: metadata})
+		}
+
+		components = append(components, cycloneDXComponent{
+			BOMRef:     fmt.Sprintf("package-%d", i),
+			Type:       "library",
+			Name:       entry.Name,
+			Version:    Version(entry),
+			PURL:       purl(entry),
+			Properties: properties,
+		})
+	}
+
+	return json.MarshalIndent(cycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.3",
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: subject.Created.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Name: toolName}},
+			Component: cycloneDXComponent{
+				BOMRef:  subject.Repository + "@" + subject.Digest,
+				Type:    "container",
+				Name:    subject.Repository,
+				Version: subject.Digest,
+			},
+		},
+		Components: components,
+	}, "", "  ")
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID           string `json:"SPDXID"`
+	Name             string `json:"name"`
+	VersionInfo      string `json:"versionInfo,omitempty"`
+	DownloadLocation string `json:"downloadLocation"`
+	FilesAnalyzed    bool   `json:"filesAnalyzed"`
+	LicenseConcluded string `json:"licenseConcluded"`
+	LicenseDeclared  string `json:"licenseDeclared"`
+	CopyrightText    string `json:"copyrightText"`
+	Comment          string `json:"comment,omitempty"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+const spdxNoAssertion = "NOASSERTION"
+
+// SPDX renders the bill of materials as an SPDX 2.2 JSON document
+func SPDX(subject Subject, bom []lifecycle.BOMEntry) ([]byte, error) {
+	const imageID = "SPDXRef-Image"
+
+	packages := []spdxPackage{spdxNoAssertionPackage(spdxPackage{
+		SPDXID:      imageID,
+		Name:        subject.Repository,
+		VersionInfo: subject.Digest,
+	})}
+	relationships := []spdxRelationship{{
+		SPDXElementID:      "SPDXRef-DOCUMENT",
+		RelationshipType:   "DESCRIBES",
+		RelatedSPDXElement: imageID,
+	}}
+
+	for i, entry := range bom {
+		metadata, err := metadataJSON(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		comment := fmt.Sprintf("buildpack: %s@%s", entry.Buildpack.ID, entry.Buildpack.Version)
+		if metadata != "" {
+			comment += "\nmetadata: " + metadata
+		}
+
+		id := fmt.Sprintf("SPDXRef-Package-%d", i)
+		packages = append(packages, spdxNoAssertionPackage(spdxPackage{
+			SPDXID:      id,
+			Name:        entry.Name,
+			VersionInfo: Version(entry),
+			Comment:     comment,
+		}))
+		relationships = append(relationships, spdxRelationship{
+			SPDXElementID:      imageID,
+			RelationshipType:   "CONTAINS",
+			RelatedSPDXElement: id,
+		})
+	}
+
+	return json.MarshalIndent(spdxDocument{
+		SPDXVersion:       "SPDX-2.2",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              subject.Repository + "@" + subject.Digest,
+		DocumentNamespace: fmt.Sprintf("https://%s/spdx/%s", subject.Repository, subject.Digest),
+		CreationInfo: spdxCreationInfo{
+			Created:  subject.Created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + toolName},
+		},
+		Packages:      packages,
+		Relationships: relationships,
+	}, "", "  ")
+}
+
+func spdxNoAssertionPackage(p spdxPackage) spdxPackage {
+	p.DownloadLocation = spdxNoAssertion
+	p.LicenseConcluded = spdxNoAssertion
+	p.LicenseDeclared = spdxNoAssertion
+	p.CopyrightText = spdxNoAssertion
+	return p
+}
+
+func purl(entry lifecycle.BOMEntry) string {
+	purl, _ := entry.Metadata["purl"].(string)
+	return purl
+}
+
+// metadataJSON encodes the buildpack provided metadata, encoding/json sorts the keys so the output is stable
+func metadataJSON(entry lifecycle.BOMEntry) (string, error) {
+	if len(entry.Metadata) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(entry.Metadata)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,98 @@
+package sbom
+
+import (
+	"log"
+	"os"
+
+	"github.com/BurntSushi/toml"
+	"github.com/buildpacks/lifecycle"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/pkg/errors"
+
+	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
+)
+
+type Publisher struct {
+	Logger *log.Logger
+}
+
+// Publish reads the bill of materials of the image described by the export report and pushes it
+// in CycloneDX and SPDX formats as an OCI artifact tagged after the image digest.
+// Lifecycles before platform API 0.3 write no report, their builds are skipped.
+func (p *Publisher) Publish(reportFilePath string, keychain authn.Keychain) error {
+	var report lifecycle.ExportReport
+	_, err := toml.DecodeFile(reportFilePath, &report)
+	if os.IsNotExist(err) {
+		p.Logger.Println("No export report found, skipping SBOM")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(report.Image.Tags) == 0 {
+		return errors.New("no tags found in export report")
+	}
+
+	ref, err := name.ParseReference(report.Image.Tags[0], name.WeakValidation)
+	if err != nil {
+		return err
+	}
+
+	image, err := remote.Image(ref.Context().Digest(report.Image.Digest), remote.WithAuthFromKeychain(keychain))
+	if err != nil {
+		return errors.Wrapf(err, "unable to fetch %s@%s", ref.Context().Name(), report.Image.Digest)
+	}
+
+	var buildMetadata lifecycle.BuildMetadata
+	if err := imagehelpers.GetLabel(image, lifecycle.BuildMetadataLabel, &buildMetadata); err != nil {
+		return err
+	}
+
+	if len(buildMetadata.BOM) == 0 {
+		p.Logger.Println("No bill of materials found, skipping SBOM")
+		return nil
+	}
+
+	created, err := imagehelpers.GetCreatedAt(image)
+	if err != nil {
+		return err
+	}
+
+	artifact, err := Artifact(Subject{
+		Repository: ref.Context().Name(),
+		Digest:     report.Image.Digest,
+		Created:    created,
+	}, buildMetadata.BOM)
+	if err != nil {
+		return err
+	}
+
+	sbomTag := tag(ref.Context(), report.Image.Digest)
+	p.Logger.Printf("Publishing SBOM for '%s@%s' to '%s'\n", ref.Context().Name(), report.Image.Digest, sbomTag.Name())
+	return errors.Wrapf(remote.Write(sbomTag, artifact, remote.WithAuthFromKeychain(keychain)), "unable to push SBOM to %s", sbomTag.Name())
+}
+
+// Artifact packages the CycloneDX and SPDX renderings of the bill of materials as layers of an OCI image
+func Artifact(subject Subject, bom []lifecycle.BOMEntry) (ggcrv1.Image, error) {
+	cycloneDX, err := CycloneDX(subject, bom)
+	if err != nil {
+		return nil, err
+	}
+
+	spdx, err := SPDX(subject, bom)
+	if err != nil {
+		return nil, err
+	}
+
+	return mutate.AppendLayers(
+		mutate.MediaType(empty.Image, types.OCIManifestSchema1),
+		imagehelpers.NewBlobLayer(cycloneDX, CycloneDXMediaType),
+		imagehelpers.NewBlobLayer(spdx, SPDXMediaType),
+	)
+}
@@ -0,0 +1,178 @@
+package sbom_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/buildpacks/lifecycle"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pivotal/kpack/pkg/registry/imagehelpers"
+	"github.com/pivotal/kpack/pkg/sbom"
+)
+
+func TestPublisher(t *testing.T) {
+	spec.Run(t, "Publisher", testPublisher)
+}
+
+func testPublisher(t *testing.T, when spec.G, it spec.S) {
+	var (
+		server     *httptest.Server
+		repo       string
+		digest     string
+		reportPath string
+		logs       = &bytes.Buffer{}
+		publisher  = &sbom.Publisher{Logger: log.New(logs, "", 0)}
+	)
+
+	pushAppImage := func(buildMetadata string) {
+		image, err := random.Image(10, 1)
+		require.NoError(t, err)
+
+		image, err = imagehelpers.SetStringLabel(image, lifecycle.BuildMetadataLabel, buildMetadata)
+		require.NoError(t, err)
+
+		ref, err := name.ParseReference(repo)
+		require.NoError(t, err)
+		require.NoError(t, remote.Write(ref, image))
+
+		hash, err := image.Digest()
+		require.NoError(t, err)
+		digest = hash.String()
+
+		file, err := os.Create(reportPath)
+		require.NoError(t, err)
+		defer file.Close()
+		require.NoError(t, toml.NewEncoder(file).Encode(lifecycle.ExportReport{
+			Image: lifecycle.ImageReport{
+				Tags:   []string{repo},
+				Digest: digest,
+			},
+		}))
+	}
+
+	it.Before(func() {
+		server = httptest.NewServer(registry.New(registry.Logger(log.New(ioutil.Discard, "", 0))))
+		u, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		repo = fmt.Sprintf("%s/some/app", u.Host)
+
+		reportDir, err := ioutil.TempDir("", "report")
+		require.NoError(t, err)
+		reportPath = filepath.Join(reportDir, "report.toml")
+	})
+
+	it.After(func() {
+		server.Close()
+		require.NoError(t, os.RemoveAll(filepath.Dir(reportPath)))
+	})
+
+	when("#Publish", func() {
+		it("skips builds without an export report such as platform API 0.2 builds", func() {
+			require.NoError(t, publisher.Publish(reportPath, authn.DefaultKeychain))
+			require.Contains(t, logs.String(), "No export report found, skipping SBOM")
+		})
+
+		it("pushes the bill of materials in cyclonedx and spdx formats tagged after the image digest", func() {
+			pushAppImage(`{"bom": [{"name": "node", "version": "12.18.0", "metadata": {"purl": "pkg:generic/node@12.18.0"}, "buildpack": {"id": "io.buildpacks.node", "version": "1.0.0"}}]}`)
+
+			require.NoError(t, publisher.Publish(reportPath, authn.DefaultKeychain))
+
+			sbomRef, err := sbom.Reference(repo + "@" + digest)
+			require.NoError(t, err)
+			require.Equal(t, repo+":sha256-"+digest[len("sha256:"):]+".sbom", sbomRef)
+
+			ref, err := name.ParseReference(sbomRef)
+			require.NoError(t, err)
+
+			artifact, err := remote.Image(ref)
+			require.NoError(t, err)
+
+			manifest, err := artifact.Manifest()
+			require.NoError(t, err)
+			require.Len(t, manifest.Layers, 2)
+			require.Equal(t, sbom.CycloneDXMediaType, manifest.Layers[0].MediaType)
+			require.Equal(t, sbom.SPDXMediaType, manifest.Layers[1].MediaType)
+
+			layers, err := artifact.Layers()
+			require.NoError(t, err)
+
+			var cycloneDX struct {
+				BOMFormat string `json:"bomFormat"`
+				Metadata  struct {
+					Component struct {
+						Name    string `json:"name"`
+						Version string `json:"version"`
+					} `json:"component"`
+				} `json:"metadata"`
+				Components []struct {
+					Name    string `json:"name"`
+					Version string `json:"version"`
+					PURL    string `json:"purl"`
+				} `json:"components"`
+			}
+			readJSON(t, layers[0], &cycloneDX)
+			require.Equal(t, "CycloneDX", cycloneDX.BOMFormat)
+			require.Equal(t, repo, cycloneDX.Metadata.Component.Name)
+			require.Equal(t, digest, cycloneDX.Metadata.Component.Version)
+			require.Len(t, cycloneDX.Components, 1)
+			require.Equal(t, "node", cycloneDX.Components[0].Name)
+			require.Equal(t, "12.18.0", cycloneDX.Components[0].Version)
+			require.Equal(t, "pkg:generic/node@12.18.0", cycloneDX.Components[0].PURL)
+
+			var spdx struct {
+				SPDXVersion string `json:"spdxVersion"`
+				Packages    []struct {
+					Name        string `json:"name"`
+					VersionInfo string `json:"versionInfo"`
+				} `json:"packages"`
+			}
+			readJSON(t, layers[1], &spdx)
+			require.Equal(t, "SPDX-2.2", spdx.SPDXVersion)
+			require.Len(t, spdx.Packages, 2)
+			require.Equal(t, repo, spdx.Packages[0].Name)
+			require.Equal(t, "node", spdx.Packages[1].Name)
+			require.Equal(t, "12.18.0", spdx.Packages[1].VersionInfo)
+		})
+
+		it("skips images without a bill of materials", func() {
+			pushAppImage(`{"buildpacks": [{"id": "io.buildpacks.node", "version": "1.0.0"}]}`)
+
+			require.NoError(t, publisher.Publish(reportPath, authn.DefaultKeychain))
+			require.Contains(t, logs.String(), "No bill of materials found")
+
+			sbomRef, err := sbom.Reference(repo + "@" + digest)
+			require.NoError(t, err)
+
+			ref, err := name.ParseReference(sbomRef)
+			require.NoError(t, err)
+
+			_, err = remote.Image(ref)
+			require.Error(t, err)
+		})
+	})
+}
+
+func readJSON(t *testing.T, layer ggcrv1.Layer, v interface{}) {
+	rc, err := layer.Compressed()
+	require.NoError(t, err)
+	defer rc.Close()
+
+	require.NoError(t, json.NewDecoder(rc).Decode(v))
+}
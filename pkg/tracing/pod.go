@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// InjectPod passes the trace context in ctx to the named containers of a pod so the spans of their binaries join the trace.
+// The pod is unchanged when tracing is disabled.
+func InjectPod(ctx context.Context, pod *corev1.Pod, containers ...string) {
+	traceParent := TraceParent(ctx)
+	if traceParent == "" || collectorAddress == "" {
+		return
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[TraceParentAnnotation] = traceParent
+
+	env := []corev1.EnvVar{
+		{Name: TraceParentEnvVar, Value: traceParent},
+		{Name: CollectorAddressEnvVar, Value: collectorAddress},
+	}
+	for _, name := range containers {
+		for i := range pod.Spec.InitContainers {
+			if pod.Spec.InitContainers[i].Name == name {
+				pod.Spec.InitContainers[i].Env = append(pod.Spec.InitContainers[i].Env, env...)
+			}
+		}
+		for i := range pod.Spec.Containers {
+			if pod.Spec.Containers[i].Name == name {
+				pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, env...)
+			}
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/propagators"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+const (
+	TracerName = "github.com/pivotal/kpack"
+
+	// TraceParentAnnotation holds the w3c trace context of the trace a build and its pod belong to
+	TraceParentAnnotation = "kpack.io/traceparent"
+	// TraceParentEnvVar passes the trace context of a build to the steps of its pod
+	TraceParentEnvVar = "TRACEPARENT"
+	// CollectorAddressEnvVar is the address of the OTLP collector spans are exported to
+	CollectorAddressEnvVar = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+	traceParentHeader = "traceparent"
+)
+
+var (
+	propagator = propagators.TraceContext{}
+
+	collectorAddress string
+)
+
+// Setup exports the spans of the service to the OTLP collector at address. Tracing is disabled if the address is empty.
+// The returned func flushes the spans that have not been exported.
+func Setup(service, address string) (func(context.Context) error, error) {
+	if address == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlp.NewExporter(otlp.WithAddress(address), otlp.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	processor := sdktrace.NewBatchSpanProcessor(exporter)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithResource(resource.New(semconv.ServiceNameKey.String(service))),
+	)
+	global.SetTracerProvider(provider)
+	global.SetTextMapPropagator(propagator)
+	collectorAddress = address
+
+	return func(ctx context.Context) error {
+		processor.Shutdown()
+		return exporter.Shutdown(ctx)
+	}, nil
+}
+
+// FromEnv sets up tracing for a build pod step from the environment the controller passed to its container.
+// The returned context continues the build's trace. Tracing is disabled if it cannot be set up.
+func FromEnv(service string) (context.Context, func(context.Context) error, error) {
+	shutdown, err := Setup(service, os.Getenv(CollectorAddressEnvVar))
+	if err != nil {
+		return context.Background(), func(context.Context) error { return nil }, err
+	}
+
+	return WithTraceParent(context.Background(), os.Getenv(TraceParentEnvVar)), shutdown, nil
+}
+
+// CollectorAddress is the address the spans of this process are exported to or empty if tracing is disabled
+func CollectorAddress() string {
+	return collectorAddress
+}
+
+func Tracer() trace.Tracer {
+	return global.Tracer(TracerName)
+}
+
+// StartSpan starts a span that is a child of the span in ctx
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanOption) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, opts...)
+}
+
+// EndSpan ends the span and marks it as failed if err is set
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(context.Background(), err, trace.WithErrorStatus(codes.Error))
+	}
+	span.End()
+}
+
+// TraceParent is the w3c traceparent of the span in ctx or empty if ctx has no sampled span
+func TraceParent(ctx context.Context) string {
+	carrier := textMapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier[traceParentHeader]
+}
+
+// WithTraceParent returns a context whose remote parent is the span of a w3c traceparent
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	return propagator.Extract(ctx, textMapCarrier{traceParentHeader: traceParent})
+}
+
+type textMapCarrier map[string]string
+
+var _ otel.TextMapCarrier = textMapCarrier{}
+
+func (c textMapCarrier) Get(key string) string {
+	return c[key]
+}
+
+func (c textMapCarrier) Set(key, value string) {
+	c[key] = value
+}
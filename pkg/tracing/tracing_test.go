@@ -0,0 +1,109 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sclevine/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/trace/tracetest"
+	"go.opentelemetry.io/otel/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTracing(t *testing.T) {
+	spec.Run(t, "Tracing", testTracing)
+}
+
+func testTracing(t *testing.T, when spec.G, it spec.S) {
+	recorder := &tracetest.StandardSpanRecorder{}
+
+	it.Before(func() {
+		global.SetTracerProvider(tracetest.NewTracerProvider(tracetest.WithSpanRecorder(recorder)))
+	})
+
+	it.After(func() {
+		collectorAddress = ""
+	})
+
+	when("TraceParent", func() {
+		it("is empty without a span", func() {
+			assert.Equal(t, "", TraceParent(context.Background()))
+		})
+
+		it("continues the trace of a traceparent", func() {
+			ctx, span := StartSpan(context.Background(), "some-span")
+			traceParent := TraceParent(ctx)
+			require.NotEmpty(t, traceParent)
+
+			_, child := StartSpan(WithTraceParent(context.Background(), traceParent), "some-child")
+			assert.Equal(t, span.SpanContext().TraceID, child.SpanContext().TraceID)
+			assert.Equal(t, span.SpanContext().SpanID, child.(*tracetest.Span).ParentSpanID())
+		})
+	})
+
+	when("EndSpan", func() {
+		it("marks the span as failed with an error", func() {
+			_, span := StartSpan(context.Background(), "some-span")
+
+			EndSpan(span, errors.New("some error"))
+
+			assert.True(t, span.(*tracetest.Span).Ended())
+			assert.Equal(t, codes.Error, span.(*tracetest.Span).StatusCode())
+		})
+
+		it("does not mark the span as failed without an error", func() {
+			_, span := StartSpan(context.Background(), "some-span")
+
+			EndSpan(span, nil)
+
+			assert.True(t, span.(*tracetest.Span).Ended())
+			assert.Equal(t, codes.Unset, span.(*tracetest.Span).StatusCode())
+		})
+	})
+
+	when("InjectPod", func() {
+		pod := func() *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "some-pod",
+				},
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "prepare"}, {Name: "build"}},
+					Containers:     []corev1.Container{{Name: "completion"}},
+				},
+			}
+		}
+
+		it("passes the trace context to the named containers", func() {
+			collectorAddress = "collector:4317"
+			ctx, _ := StartSpan(context.Background(), "some-span")
+			traceParent := TraceParent(ctx)
+
+			tracedPod := pod()
+			InjectPod(ctx, tracedPod, "prepare", "completion")
+
+			expectedEnv := []corev1.EnvVar{
+				{Name: "TRACEPARENT", Value: traceParent},
+				{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "collector:4317"},
+			}
+			assert.Equal(t, traceParent, tracedPod.Annotations["kpack.io/traceparent"])
+			assert.Equal(t, expectedEnv, tracedPod.Spec.InitContainers[0].Env)
+			assert.Empty(t, tracedPod.Spec.InitContainers[1].Env)
+			assert.Equal(t, expectedEnv, tracedPod.Spec.Containers[0].Env)
+		})
+
+		it("does not change the pod when tracing is disabled", func() {
+			ctx, _ := StartSpan(context.Background(), "some-span")
+
+			tracedPod := pod()
+			InjectPod(ctx, tracedPod, "prepare", "completion")
+
+			assert.Equal(t, pod(), tracedPod)
+		})
+	})
+}